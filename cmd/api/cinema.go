@@ -0,0 +1,363 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// ScreenShowtimes is a screen of a cinema with its shows
+type ScreenShowtimes struct {
+	Venue          models.Venue
+	MovieTimeSlots []models.MovieTimeSlot
+}
+
+// CinemaShowtimes is a cinema with the screens showing a movie, the Cinema has ID 0 for a screen not linked to a cinema yet
+type CinemaShowtimes struct {
+	Cinema     models.Cinema
+	DistanceKm float64
+	Screens    []ScreenShowtimes
+}
+
+func validateCinema(cinema models.Cinema) error {
+	if err := validate.Struct(cinema); err != nil {
+		return err
+	}
+
+	if _, err := time.LoadLocation(cinema.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %s", cinema.Timezone)
+	}
+
+	return nil
+}
+
+// inheritCinemaSite fills in the site details a screen shares with its cinema when they are not set on the screen
+func inheritCinemaSite(venue *models.Venue, cinema models.Cinema) {
+	if venue.Address == "" {
+		venue.Address = cinema.Address
+	}
+
+	if venue.Latitude == 0 && venue.Longitude == 0 {
+		venue.Latitude = cinema.Latitude
+		venue.Longitude = cinema.Longitude
+	}
+
+	if venue.RegionCode == "" {
+		venue.RegionCode = cinema.RegionCode
+	}
+
+	venue.Timezone = cinema.Timezone
+}
+
+func (m *MovieDB) AddCinema(cinema models.Cinema) (models.Cinema, int, error) {
+	if cinema.Timezone == "" {
+		cinema.Timezone = "UTC"
+	}
+
+	if err := validateCinema(cinema); err != nil {
+		return cinema, 400, err
+	}
+
+	for i := range cinema.Screens {
+		inheritCinemaSite(&cinema.Screens[i], cinema)
+	}
+
+	result := m.DB.Conn.Create(&cinema)
+
+	if result.Error != nil {
+		return cinema, 500, result.Error
+	}
+
+	return cinema, 200, nil
+}
+
+// GetCinema returns the cinema with its screens ordered by screen number
+func (m *MovieDB) GetCinema(cinemaID uint) (models.Cinema, int, error) {
+	var cinema models.Cinema
+
+	result := m.DB.Conn.Preload("Screens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("screen_number ASC")
+	}).First(&cinema, cinemaID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return cinema, 404, errors.New("cinema not found")
+	}
+
+	if result.Error != nil {
+		return cinema, 500, result.Error
+	}
+
+	return cinema, 200, nil
+}
+
+/*
+GetAllCinemas lists every cinema ordered by ID
+
+	pageSize: number of cinemas per page, defaults to 20 and is capped at 100
+	pageToken: next page token returned by the previous call, empty for the first page
+	regionCode: optional, only cinemas of this region
+*/
+func (m *MovieDB) GetAllCinemas(pageSize int, pageToken string, regionCode string) ([]models.Cinema, string, int, error) {
+	var cinemas []models.Cinema
+
+	lastID, err := helper.DecodePageToken(pageToken)
+
+	if err != nil {
+		return nil, "", 400, err
+	}
+
+	pageSize = helper.PageSize(pageSize)
+
+	query := m.DB.Conn.Where("id > ?", lastID)
+
+	if regionCode != "" {
+		query = query.Where("region_code = ?", regionCode)
+	}
+
+	// Fetch one extra row to know if there is a next page
+
+	result := query.Preload("Screens", func(db *gorm.DB) *gorm.DB {
+		return db.Order("screen_number ASC")
+	}).Order("id ASC").Limit(pageSize + 1).Find(&cinemas)
+
+	if result.Error != nil {
+		return nil, "", 500, result.Error
+	}
+
+	nextPageToken := ""
+
+	if len(cinemas) > pageSize {
+		cinemas = cinemas[:pageSize]
+		nextPageToken = helper.EncodePageToken(cinemas[len(cinemas)-1].ID)
+	}
+
+	return cinemas, nextPageToken, 200, nil
+}
+
+// UpdateCinema updates the site details, a new address or location is copied to every screen of the cinema
+func (m *MovieDB) UpdateCinema(cinemaID uint, cinema models.Cinema) (models.Cinema, int, error) {
+	var existingCinema models.Cinema
+
+	result := m.DB.Conn.First(&existingCinema, cinemaID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return cinema, 404, errors.New("cinema not found")
+	}
+
+	if result.Error != nil {
+		return cinema, 500, result.Error
+	}
+
+	// Screens are managed through the venue RPCs
+	cinema.Screens = nil
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&existingCinema).Updates(&cinema).Error; err != nil {
+		tx.Rollback()
+		return cinema, 500, err
+	}
+
+	if err := validateCinema(existingCinema); err != nil {
+		tx.Rollback()
+		return cinema, 400, err
+	}
+
+	err := tx.Model(&models.Venue{}).Where("cinema_id = ?", cinemaID).Updates(map[string]interface{}{
+		"address":     existingCinema.Address,
+		"latitude":    existingCinema.Latitude,
+		"longitude":   existingCinema.Longitude,
+		"region_code": existingCinema.RegionCode,
+		"timezone":    existingCinema.Timezone,
+	}).Error
+
+	if err != nil {
+		tx.Rollback()
+		return cinema, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return cinema, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return existingCinema, 200, nil
+}
+
+// DeleteCinema deletes a cinema without screens, screens have to be deleted or moved first
+func (m *MovieDB) DeleteCinema(cinemaID uint) (int, error) {
+	var screens int64
+
+	result := m.DB.Conn.Model(&models.Venue{}).Where("cinema_id = ?", cinemaID).Count(&screens)
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if screens > 0 {
+		return 409, fmt.Errorf("cinema still has %d screens", screens)
+	}
+
+	result = m.DB.Conn.Delete(&models.Cinema{}, cinemaID)
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return 404, errors.New("cinema not found")
+	}
+
+	return 200, nil
+}
+
+/*
+MigrateVenuesToCinemas links the venues created before cinemas existed to a cinema
+
+Venues sharing the same address and coordinates are screens of the same site and end up in one cinema, it is safe to run again.
+Returns the number of cinemas created and venues linked
+*/
+func (m *MovieDB) MigrateVenuesToCinemas() (int, int, int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	created := tx.Exec(`INSERT INTO cinemas (created_at, updated_at, name, address, region_code, longitude, latitude, timezone)
+		SELECT now(), now(), min(venues.name), venues.address, max(venues.region_code), venues.longitude, venues.latitude, max(venues.timezone)
+		FROM venues
+		WHERE venues.cinema_id IS NULL AND venues.deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM cinemas WHERE cinemas.deleted_at IS NULL AND cinemas.address = venues.address
+			AND cinemas.longitude = venues.longitude AND cinemas.latitude = venues.latitude
+		)
+		GROUP BY venues.address, venues.longitude, venues.latitude`)
+
+	if created.Error != nil {
+		tx.Rollback()
+		return 0, 0, 500, created.Error
+	}
+
+	// The lowest cinema ID wins if a site was added twice by hand
+
+	linked := tx.Exec(`UPDATE venues SET cinema_id = (
+			SELECT min(cinemas.id) FROM cinemas WHERE cinemas.deleted_at IS NULL AND cinemas.address = venues.address
+			AND cinemas.longitude = venues.longitude AND cinemas.latitude = venues.latitude
+		)
+		WHERE venues.cinema_id IS NULL AND venues.deleted_at IS NULL`)
+
+	if linked.Error != nil {
+		tx.Rollback()
+		return 0, 0, 500, linked.Error
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, 0, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return int(created.RowsAffected), int(linked.RowsAffected), 200, nil
+}
+
+/*
+GroupShowtimesByCinema groups nearby screens and their shows by cinema
+
+Cinemas keep the order of their closest screen, screens are ordered by screen number within a cinema
+*/
+func (m *MovieDB) GroupShowtimesByCinema(venues []NearbyVenue) ([]CinemaShowtimes, int, error) {
+	cinemaIDs := make([]uint, 0)
+
+	for _, v := range venues {
+		if v.Venue.CinemaID != nil {
+			cinemaIDs = append(cinemaIDs, *v.Venue.CinemaID)
+		}
+	}
+
+	cinemasByID := make(map[uint]models.Cinema)
+
+	if len(cinemaIDs) > 0 {
+		var cinemas []models.Cinema
+
+		result := m.DB.Conn.Where("id IN ?", cinemaIDs).Find(&cinemas)
+
+		if result.Error != nil {
+			return nil, 500, result.Error
+		}
+
+		for _, cinema := range cinemas {
+			cinemasByID[cinema.ID] = cinema
+		}
+	}
+
+	groups := make([]CinemaShowtimes, 0)
+	groupIndex := make(map[uint]int)
+
+	for _, v := range venues {
+		screen := ScreenShowtimes{Venue: v.Venue, MovieTimeSlots: v.Venue.MovieTimeSlots}
+
+		cinema, ok := models.Cinema{}, false
+
+		if v.Venue.CinemaID != nil {
+			cinema, ok = cinemasByID[*v.Venue.CinemaID]
+		}
+
+		if !ok {
+			// Not linked to a cinema yet, the screen is a site of its own
+			groups = append(groups, CinemaShowtimes{
+				Cinema: models.Cinema{
+					Name:       v.Venue.Name,
+					Address:    v.Venue.Address,
+					RegionCode: v.Venue.RegionCode,
+					Latitude:   v.Venue.Latitude,
+					Longitude:  v.Venue.Longitude,
+				},
+				DistanceKm: v.DistanceKm,
+				Screens:    []ScreenShowtimes{screen},
+			})
+			continue
+		}
+
+		i, seen := groupIndex[cinema.ID]
+
+		if !seen {
+			i = len(groups)
+			groupIndex[cinema.ID] = i
+			groups = append(groups, CinemaShowtimes{Cinema: cinema, DistanceKm: v.DistanceKm})
+		}
+
+		groups[i].Screens = append(groups[i].Screens, screen)
+	}
+
+	for _, group := range groups {
+		sort.SliceStable(group.Screens, func(i, j int) bool {
+			return group.Screens[i].Venue.ScreenNumber < group.Screens[j].Venue.ScreenNumber
+		})
+	}
+
+	return groups, 200, nil
+}
+
+// venueTimezone is the time zone of a venue, UTC for a venue that does not exist
+func venueTimezone(db *gorm.DB, venueID uint) (*time.Location, error) {
+	var timezone string
+
+	result := db.Model(&models.Venue{}).Select("timezone").Where("id = ?", venueID).Scan(&timezone)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return LoadTimezone(timezone)
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+// Formats a movie can be shown in, the proto MovieFormat enum names them with a FORMAT_ prefix
+const (
+	MovieFormat2D    = "2D"
+	MovieFormat3D    = "3D"
+	MovieFormatIMAX  = "IMAX"
+	MovieFormat4DX   = "4DX"
+	MovieFormatDolby = "DOLBY"
+)
+
+var movieFormatAliases = map[string]string{
+	"TWO_D":        MovieFormat2D,
+	"THREE_D":      MovieFormat3D,
+	"FOUR_D":       MovieFormat4DX,
+	"4D":           MovieFormat4DX,
+	"DOLBY_CINEMA": MovieFormatDolby,
+	"DOLBY CINEMA": MovieFormatDolby,
+}
+
+// NormalizeMovieFormat maps the names a movie format is known by to one, so 2D, TWO_D and FORMAT_2D compare equal
+func NormalizeMovieFormat(format string) string {
+	format = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(format)), "FORMAT_")
+
+	if alias, ok := movieFormatAliases[format]; ok {
+		return alias
+	}
+
+	return format
+}
+
+// movieFormatSpellings lists the names a stored show can use for format, to match them in SQL
+func movieFormatSpellings(format string) []string {
+	normalized := NormalizeMovieFormat(format)
+	spellings := []string{normalized, "FORMAT_" + normalized}
+
+	for alias, name := range movieFormatAliases {
+		if name == normalized {
+			spellings = append(spellings, alias)
+		}
+	}
+
+	return spellings
+}
+
+func venueSupportsFormat(venue models.Venue, format string) bool {
+	for _, supported := range venue.MovieFormatSupported {
+		if NormalizeMovieFormat(supported) == NormalizeMovieFormat(format) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasLanguage(languages []string, language string) bool {
+	for _, l := range languages {
+		if strings.EqualFold(strings.TrimSpace(l), strings.TrimSpace(language)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+validateShowLanguages checks the audio language and the subtitles of a show against its movie and its screen
+
+A show without a language is given the first language of the movie the screen supports
+*/
+func validateShowLanguages(slot *models.MovieTimeSlot, movie models.Movie, venue models.Venue) error {
+	if slot.Language == "" {
+		for _, language := range movie.Language {
+			if hasLanguage(venue.LanguagesSupported, language) {
+				slot.Language = language
+				break
+			}
+		}
+	}
+
+	languages := append([]string{}, slot.Subtitles...)
+
+	if slot.Language != "" {
+		languages = append([]string{slot.Language}, languages...)
+	}
+
+	for i, language := range languages {
+		what := "subtitles"
+
+		if i == 0 && slot.Language != "" {
+			what = "audio"
+		}
+
+		if !hasLanguage(movie.Language, language) {
+			return &ScheduleConflictError{
+				Reason:  ScheduleConflictLanguage,
+				Message: fmt.Sprintf("movie %d is not available with %s in %s", movie.ID, what, language),
+			}
+		}
+
+		if !hasLanguage(venue.LanguagesSupported, language) {
+			return &ScheduleConflictError{
+				Reason:  ScheduleConflictLanguage,
+				Message: fmt.Sprintf("venue %d does not support %s in %s", venue.ID, what, language),
+			}
+		}
+	}
+
+	return nil
+}
+
+// showVariantFilter is the SQL condition on the shows of table for an audio language and a format, both are optional
+func showVariantFilter(table string, language string, movieFormat string) (string, []any) {
+	condition := "TRUE"
+	args := make([]any, 0, 2)
+
+	if language != "" {
+		condition += fmt.Sprintf(" AND lower(%s.language) = lower(?)", table)
+		args = append(args, strings.TrimSpace(language))
+	}
+
+	if movieFormat != "" {
+		condition += fmt.Sprintf(" AND upper(%s.movie_format) IN ?", table)
+		args = append(args, movieFormatSpellings(movieFormat))
+	}
+
+	return condition, args
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// DefaultNearbyRadiusKm is how far from the customer venues are looked up when no radius is given
+const DefaultNearbyRadiusKm = 30
+
+// MaxNearbyRadiusKm caps the radius a client can ask for
+const MaxNearbyRadiusKm = 500
+
+// Units a search radius can be given in
+const (
+	DistanceUnitKilometers = "KILOMETERS"
+	DistanceUnitMiles      = "MILES"
+	DistanceUnitMeters     = "METERS"
+)
+
+// geographyPoint builds a PostGIS geography from a longitude and a latitude argument
+const geographyPoint = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+
+// GeoPoint is a position in WGS84 degrees
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+/*
+Location is where the customer looks for shows
+
+	Point: position of the customer, the zero point means no position
+	RadiusKm: search radius around the point, 0 uses the configured default
+	RegionCode: alternative to the point, only venues of this region. When both are set venues have to match both
+*/
+type Location struct {
+	Point      GeoPoint
+	RadiusKm   float64
+	RegionCode string
+}
+
+// HasPoint tells if the location has coordinates
+func (l Location) HasPoint() bool {
+	return l.Point.Latitude != 0 || l.Point.Longitude != 0
+}
+
+// IsZero tells if the location has neither coordinates nor a region code
+func (l Location) IsZero() bool {
+	return !l.HasPoint() && l.RegionCode == ""
+}
+
+// Validate checks that the coordinates and the radius are in range
+func (l Location) Validate() error {
+	if l.Point.Latitude < -90 || l.Point.Latitude > 90 {
+		return fmt.Errorf("latitude %g is out of range", l.Point.Latitude)
+	}
+
+	if l.Point.Longitude < -180 || l.Point.Longitude > 180 {
+		return fmt.Errorf("longitude %g is out of range", l.Point.Longitude)
+	}
+
+	if l.RadiusKm < 0 {
+		return errors.New("radius cannot be negative")
+	}
+
+	if l.RadiusKm > MaxNearbyRadiusKm {
+		return fmt.Errorf("radius cannot be more than %dkm", MaxNearbyRadiusKm)
+	}
+
+	return nil
+}
+
+// RadiusToKm converts a radius given in unit to kilometers, an empty unit is kilometers
+func RadiusToKm(radius float64, unit string) (float64, error) {
+	switch unit {
+	case "", DistanceUnitKilometers:
+		return radius, nil
+	case DistanceUnitMiles:
+		return radius * 1.609344, nil
+	case DistanceUnitMeters:
+		return radius / 1000, nil
+	}
+
+	return 0, fmt.Errorf("unknown distance unit %s", unit)
+}
+
+// NearbyVenue is a venue together with its distance from the searched point
+type NearbyVenue struct {
+	Venue      models.Venue
+	DistanceKm float64
+}
+
+// NearbyMovie is a movie together with the closest venue showing it, DistanceKm is 0 when the location has no point
+type NearbyMovie struct {
+	Movie      models.Movie
+	VenueID    uint
+	DistanceKm float64
+}
+
+type nearbyVenueRow struct {
+	VenueID    uint
+	DistanceKm float64
+}
+
+type nearbyMovieRow struct {
+	MovieID    uint
+	VenueID    uint
+	DistanceKm float64
+}
+
+// NearbyRadiusKmFromEnv reads NEARBY_RADIUS_KM, falling back to DefaultNearbyRadiusKm when it is unset or invalid
+func NearbyRadiusKmFromEnv() float64 {
+	radiusKm, err := strconv.ParseFloat(os.Getenv("NEARBY_RADIUS_KM"), 64)
+
+	if err != nil || radiusKm <= 0 {
+		return DefaultNearbyRadiusKm
+	}
+
+	return radiusKm
+}
+
+func (m *MovieDB) searchRadiusKm(radiusKm float64) float64 {
+	if radiusKm > 0 {
+		return min(radiusKm, MaxNearbyRadiusKm)
+	}
+
+	if m.NearbyRadiusKm > 0 {
+		return m.NearbyRadiusKm
+	}
+
+	return DefaultNearbyRadiusKm
+}
+
+/*
+nearbyVenuesQuery selects the venue_id and distance_km of every venue matching location
+
+The query is meant to be used as a subquery aliased nearby, ST_DWithin on the generated location column uses the idx_venues_location index.
+Without a point every venue of the region matches and distance_km is 0
+*/
+func (m *MovieDB) nearbyVenuesQuery(location Location) *gorm.DB {
+	query := m.DB.Conn.Model(&models.Venue{})
+
+	if location.RegionCode != "" {
+		query = query.Where("venues.region_code = ?", location.RegionCode)
+	}
+
+	if !location.HasPoint() {
+		return query.Select("venues.id AS venue_id, 0::float8 AS distance_km")
+	}
+
+	point := location.Point
+
+	return query.
+		Select("venues.id AS venue_id, ST_Distance(venues.location, "+geographyPoint+") / 1000 AS distance_km", point.Longitude, point.Latitude).
+		Where("ST_DWithin(venues.location, "+geographyPoint+", ?)", point.Longitude, point.Latitude, m.searchRadiusKm(location.RadiusKm)*1000)
+}
+
+/*
+NearbyVenues returns the venues matching location sorted by distance, closest first
+
+	location: position and radius of the customer, or a region code
+	scopes: optional extra conditions on the nearby subquery, e.g. only venues showing a movie
+*/
+func (m *MovieDB) NearbyVenues(location Location, scopes ...func(*gorm.DB) *gorm.DB) ([]NearbyVenue, int, error) {
+	if location.IsZero() {
+		return nil, 400, errors.New("coordinates or a region code are required")
+	}
+
+	if err := location.Validate(); err != nil {
+		return nil, 400, err
+	}
+
+	var rows []nearbyVenueRow
+
+	result := m.DB.Conn.Table("(?) AS nearby", m.nearbyVenuesQuery(location)).
+		Select("nearby.venue_id, nearby.distance_km").
+		Scopes(scopes...).
+		Order("nearby.distance_km ASC, nearby.venue_id ASC").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, 500, result.Error
+	}
+
+	if len(rows) == 0 {
+		return []NearbyVenue{}, 200, nil
+	}
+
+	ids := make([]uint, 0, len(rows))
+
+	for _, row := range rows {
+		ids = append(ids, row.VenueID)
+	}
+
+	var venues []models.Venue
+
+	result = m.DB.Conn.Where("id IN ?", ids).Find(&venues)
+
+	if result.Error != nil {
+		return nil, 500, result.Error
+	}
+
+	venuesByID := make(map[uint]models.Venue, len(venues))
+
+	for _, venue := range venues {
+		venuesByID[venue.ID] = venue
+	}
+
+	nearbyVenues := make([]NearbyVenue, 0, len(rows))
+
+	for _, row := range rows {
+		if venue, ok := venuesByID[row.VenueID]; ok {
+			nearbyVenues = append(nearbyVenues, NearbyVenue{Venue: venue, DistanceKm: row.DistanceKm})
+		}
+	}
+
+	return nearbyVenues, 200, nil
+}
+
+// nearbyMovies loads the movies of rows with their cast and crew, sorted by distance of the closest venue
+func (m *MovieDB) nearbyMovies(rows []nearbyMovieRow) ([]NearbyMovie, int, error) {
+	if len(rows) == 0 {
+		return []NearbyMovie{}, 200, nil
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].DistanceKm != rows[j].DistanceKm {
+			return rows[i].DistanceKm < rows[j].DistanceKm
+		}
+		return rows[i].MovieID < rows[j].MovieID
+	})
+
+	ids := make([]uint, 0, len(rows))
+
+	for _, row := range rows {
+		ids = append(ids, row.MovieID)
+	}
+
+	var movies []models.Movie
+
+	result := m.DB.Conn.Preload("CastCrew").Where("id IN ?", ids).Find(&movies)
+
+	if result.Error != nil {
+		return nil, 500, result.Error
+	}
+
+	moviesByID := make(map[uint]models.Movie, len(movies))
+
+	for _, movie := range movies {
+		moviesByID[movie.ID] = movie
+	}
+
+	nearbyMovies := make([]NearbyMovie, 0, len(rows))
+
+	for _, row := range rows {
+		if movie, ok := moviesByID[row.MovieID]; ok {
+			nearbyMovies = append(nearbyMovies, NearbyMovie{Movie: movie, VenueID: row.VenueID, DistanceKm: row.DistanceKm})
+		}
+	}
+
+	return nearbyMovies, 200, nil
+}
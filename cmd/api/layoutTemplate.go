@@ -0,0 +1,462 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/*
+newTemplateVersion checks a seat grid and turns it into a template version, see ParseSeatGrid
+
+The grid is stored in the TEXT format whatever format it came in so every version reads the same way
+*/
+func newTemplateVersion(data string, format string, prices map[string]int) (models.LayoutTemplateVersion, error) {
+	grid, err := ParseSeatGrid(data, format, prices)
+
+	if err != nil {
+		return models.LayoutTemplateVersion{}, err
+	}
+
+	venue := models.Venue{Rows: grid.Rows, Columns: grid.Columns, RowLabels: grid.RowLabels, LayoutCells: grid.Cells}
+
+	if err := ValidateVenueLayout(venue, grid.Seats); err != nil {
+		return models.LayoutTemplateVersion{}, err
+	}
+
+	text, err := FormatSeatGrid(BuildVenueLayout(venue, grid.Seats), SeatGridText)
+
+	if err != nil {
+		return models.LayoutTemplateVersion{}, err
+	}
+
+	used, err := SeatGridPrices(grid.Seats)
+
+	if err != nil {
+		return models.LayoutTemplateVersion{}, err
+	}
+
+	version := models.LayoutTemplateVersion{Grid: text}
+
+	for seatType, price := range used {
+		version.Prices = append(version.Prices, fmt.Sprintf("%s=%d", seatType, price))
+	}
+
+	slices.Sort(version.Prices)
+
+	return version, nil
+}
+
+/*
+CreateLayoutTemplate saves a seat grid under a name so it can be applied to any venue
+
+	data, format, prices: the seat grid, see ParseSeatGrid
+	currency: of the prices, empty is DefaultCurrency. The template can only be applied to venues charging in it
+*/
+func (m *MovieDB) CreateLayoutTemplate(name string, description string, data string, format string, prices map[string]int, currency string) (models.LayoutTemplate, int, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return models.LayoutTemplate{}, 400, errors.New("template name is required")
+	}
+
+	currency, err := ParseCurrency(currency)
+
+	if err != nil {
+		return models.LayoutTemplate{}, 400, err
+	}
+
+	version, err := newTemplateVersion(data, format, prices)
+
+	if err != nil {
+		return models.LayoutTemplate{}, 400, err
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var count int64
+
+	if err := tx.Model(&models.LayoutTemplate{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 500, err
+	}
+
+	if count > 0 {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 409, fmt.Errorf("layout template %s already exists", name)
+	}
+
+	version.Version = 1
+
+	template := models.LayoutTemplate{
+		Name:        name,
+		Description: description,
+		Version:     1,
+		Currency:    currency,
+		Versions:    []models.LayoutTemplateVersion{version},
+	}
+
+	if err := tx.Create(&template).Error; err != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return models.LayoutTemplate{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return template, 200, nil
+}
+
+/*
+UpdateLayoutTemplate writes a new version of a template
+
+	currency: of the prices, empty for the currency of the template. Another currency is refused
+
+Venues keep the version they were given, the new one only reaches a venue when it is applied again
+*/
+func (m *MovieDB) UpdateLayoutTemplate(templateID uint, data string, format string, prices map[string]int, currency string) (models.LayoutTemplate, int, error) {
+	version, err := newTemplateVersion(data, format, prices)
+
+	if err != nil {
+		return models.LayoutTemplate{}, 400, err
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var template models.LayoutTemplate
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, templateID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 404, fmt.Errorf("layout template %d not found", templateID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 500, result.Error
+	}
+
+	if err := checkCurrency(currency, template.Currency); err != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 400, err
+	}
+
+	template.Version++
+
+	version.TemplateID = template.ID
+	version.Version = template.Version
+
+	if err := tx.Create(&version).Error; err != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 500, err
+	}
+
+	if err := tx.Model(&models.LayoutTemplate{}).Where("id = ?", template.ID).Update("version", template.Version).Error; err != nil {
+		tx.Rollback()
+		return models.LayoutTemplate{}, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return models.LayoutTemplate{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	template.Versions = []models.LayoutTemplateVersion{version}
+
+	return template, 200, nil
+}
+
+// GetLayoutTemplate returns a template with all of its versions, the latest last
+func (m *MovieDB) GetLayoutTemplate(templateID uint) (models.LayoutTemplate, int, error) {
+	var template models.LayoutTemplate
+
+	result := m.DB.Conn.Preload("Versions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("version ASC")
+	}).First(&template, templateID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return models.LayoutTemplate{}, 404, fmt.Errorf("layout template %d not found", templateID)
+	}
+
+	if result.Error != nil {
+		return models.LayoutTemplate{}, 500, result.Error
+	}
+
+	return template, 200, nil
+}
+
+/*
+ApplyLayoutTemplate gives a venue the seats, cells and row labels of a version of a template
+
+	version: 0 applies the latest version
+
+The venue cannot have upcoming shows on sale, their tickets were sold against the seats it has now, and has to charge in
+the currency of the template
+*/
+func (m *MovieDB) ApplyLayoutTemplate(templateID uint, version int, venueID uint) (VenueLayout, int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var template models.LayoutTemplate
+
+	result := tx.First(&template, templateID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return VenueLayout{}, 404, fmt.Errorf("layout template %d not found", templateID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, result.Error
+	}
+
+	if version == 0 {
+		version = template.Version
+	}
+
+	var templateVersion models.LayoutTemplateVersion
+
+	result = tx.Where("template_id = ? AND version = ?", templateID, version).First(&templateVersion)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return VenueLayout{}, 404, fmt.Errorf("layout template %d has no version %d", templateID, version)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, result.Error
+	}
+
+	prices, err := ParseSeatPrices(strings.Join(templateVersion.Prices, ","))
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	grid, err := ParseSeatGrid(templateVersion.Grid, SeatGridText, prices)
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	venue, status, err := lockVenueForLayout(tx, venueID)
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, status, err
+	}
+
+	if venue.Currency != template.Currency {
+		tx.Rollback()
+		return VenueLayout{}, 409, fmt.Errorf("layout template %d is priced in %s, venue %d charges in %s", templateID, template.Currency, venueID, venue.Currency)
+	}
+
+	if status, err := writeSeatGrid(tx, &venue, grid, false); err != nil {
+		tx.Rollback()
+		return VenueLayout{}, status, err
+	}
+
+	venue.LayoutTemplateID = &template.ID
+	venue.LayoutVersion = version
+
+	err = tx.Model(&models.Venue{}).Where("id = ?", venueID).Updates(map[string]any{
+		"layout_template_id": venue.LayoutTemplateID,
+		"layout_version":     venue.LayoutVersion,
+	}).Error
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return VenueLayout{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return BuildVenueLayout(venue, venue.Seats), 200, nil
+}
+
+/*
+CloneSeatLayout copies the seats, cells, sections, row labels, size and screen position of one venue to another
+
+The target venue keeps a seat at a position both venues have a seat at, like ApplyLayoutTemplate it cannot have
+upcoming shows on sale. Both venues have to charge in the same currency as the prices are copied
+*/
+func (m *MovieDB) CloneSeatLayout(fromVenueID uint, toVenueID uint) (VenueLayout, int, error) {
+	if fromVenueID == toVenueID {
+		return VenueLayout{}, 400, errors.New("cannot clone the seat layout of a venue to itself")
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var source models.Venue
+
+	result := tx.Preload("LayoutCells").Preload("Sections", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Seats").First(&source, fromVenueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return VenueLayout{}, 404, fmt.Errorf("venue %d not found", fromVenueID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, result.Error
+	}
+
+	if len(source.Seats) == 0 {
+		tx.Rollback()
+		return VenueLayout{}, 400, fmt.Errorf("venue %d has no seats to clone", fromVenueID)
+	}
+
+	venue, status, err := lockVenueForLayout(tx, toVenueID)
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, status, err
+	}
+
+	if venue.Currency != source.Currency {
+		tx.Rollback()
+		return VenueLayout{}, 409, fmt.Errorf("venue %d charges in %s, venue %d in %s", fromVenueID, source.Currency, toVenueID, venue.Currency)
+	}
+
+	grid := SeatGrid{
+		Rows:      source.Rows,
+		Columns:   source.Columns,
+		RowLabels: source.RowLabels,
+		Seats:     make([]models.SeatMatrix, 0, len(source.Seats)),
+		Cells:     make([]models.LayoutCell, 0, len(source.LayoutCells)),
+	}
+
+	for _, seat := range source.Seats {
+		grid.Seats = append(grid.Seats, models.SeatMatrix{
+			SeatNumber: seat.SeatNumber,
+			Type:       seat.Type,
+			Price:      seat.Price,
+			Row:        seat.Row,
+			Column:     seat.Column,
+		})
+	}
+
+	for _, cell := range source.LayoutCells {
+		grid.Cells = append(grid.Cells, models.LayoutCell{Row: cell.Row, Column: cell.Column, Kind: cell.Kind})
+	}
+
+	venue.ScreenPosition = source.ScreenPosition
+	venue.CurvedRows = source.CurvedRows
+	venue.LayoutTemplateID = source.LayoutTemplateID
+	venue.LayoutVersion = source.LayoutVersion
+	venue.Sections = make([]models.SeatSection, 0, len(source.Sections))
+
+	for _, section := range source.Sections {
+		venue.Sections = append(venue.Sections, models.SeatSection{
+			VenueID:     venue.ID,
+			Name:        section.Name,
+			FirstRow:    section.FirstRow,
+			LastRow:     section.LastRow,
+			FirstColumn: section.FirstColumn,
+			LastColumn:  section.LastColumn,
+		})
+	}
+
+	if status, err := writeSeatGrid(tx, &venue, grid, false); err != nil {
+		tx.Rollback()
+		return VenueLayout{}, status, err
+	}
+
+	err = tx.Model(&models.Venue{}).Where("id = ?", venue.ID).Updates(map[string]any{
+		"screen_position":    venue.ScreenPosition,
+		"curved_rows":        venue.CurvedRows,
+		"layout_template_id": venue.LayoutTemplateID,
+		"layout_version":     venue.LayoutVersion,
+	}).Error
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if err := tx.Unscoped().Where("venue_id = ?", venue.ID).Delete(&models.SeatSection{}).Error; err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if len(venue.Sections) > 0 {
+		if err := tx.Create(&venue.Sections).Error; err != nil {
+			tx.Rollback()
+			return VenueLayout{}, 500, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return VenueLayout{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return BuildVenueLayout(venue, venue.Seats), 200, nil
+}
+
+// lockVenueForLayout locks a venue whose seats are about to be replaced, a venue with upcoming shows on sale is refused
+func lockVenueForLayout(tx *gorm.DB, venueID uint) (models.Venue, int, error) {
+	var venue models.Venue
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Sections").First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return venue, 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		return venue, 500, result.Error
+	}
+
+	var shows int64
+
+	result = tx.Model(&models.MovieTimeSlot{}).
+		Where("venue_id = ? AND end_time > now() AND status IN ?", venueID, []string{ShowtimeOnSale, ShowtimeSoldOut}).
+		Count(&shows)
+
+	if result.Error != nil {
+		return venue, 500, result.Error
+	}
+
+	if shows > 0 {
+		return venue, 409, fmt.Errorf("venue %d has %d upcoming shows on sale, its seats cannot be replaced", venueID, shows)
+	}
+
+	return venue, 200, nil
+}
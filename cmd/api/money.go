@@ -0,0 +1,176 @@
+package api
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"golang.org/x/text/currency"
+	"gorm.io/gorm"
+)
+
+// DefaultCurrency is the currency of a venue that does not set one
+const DefaultCurrency = "INR"
+
+// Ways a venue rounds the prices it charges, the proto RoundingMode enum names them with a ROUND_ prefix
+const (
+	RoundHalfUp = "HALF_UP"
+	RoundUp     = "UP"
+	RoundDown   = "DOWN"
+)
+
+// Money is an amount in the minor units of its currency, e.g. 25050 INR is ₹250.50 and 250 JPY is ¥250
+type Money struct {
+	Amount   int64
+	Currency string // ISO 4217 code
+}
+
+// ParseCurrency checks an ISO 4217 currency code and returns it upper case, empty is DefaultCurrency
+func ParseCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if code == "" {
+		return DefaultCurrency, nil
+	}
+
+	unit, err := currency.ParseISO(code)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid currency %s, expected an ISO 4217 code like INR", code)
+	}
+
+	return unit.String(), nil
+}
+
+// MinorUnits is the number of digits after the decimal point of a currency, 2 for INR and 0 for JPY
+func MinorUnits(code string) int {
+	unit, err := currency.ParseISO(code)
+
+	if err != nil {
+		return 2
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+
+	return scale
+}
+
+// String formats the amount in major units, e.g. INR 250.50
+func (m Money) String() string {
+	digits := MinorUnits(m.Currency)
+
+	if digits == 0 {
+		return fmt.Sprintf("%s %d", m.Currency, m.Amount)
+	}
+
+	amount := m.Amount
+	sign := ""
+
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	unit := int64(1)
+
+	for range digits {
+		unit *= 10
+	}
+
+	return fmt.Sprintf("%s %s%d.%0*d", m.Currency, sign, amount/unit, digits, amount%unit)
+}
+
+// Rounding is how a venue rounds the prices it charges
+type Rounding struct {
+	Step int64  // Prices are a multiple of it in minor units, e.g. 100 for whole rupees. 0 or 1 does not round
+	Mode string // HALF_UP, UP or DOWN, empty is HALF_UP
+}
+
+// Round rounds an amount in minor units to a multiple of the step
+func (r Rounding) Round(amount int64) int64 {
+	if r.Step <= 1 {
+		return amount
+	}
+
+	floor := amount - amount%r.Step
+
+	if amount%r.Step < 0 {
+		floor -= r.Step
+	}
+
+	switch r.Mode {
+	case RoundDown:
+		return floor
+	case RoundUp:
+		if floor == amount {
+			return floor
+		}
+
+		return floor + r.Step
+	default:
+		if 2*(amount-floor) >= r.Step {
+			return floor + r.Step
+		}
+
+		return floor
+	}
+}
+
+// VenuePricing is what PriceSeat needs to know about the venue of a show
+type VenuePricing struct {
+	Location *time.Location // Days and times of price rules are local to it
+	Currency string         // Prices of seats and rules are in it
+	Rounding Rounding
+}
+
+// venuePricing loads the time zone, currency and rounding of a venue
+func venuePricing(db *gorm.DB, venueID uint) (VenuePricing, error) {
+	var venue models.Venue
+
+	result := db.Select("id", "timezone", "currency", "price_rounding", "rounding_mode").Where("id = ?", venueID).Find(&venue)
+
+	if result.Error != nil {
+		return VenuePricing{}, result.Error
+	}
+
+	loc, err := LoadTimezone(venue.Timezone)
+
+	if err != nil {
+		return VenuePricing{}, err
+	}
+
+	return VenuePricing{
+		Location: loc,
+		Currency: cmp.Or(venue.Currency, DefaultCurrency),
+		Rounding: Rounding{Step: int64(venue.PriceRounding), Mode: venue.RoundingMode},
+	}, nil
+}
+
+// venueCurrency is the currency the prices of a venue are in
+func venueCurrency(db *gorm.DB, venueID uint) (string, int, error) {
+	var venue models.Venue
+
+	result := db.Select("id", "currency").First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return "", 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		return "", 500, result.Error
+	}
+
+	return cmp.Or(venue.Currency, DefaultCurrency), 200, nil
+}
+
+// checkCurrency refuses an amount given in another currency than the one it is stored in, empty is taken as want
+func checkCurrency(got string, want string) error {
+	if got == "" || strings.EqualFold(got, want) {
+		return nil
+	}
+
+	return fmt.Errorf("prices are in %s, got %s", want, strings.ToUpper(got))
+}
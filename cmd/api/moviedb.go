@@ -1,65 +1,71 @@
 package api
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"net/mail"
+	"sort"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
 	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/kartik7120/booking_moviedb_service/cmd/producers"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 type MovieDB struct {
-	DB helper.DBConfig
+	DB             helper.DBConfig
+	Producer       *producers.Producer
+	HoldConfig     SeatHoldConfig
+	ScheduleConfig ScheduleConfig
+	NearbyRadiusKm float64 // Default radius of location based lookups
 }
 
 var validate *validator.Validate
 
 func NewMovieDB() *MovieDB {
 	validate = validator.New()
-	return &MovieDB{}
+	return &MovieDB{
+		HoldConfig:     DefaultSeatHoldConfig(),
+		ScheduleConfig: DefaultScheduleConfig(),
+		NearbyRadiusKm: DefaultNearbyRadiusKm,
+	}
 }
 
-func (m *MovieDB) GetCurrentMovies(
-	latitude float64,
-	longitude float64,
-) ([]models.Movie, int, error) {
-	var movies []models.Movie
-	var venues []models.Venue
+/*
+GetCurrentMovies lists the movies of the venues near the customer, sorted by distance of the closest venue
 
-	// Fetch all venues from the database
-	result := m.DB.Conn.Find(&venues)
+	location: position and radius of the customer, or a region code
+*/
+func (m *MovieDB) GetCurrentMovies(location Location) ([]NearbyMovie, int, error) {
+	var rows []nearbyMovieRow
 
-	if result.Error != nil {
-		return movies, 500, result.Error
+	if location.IsZero() {
+		return nil, 400, errors.New("coordinates or a region code are required")
 	}
 
-	// Filter venues within 30km radius
-	var nearbyVenues []models.Venue
-	for _, venue := range venues {
-		distance := helper.Haversine(latitude, longitude, venue.Latitude, venue.Longitude)
-		if distance <= 30 {
-			nearbyVenues = append(nearbyVenues, venue)
-		}
+	if err := location.Validate(); err != nil {
+		return nil, 400, err
 	}
 
-	// Fetch movies for the nearby venues
-	for _, venue := range nearbyVenues {
-		var venueMovies []models.Movie
-		result := m.DB.Conn.Model(&venue).Association("Movies").Find(&venueMovies)
+	// Only the closest venue of every movie is kept
 
-		if result.Error() != "" {
-			return movies, 500, errors.New("error fetching movies for venue")
-		}
+	result := m.DB.Conn.Table("(?) AS nearby", m.nearbyVenuesQuery(location)).
+		Select("DISTINCT ON (movie_venues.movie_id) movie_venues.movie_id, nearby.venue_id, nearby.distance_km").
+		Joins("JOIN movie_venues ON movie_venues.venue_id = nearby.venue_id").
+		Order("movie_venues.movie_id, nearby.distance_km").
+		Scan(&rows)
 
-		movies = append(movies, venueMovies...)
+	if result.Error != nil {
+		return nil, 500, result.Error
 	}
 
-	return movies, 200, nil
+	return m.nearbyMovies(rows)
 }
 
 func (m *MovieDB) GetMovieDetails(movieID uint) (models.Movie, int, error) {
@@ -72,30 +78,114 @@ func (m *MovieDB) GetMovieDetails(movieID uint) (models.Movie, int, error) {
 	return movie, 200, nil
 }
 
+/*
+GetMovieShowtimes fetches the shows of a movie ordered by start time
+
+	movieID: The ID of the movie
+	venueID: optional, only shows of this venue
+	movie_format: optional, only shows in this format
+	date: optional, only shows starting on this date in "YYYY-MM-DD" format, local to the time zone of each venue
+
+Start and end times come back in the time zone of the venue
+*/
 func (m *MovieDB) GetMovieShowtimes(movieID uint, venueID uint, movie_format string, date string) ([]models.MovieTimeSlot, int, error) {
 	var movie_time_slots []models.MovieTimeSlot
 
-	result := m.DB.Conn.Preload("Venue").Preload("MovieTimeSlots").Preload("MovieTimeSlots.SeatLayout").Preload("MovieTimeSlots.SeatLayout.Seats").Find(&models.Movie{}, movieID)
+	query := m.DB.Conn.Joins("JOIN venues ON venues.id = movie_time_slots.venue_id").Where("movie_time_slots.movie_id = ?", movieID)
+
+	if venueID != 0 {
+		query = query.Where("movie_time_slots.venue_id = ?", venueID)
+	}
+
+	if movie_format != "" {
+		variant, args := showVariantFilter("movie_time_slots", "", movie_format)
+		query = query.Where(variant, args...)
+	}
+
+	if date != "" {
+		d, err := time.Parse(time.DateOnly, date)
+
+		if err != nil {
+			return nil, 400, err
+		}
+
+		query = query.Where(localDateSQL("movie_time_slots.start_time", "venues.timezone")+" = ?", d.Format(time.DateOnly))
+	}
+
+	result := query.Order("movie_time_slots.start_time ASC").Order("movie_time_slots.id ASC").Find(&movie_time_slots)
 
 	if result.Error != nil {
 		return movie_time_slots, 500, result.Error
 	}
 
+	if err := m.localizeShowtimes(movie_time_slots); err != nil {
+		return movie_time_slots, 500, err
+	}
+
 	return movie_time_slots, 200, nil
 }
 
-func (m *MovieDB) GetMovieSeatLayout(movieID uint, venueID uint, movie_format string, date string, start_time string) (models.Venue, int, error) {
-	var venue models.Venue
-	result := m.DB.Conn.Where("id = ?", venueID).Find(&venue)
+/*
+GetMovieSeatLayout finds the show of a movie at a venue and returns its seat layout with the live status of every seat
+
+	movie_format: optional, the format of the show
+	date: optional, the date of the show in "YYYY-MM-DD" format
+	start_time: The start time of the show in RFC3339 format, or "HH:MM" together with date in the time zone of the venue
+*/
+func (m *MovieDB) GetMovieSeatLayout(movieID uint, venueID uint, movie_format string, date string, start_time string) (ShowSeatLayout, int, error) {
+	var movieTimeSlot models.MovieTimeSlot
+
+	startTime, err := time.Parse(time.RFC3339, start_time)
+
+	if err != nil {
+		loc, err := venueTimezone(m.DB.Conn, venueID)
+
+		if err != nil {
+			return ShowSeatLayout{}, 500, err
+		}
+
+		startTime, err = time.ParseInLocation(time.DateOnly+" 15:04", date+" "+start_time, loc)
+
+		if err != nil {
+			return ShowSeatLayout{}, 400, errors.New("start time should be in RFC3339 or HH:MM format along with the date")
+		}
+	}
+
+	query := m.DB.Conn.Where("movie_id = ? AND venue_id = ? AND start_time = ?", movieID, venueID, startTime)
+
+	if movie_format != "" {
+		variant, args := showVariantFilter("movie_time_slots", "", movie_format)
+		query = query.Where(variant, args...)
+	}
+
+	result := query.Find(&movieTimeSlot)
 
 	if result.Error != nil {
-		return venue, 500, result.Error
+		return ShowSeatLayout{}, 500, result.Error
 	}
 
-	return venue, 200, nil
+	if movieTimeSlot.ID == 0 {
+		return ShowSeatLayout{}, 404, errors.New("no show found for the given movie, venue and start time")
+	}
+
+	return m.GetShowSeatLayout(movieTimeSlot.ID)
 }
 
 func (m *MovieDB) AddVenue(venue models.Venue) (models.Venue, int, error) {
+	// A screen of a cinema shares the address and location of the site
+
+	if venue.CinemaID != nil {
+		var cinema models.Cinema
+
+		result := m.DB.Conn.First(&cinema, *venue.CinemaID)
+
+		if result.Error != nil {
+			return venue, 400, fmt.Errorf("cinema %d not found", *venue.CinemaID)
+		}
+
+		inheritCinemaSite(&venue, cinema)
+	}
+
 	err := validate.Struct(venue)
 	if err != nil {
 		return venue, 400, err
@@ -140,36 +230,38 @@ func (m *MovieDB) AddMovie(movie models.Movie, movieTimeSlots []models.MovieTime
 		return movie, 500, errors.New("failed to insert movie, no rows affected")
 	}
 
-	// Step 2: Insert Venues
+	// Step 2: The venues were inserted with the movie
 	for i := range movie.Venues {
 		venue := &movie.Venues[i]
 
-		// Create Venue
-
-		fmt.Println("Inserted Venue ID:", venue.ID) // Debugging
+		// Step 3: Insert Seat Matrices (from function parameter), before the time slots so they get booked seats
+		venueSeats := make([]models.SeatMatrix, len(seats))
 
-		// Step 3: Insert MovieTimeSlots (from function parameter)
-		for j := range movieTimeSlots {
-			movieTimeSlots[j].MovieID = movie.ID
-			movieTimeSlots[j].VenueID = venue.ID
+		for k := range seats {
+			venueSeats[k] = seats[k]
+			venueSeats[k].VenueID = venue.ID
 		}
 
-		if len(movieTimeSlots) > 0 {
-			if err := tx.Create(&movieTimeSlots).Error; err != nil {
+		if len(venueSeats) > 0 {
+			if err := tx.Create(&venueSeats).Error; err != nil {
 				tx.Rollback()
-				return movie, 500, fmt.Errorf("error inserting time slots: %v", err)
+				return movie, 500, fmt.Errorf("error inserting seat matrix: %v", err)
 			}
 		}
 
-		// Step 4: Insert Seat Matrices (from function parameter)
-		for k := range seats {
-			seats[k].VenueID = venue.ID
-		}
+		// Step 4: Insert MovieTimeSlots (from function parameter), they follow the same schedule rules as AddMovieTimeSlot
+		for _, movieTimeSlot := range movieTimeSlots {
+			movieTimeSlot.MovieID = movie.ID
+			movieTimeSlot.VenueID = venue.ID
 
-		if len(seats) > 0 {
-			if err := tx.Create(&seats).Error; err != nil {
+			if err := validate.Struct(movieTimeSlot); err != nil {
 				tx.Rollback()
-				return movie, 500, fmt.Errorf("error inserting seat matrix: %v", err)
+				return movie, 400, err
+			}
+
+			if _, status, err := m.addMovieTimeSlot(tx, movieTimeSlot); status != 200 || err != nil {
+				tx.Rollback()
+				return movie, status, err
 			}
 		}
 	}
@@ -247,6 +339,34 @@ func (m *MovieDB) UpdateVenue(venueId uint, venue models.Venue) (models.Venue, i
 		return venue, 500, result.Error
 	}
 
+	// A screen of a cinema keeps the time zone of the cinema, it is changed through UpdateCinema
+
+	if existingVenue.CinemaID != nil {
+		venue.Timezone = ""
+	}
+
+	// Prices of the seats are in the minor units of the currency, they would change value with it
+
+	if venue.Currency != "" && venue.Currency != existingVenue.Currency {
+		var seatCount int64
+
+		if err := m.DB.Conn.Model(&models.SeatMatrix{}).Where("venue_id = ?", venueId).Count(&seatCount).Error; err != nil {
+			return venue, 500, err
+		}
+
+		if seatCount > 0 {
+			return venue, 409, fmt.Errorf("venue %d has %d seats priced in %s, its currency cannot change", venueId, seatCount, existingVenue.Currency)
+		}
+	}
+
+	// The seat map has to keep fitting when the grid shrinks
+
+	if (venue.Rows != 0 && venue.Rows < existingVenue.Rows) || (venue.Columns != 0 && venue.Columns < existingVenue.Columns) {
+		if status, err := checkVenueResize(m.DB.Conn, existingVenue, venue.Rows, venue.Columns); err != nil {
+			return venue, status, err
+		}
+	}
+
 	result = m.DB.Conn.Model(&existingVenue).Updates(&venue)
 
 	if result.Error != nil {
@@ -267,21 +387,125 @@ func (m *MovieDB) GetVenue(venueId uint) (models.Venue, int, error) {
 	return venue, 200, nil
 }
 
-// Used to fetch upcoming movies based on the range date given by user,starting from date + 2 weeks to date + 2 weeks + 1 month
-func (m *MovieDB) GetUpcomingMovies(date string) ([]models.Movie, int, error) {
-	// Parse the input date
-	d, err := time.Parse("2006-01-02", date)
+/*
+GetAllMovies lists the whole catalog ordered by ID
+
+	pageSize: number of movies per page, defaults to 20 and is capped at 100
+	pageToken: next page token returned by the previous call, empty for the first page
+	language: optional, only movies available in this language
+	genre: optional, only movies of this type
+*/
+func (m *MovieDB) GetAllMovies(pageSize int, pageToken string, language string, genre string) ([]models.Movie, string, int, error) {
+	var movies []models.Movie
+
+	lastID, err := helper.DecodePageToken(pageToken)
+
+	if err != nil {
+		return nil, "", 400, err
+	}
+
+	pageSize = helper.PageSize(pageSize)
+
+	query := m.DB.Conn.Where("id > ?", lastID)
+
+	if language != "" {
+		query = query.Where("? = ANY(language)", language)
+	}
+
+	if genre != "" {
+		query = query.Where("? = ANY(type)", genre)
+	}
+
+	// Fetch one extra row to know if there is a next page
+
+	result := query.Preload("CastCrew").Order("id ASC").Limit(pageSize + 1).Find(&movies)
+
+	if result.Error != nil {
+		return nil, "", 500, result.Error
+	}
+
+	nextPageToken := ""
+
+	if len(movies) > pageSize {
+		movies = movies[:pageSize]
+		nextPageToken = helper.EncodePageToken(movies[len(movies)-1].ID)
+	}
+
+	return movies, nextPageToken, 200, nil
+}
+
+/*
+GetAllVenues lists every venue ordered by ID
+
+	pageSize: number of venues per page, defaults to 20 and is capped at 100
+	pageToken: next page token returned by the previous call, empty for the first page
+	venueType: optional, only venues of this type
+*/
+func (m *MovieDB) GetAllVenues(pageSize int, pageToken string, venueType string) ([]models.Venue, string, int, error) {
+	var venues []models.Venue
+
+	lastID, err := helper.DecodePageToken(pageToken)
+
+	if err != nil {
+		return nil, "", 400, err
+	}
+
+	pageSize = helper.PageSize(pageSize)
+
+	query := m.DB.Conn.Where("id > ?", lastID)
+
+	if venueType != "" {
+		query = query.Where("type = ?", venueType)
+	}
+
+	result := query.Order("id ASC").Limit(pageSize + 1).Find(&venues)
+
+	if result.Error != nil {
+		return nil, "", 500, result.Error
+	}
+
+	nextPageToken := ""
+
+	if len(venues) > pageSize {
+		venues = venues[:pageSize]
+		nextPageToken = helper.EncodePageToken(venues[len(venues)-1].ID)
+	}
+
+	return venues, nextPageToken, 200, nil
+}
+
+/*
+GetUpcomingMovies lists the movies released after the given day and within the next 7 months
+
+	date: the day in "YYYY-MM-DD" format, empty is today in timezone
+	timezone: optional IANA time zone of the customer, empty is UTC
+*/
+func (m *MovieDB) GetUpcomingMovies(date string, timezone string) ([]models.Movie, int, error) {
+	loc, err := LoadTimezone(timezone)
 	if err != nil {
 		return nil, 400, err
 	}
 
+	// Parse the input date
+	d := time.Now().In(loc)
+
+	if date != "" {
+		d, err = time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, 400, err
+		}
+	}
+
 	// Calculate start and end dates
 	startDate := d.AddDate(0, 0, 1)
 	endDate := d.AddDate(0, 7, 0)
 
-	// Query the database
+	// Query the database, release dates are calendar days so they are compared as days
 	var movies []models.Movie
-	result := m.DB.Conn.Table("movies").Where("release_date BETWEEN ? AND ?", startDate, endDate).Preload("CastCrew").Find(&movies)
+	result := m.DB.Conn.Table("movies").
+		Where(releaseDateSQL+" BETWEEN ? AND ?", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)).
+		Preload("CastCrew").
+		Find(&movies)
 
 	if result.Error != nil {
 		return nil, 500, result.Error
@@ -291,44 +515,56 @@ func (m *MovieDB) GetUpcomingMovies(date string) ([]models.Movie, int, error) {
 	return movies, 200, nil
 }
 
-func (m *MovieDB) GetNowPlayingMovies(longitude, latitude int32) ([]models.Movie, int, error) {
-	today := time.Now().Truncate(24 * time.Hour)
+/*
+GetNowPlayingMovies lists the movies released today or earlier that have shows
 
-	var movies []models.Movie
+	location: position and radius of the customer or a region code, the zero location lists the movies of every venue
+*/
+func (m *MovieDB) GetNowPlayingMovies(location Location) ([]NearbyMovie, int, error) {
+	// Today is the local day of each venue, a movie can already be playing in one time zone and not yet in another
+
+	today := localTodaySQL("v.timezone")
+
+	var rows []nearbyMovieRow
+
+	if err := location.Validate(); err != nil {
+		return nil, 400, err
+	}
 
-	if longitude == 0 && latitude == 0 {
+	if location.IsZero() {
 		// If no coordinates are provided, fetch all movies released today or earlier
-		err := m.DB.Conn.
-			Joins("JOIN movie_time_slots mts ON mts.movie_id = movies.id").
-			Where("movies.release_date <= ?", today).
-			Where("DATE(mts.date) <= ?", today).
-			Preload("CastCrew").
-			Group("movies.id").
-			Find(&movies).Error
+		err := m.DB.Conn.Model(&models.Movie{}).
+			Select("DISTINCT movies.id AS movie_id").
+			Joins("JOIN movie_time_slots mts ON mts.movie_id = movies.id AND mts.deleted_at IS NULL AND mts.status <> ?", ShowtimeCancelled).
+			Joins("JOIN venues v ON v.id = mts.venue_id AND v.deleted_at IS NULL").
+			Where(releaseDateSQL + " <= " + today).
+			Where(localDateSQL("mts.start_time", "v.timezone") + " <= " + today).
+			Scan(&rows).Error
 
 		if err != nil {
 			return nil, 500, err
 		}
 
-		return movies, 200, nil
+		return m.nearbyMovies(rows)
 	}
 
-	// If coordinates are provided, fetch movies released today or earlier and within 30km of the coordinates
+	// If a location is provided, fetch movies released today or earlier showing at a nearby venue, keeping the closest one
 
-	err := m.DB.Conn.
-		Joins("JOIN movie_time_slots mts ON mts.movie_id = movies.id").
-		Joins("JOIN venues venue ON mts.venue_id = venue.id"). // Add this JOIN
-		Where("movies.release_date <= ?", today).
-		Where("DATE(mts.date) <= ?", today).
-		Where("ST_DistanceSphere(ST_MakePoint(?, ?), ST_MakePoint(venue.longitude, venue.latitude)) <= ?", longitude, latitude, 30000).
-		Preload("CastCrew").
-		Group("movies.id").
-		Find(&movies).Error
+	err := m.DB.Conn.Table("(?) AS nearby", m.nearbyVenuesQuery(location)).
+		Select("DISTINCT ON (mts.movie_id) mts.movie_id, nearby.venue_id, nearby.distance_km").
+		Joins("JOIN movie_time_slots mts ON mts.venue_id = nearby.venue_id AND mts.deleted_at IS NULL AND mts.status <> ?", ShowtimeCancelled).
+		Joins("JOIN movies ON movies.id = mts.movie_id AND movies.deleted_at IS NULL").
+		Joins("JOIN venues v ON v.id = nearby.venue_id").
+		Where(releaseDateSQL + " <= " + today).
+		Where(localDateSQL("mts.start_time", "v.timezone") + " <= " + today).
+		Order("mts.movie_id, nearby.distance_km").
+		Scan(&rows).Error
 
 	if err != nil {
 		return nil, 500, err
 	}
-	return movies, 200, nil
+
+	return m.nearbyMovies(rows)
 }
 
 func (m *MovieDB) AddReview(review models.Review) (models.Review, int, error) {
@@ -492,168 +728,343 @@ func (m *MovieDB) GetAllMovieReviews(movieID uint, limit int, offset int, sortBy
 }
 
 /*
-GetMovieTimeSlots fetches movie time slots based on the given date range, movie ID, and venue ID
+GetMovieTimeSlots fetches the movie time slots of the venues near the customer, venues are sorted by distance
 
-	startTime: The start date in "YYYY-MM-DD:HH:MM:SS" format
-	endTime: The end date in "YYYY-MM-DD:HH:MM:SS" format
+	startDate: The first day in "YYYY-MM-DD" format, days are local to the time zone of each venue
+	endDate: The last day in "YYYY-MM-DD" format, included
 	movieID: The ID of the movie
-	venueID: The ID of the venue
+	location: position and radius of the customer, or a region code
+	language: optional, only shows in this audio language
+	movieFormat: optional, only shows in this format, e.g. IMAX
 */
-func (m *MovieDB) GetMovieTimeSlots(startDate string, endDate string, movieID uint, latitude float32, longitude float32) ([]models.Venue, []models.MovieTimeSlot, int, error) {
+func (m *MovieDB) GetMovieTimeSlots(startDate string, endDate string, movieID uint, location Location, language string, movieFormat string) ([]NearbyVenue, []models.MovieTimeSlot, int, error) {
 	var movieTimeSlots []models.MovieTimeSlot
-	var venues []models.Venue
-	// Parse the input dates
 
-	start, err := time.Parse(time.DateOnly, startDate)
-
-	fmt.Println("Start date:", start)
+	start, end, err := parseDateRange(startDate, endDate)
 
 	if err != nil {
 		return nil, nil, 400, err
 	}
 
-	end, err := time.Parse(time.DateOnly, endDate)
+	// Only the nearby venues showing the movie in the date range, a show is on the day it starts in the time zone of its venue
 
-	fmt.Println("End date:", end)
+	showsMovie := func(db *gorm.DB) *gorm.DB {
+		variant, variantArgs := showVariantFilter("mts", language, movieFormat)
 
-	end = helper.EndOfDay(end)
+		args := append([]any{ShowtimeCancelled, movieID, start.Format(time.DateOnly), end.Format(time.DateOnly)}, variantArgs...)
 
-	if err != nil {
-		return nil, nil, 400, err
+		return db.Where(`EXISTS (SELECT 1 FROM movie_time_slots mts JOIN venues v ON v.id = mts.venue_id
+			WHERE mts.venue_id = nearby.venue_id AND mts.deleted_at IS NULL AND mts.status <> ? AND mts.movie_id = ?
+			AND `+localDateSQL("mts.start_time", "v.timezone")+` BETWEEN ? AND ? AND `+variant+`)`, args...)
+	}
+
+	venues, status, err := m.NearbyVenues(location, showsMovie)
+
+	if status != 200 || err != nil {
+		return nil, nil, status, err
+	}
+
+	if len(venues) == 0 {
+		return venues, movieTimeSlots, 200, nil
 	}
 
-	// Query the database
-	result := m.DB.Conn.Debug().
-		Preload("MovieTimeSlots", func(db *gorm.DB) *gorm.DB {
-			return db.
-				Where("movie_id = ? AND start_time >= ? AND end_time <= ?", movieID, start.UTC(), end.UTC())
-		}).
-		Joins("JOIN movie_time_slots mts ON mts.venue_id = venues.id").
-		Where("mts.movie_id = ? AND mts.start_time >= ? AND mts.end_time <= ?", movieID, start.UTC(), end.UTC()).
-		// Adding Haversine formula in ORDER BY to calculate distance and sort results
-		Order(fmt.Sprintf(`
-        6371 * acos(
-            cos(radians(%f)) * cos(radians(venues.latitude)) *
-            cos(radians(venues.longitude) - radians(%f)) +
-            sin(radians(%f)) * sin(radians(venues.latitude))
-        ) ASC`, latitude, longitude, latitude)).
-		Group("venues.id").
-		Find(&venues)
-
-	for _, v := range venues {
-		movieTimeSlots = append(movieTimeSlots, v.MovieTimeSlots...)
+	venueIDs := make([]uint, 0, len(venues))
+	venueIndex := make(map[uint]int, len(venues))
+
+	for i, v := range venues {
+		venueIDs = append(venueIDs, v.Venue.ID)
+		venueIndex[v.Venue.ID] = i
 	}
 
+	variant, variantArgs := showVariantFilter("movie_time_slots", language, movieFormat)
+
+	result := m.DB.Conn.
+		Joins("JOIN venues ON venues.id = movie_time_slots.venue_id").
+		Where(variant, variantArgs...).
+		Where("movie_time_slots.venue_id IN ? AND movie_time_slots.movie_id = ? AND movie_time_slots.status <> ?", venueIDs, movieID, ShowtimeCancelled).
+		Where(localDateSQL("movie_time_slots.start_time", "venues.timezone")+" BETWEEN ? AND ?", start.Format(time.DateOnly), end.Format(time.DateOnly)).
+		Order("movie_time_slots.start_time ASC, movie_time_slots.id ASC").
+		Find(&movieTimeSlots)
+
 	if result.Error != nil {
 		return nil, nil, 500, result.Error
 	}
 
+	if err := m.localizeShowtimes(movieTimeSlots); err != nil {
+		return nil, nil, 500, err
+	}
+
+	// Closest venue first, then by start time
+
+	sort.SliceStable(movieTimeSlots, func(i, j int) bool {
+		return venueIndex[movieTimeSlots[i].VenueID] < venueIndex[movieTimeSlots[j].VenueID]
+	})
+
+	for _, v := range movieTimeSlots {
+		i := venueIndex[v.VenueID]
+		venues[i].Venue.MovieTimeSlots = append(venues[i].Venue.MovieTimeSlots, v)
+	}
+
 	return venues, movieTimeSlots, 200, nil
 }
 
+/*
+UpdateMovieTimeSlot changes a show, zero fields of updatedMovieTimeSlot are left as they are
+
+The updated show has to follow the same scheduling rules as a new one, a ScheduleConflictError is returned otherwise.
+Moving a show to another venue is not supported as its seats belong to the venue
+*/
 func (m *MovieDB) UpdateMovieTimeSlot(movieTimeSlotID uint, updatedMovieTimeSlot models.MovieTimeSlot) (models.MovieTimeSlot, int, error) {
 	err := validate.Struct(updatedMovieTimeSlot)
 	if err != nil {
 		return updatedMovieTimeSlot, 400, err
 	}
 
-	result := m.DB.Conn.First(&models.MovieTimeSlot{}, movieTimeSlotID)
-
-	if result.Error != nil {
-		return updatedMovieTimeSlot, 500, result.Error
-	}
-
-	result = m.DB.Conn.Model(&models.MovieTimeSlot{}).Where("id = ?", movieTimeSlotID).Updates(&updatedMovieTimeSlot)
+	tx := m.DB.Conn.Begin()
 
-	if result.Error != nil {
-		return updatedMovieTimeSlot, 500, result.Error
+	if tx.Error != nil {
+		return updatedMovieTimeSlot, 500, tx.Error
 	}
 
-	return updatedMovieTimeSlot, 200, nil
-}
-
-func (m *MovieDB) DeleteMovieTimeSlot(movieTimeSlotID uint) (int, error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
 
 	var movieTimeSlot models.MovieTimeSlot
 
-	result := m.DB.Conn.Unscoped().Where("id = ?", movieTimeSlotID).First(&movieTimeSlot)
+	result := tx.First(&movieTimeSlot, movieTimeSlotID)
 
-	if result.Error != nil {
-		return 500, result.Error
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return updatedMovieTimeSlot, 404, errors.New("movie time slot not found")
 	}
 
-	result = m.DB.Conn.Unscoped().Delete(&models.MovieTimeSlot{}, movieTimeSlotID)
-
 	if result.Error != nil {
-		return 500, result.Error
+		tx.Rollback()
+		return updatedMovieTimeSlot, 500, result.Error
 	}
 
-	return 200, nil
-}
-
-func (m *MovieDB) AddMovieTimeSlot(movieTimeSlot models.MovieTimeSlot) (models.MovieTimeSlot, int, error) {
-	fmt.Println("calling AddMovieTimeSlot in moviedb.go file")
+	if updatedMovieTimeSlot.VenueID != 0 && updatedMovieTimeSlot.VenueID != movieTimeSlot.VenueID {
+		tx.Rollback()
+		err := &ScheduleConflictError{Reason: ScheduleConflictVenueNotMovable, Message: "a show cannot be moved to another venue"}
+		return updatedMovieTimeSlot, err.Status(), err
+	}
 
-	err := validate.Struct(movieTimeSlot)
-	if err != nil {
-		return movieTimeSlot, 400, err
+	if movieTimeSlot.Status == ShowtimeCancelled || movieTimeSlot.Status == ShowtimeCompleted {
+		tx.Rollback()
+		return updatedMovieTimeSlot, 409, fmt.Errorf("show is %s and cannot be changed", movieTimeSlot.Status)
 	}
 
-	result := m.DB.Conn.Create(&movieTimeSlot)
+	if !updatedMovieTimeSlot.StartTime.IsZero() {
+		movieTimeSlot.StartTime = updatedMovieTimeSlot.StartTime
+	}
 
-	if result.Error != nil {
-		return movieTimeSlot, 500, result.Error
+	if !updatedMovieTimeSlot.EndTime.IsZero() {
+		movieTimeSlot.EndTime = updatedMovieTimeSlot.EndTime
 	}
 
-	// When a time slot is created, take the venue ID and fetch it's seat matrix and then add booked seats with corresponding seat matrix ID and movie slot ID
+	if !updatedMovieTimeSlot.Date.IsZero() {
+		movieTimeSlot.Date = updatedMovieTimeSlot.Date
+	}
 
-	var seatMatrix []models.SeatMatrix
+	if updatedMovieTimeSlot.MovieID != 0 {
+		movieTimeSlot.MovieID = updatedMovieTimeSlot.MovieID
+	}
 
-	result = m.DB.Conn.Where("venue_id = ?", movieTimeSlot.VenueID).Find(&seatMatrix)
+	if updatedMovieTimeSlot.MovieFormat != "" {
+		movieTimeSlot.MovieFormat = updatedMovieTimeSlot.MovieFormat
+	}
 
-	var bookedSeats []models.BookedSeats
+	if updatedMovieTimeSlot.Language != "" {
+		movieTimeSlot.Language = updatedMovieTimeSlot.Language
+	}
 
-	for _, seat := range seatMatrix {
-		bookedSeat := models.BookedSeats{
-			SeatNumber:      seat.SeatNumber,
-			MovieTimeSlotID: movieTimeSlot.ID,
-			SeatMatrixID:    seat.ID,
-			IsBooked:        false,
-		}
-		bookedSeats = append(bookedSeats, bookedSeat)
+	if updatedMovieTimeSlot.Subtitles != nil {
+		movieTimeSlot.Subtitles = updatedMovieTimeSlot.Subtitles
 	}
 
-	result = m.DB.Conn.Create(&bookedSeats)
+	// A new start or end time without a duration takes the duration from the new times
 
-	if result.Error != nil && result.Error.Error() == "ERROR: duplicate key value violates unique constraint \"idx_unique_seat\" (SQLSTATE 23505)" {
-		return movieTimeSlot, 400, errors.New("ERROR: duplicate key value violates unique constraint \"idx_unique_seat\" (SQLSTATE 23505)")
+	if updatedMovieTimeSlot.Duration != 0 || !updatedMovieTimeSlot.StartTime.IsZero() || !updatedMovieTimeSlot.EndTime.IsZero() {
+		movieTimeSlot.Duration = updatedMovieTimeSlot.Duration
 	}
 
-	if result.Error != nil && result.Error.Error() == "ERROR: duplicate key value violates unique constraint \"uni_seat_matrices_seat_number\" (SQLSTATE 23505)" {
-		return movieTimeSlot, 400, errors.New("duplicate seat number found")
+	if status, err := m.checkSchedule(tx, &movieTimeSlot); err != nil {
+		tx.Rollback()
+		return updatedMovieTimeSlot, status, err
 	}
 
+	result = tx.Save(&movieTimeSlot)
+
 	if result.Error != nil {
-		return movieTimeSlot, 500, result.Error
+		tx.Rollback()
+		return updatedMovieTimeSlot, 500, result.Error
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return updatedMovieTimeSlot, 500, fmt.Errorf("commit error: %v", err)
 	}
 
 	return movieTimeSlot, 200, nil
 }
 
-func (m *MovieDB) AddSeatMatrix(venueID int, seatMatrix []models.SeatMatrix) (int, error) {
+/*
+DeleteMovieTimeSlot removes a show nobody bought or is buying seats for, the show and its seats are kept for history
 
-	for i := range seatMatrix {
-		err := validate.Struct(seatMatrix[i])
+A show with sold or held seats is refused with a HAS_BOOKINGS conflict, it has to be cancelled with CancelShowtime so the customers are refunded
+*/
+func (m *MovieDB) DeleteMovieTimeSlot(movieTimeSlotID uint) (int, error) {
+	tx := m.DB.Conn.Begin()
 
-		if err != nil {
-			return 400, err
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
 		}
-	}
+	}()
 
-	for i := range seatMatrix {
+	movieTimeSlot, status, err := lockShowtime(tx, movieTimeSlotID)
+
+	if err != nil {
+		tx.Rollback()
+		return status, err
+	}
+
+	if err := removeScheduledShow(tx, movieTimeSlot); err != nil {
+		tx.Rollback()
+
+		var conflict *ScheduleConflictError
+
+		if errors.As(err, &conflict) {
+			conflict.Message += ", cancel the show instead"
+			return conflict.Status(), conflict
+		}
+
+		return 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return 200, nil
+}
+
+/*
+AddMovieTimeSlot adds a show and the seats that can be booked for it
+
+The show has to fit the schedule of its venue, a ScheduleConflictError naming the rule and the clashing show is returned otherwise
+*/
+func (m *MovieDB) AddMovieTimeSlot(movieTimeSlot models.MovieTimeSlot) (models.MovieTimeSlot, int, error) {
+	err := validate.Struct(movieTimeSlot)
+	if err != nil {
+		return movieTimeSlot, 400, err
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	if tx.Error != nil {
+		return movieTimeSlot, 500, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	movieTimeSlot, status, err := m.addMovieTimeSlot(tx, movieTimeSlot)
+
+	if status != 200 || err != nil {
+		tx.Rollback()
+		return movieTimeSlot, status, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return movieTimeSlot, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return movieTimeSlot, 200, nil
+}
+
+// addMovieTimeSlot checks the schedule and creates the show with its booked seats inside tx
+func (m *MovieDB) addMovieTimeSlot(tx *gorm.DB, movieTimeSlot models.MovieTimeSlot) (models.MovieTimeSlot, int, error) {
+	if err := validateNewShowtimeStatus(&movieTimeSlot); err != nil {
+		return movieTimeSlot, 400, err
+	}
+
+	if status, err := m.checkSchedule(tx, &movieTimeSlot); err != nil {
+		return movieTimeSlot, status, err
+	}
+
+	result := tx.Create(&movieTimeSlot)
+
+	if result.Error != nil {
+		return movieTimeSlot, 500, result.Error
+	}
+
+	// When a time slot is created, take the venue ID and fetch it's seat matrix and then add booked seats with corresponding seat matrix ID and movie slot ID
+
+	var seatMatrix []models.SeatMatrix
+
+	result = tx.Where("venue_id = ?", movieTimeSlot.VenueID).Find(&seatMatrix)
+
+	if result.Error != nil {
+		return movieTimeSlot, 500, result.Error
+	}
+
+	if len(seatMatrix) == 0 {
+		return movieTimeSlot, 200, nil
+	}
+
+	var bookedSeats []models.BookedSeats
+
+	for _, seat := range seatMatrix {
+		bookedSeat := models.BookedSeats{
+			SeatNumber:      seat.SeatNumber,
+			MovieTimeSlotID: movieTimeSlot.ID,
+			SeatMatrixID:    seat.ID,
+			IsBooked:        false,
+		}
+		bookedSeats = append(bookedSeats, bookedSeat)
+	}
+
+	result = tx.Create(&bookedSeats)
+
+	if result.Error != nil && result.Error.Error() == "ERROR: duplicate key value violates unique constraint \"idx_unique_seat\" (SQLSTATE 23505)" {
+		return movieTimeSlot, 400, errors.New("ERROR: duplicate key value violates unique constraint \"idx_unique_seat\" (SQLSTATE 23505)")
+	}
+
+	if result.Error != nil && result.Error.Error() == "ERROR: duplicate key value violates unique constraint \"uni_seat_matrices_seat_number\" (SQLSTATE 23505)" {
+		return movieTimeSlot, 400, errors.New("duplicate seat number found")
+	}
+
+	if result.Error != nil {
+		return movieTimeSlot, 500, result.Error
+	}
+
+	return movieTimeSlot, 200, nil
+}
+
+func (m *MovieDB) AddSeatMatrix(venueID int, seatMatrix []models.SeatMatrix) (int, error) {
+
+	for i := range seatMatrix {
+		err := validate.Struct(seatMatrix[i])
+
+		if err != nil {
+			return 400, err
+		}
+	}
+
+	for i := range seatMatrix {
 		seatMatrix[i].VenueID = uint(venueID)
 	}
 
+	if status, err := checkSeatPositions(m.DB.Conn, uint(venueID), seatMatrix); err != nil {
+		return status, err
+	}
+
 	for _, v := range seatMatrix {
 		var existingSeatMatrix models.SeatMatrix
 		m.DB.Conn.Where("row = ? AND column = ? AND venue_id = ?", v.SeatNumber, v.Row, v.Column, v.VenueID).First(&existingSeatMatrix)
@@ -691,7 +1102,15 @@ func (m *MovieDB) GetSeatMatrix(venueID int) ([]models.SeatMatrix, int, error) {
 	return seatMatrix, 200, nil
 }
 
-func (m *MovieDB) UpdateSeatMatrix(seatMatrixID uint, updatedSeatMatrix models.SeatMatrix) (models.SeatMatrix, int, error) {
+/*
+UpdateSeatMatrix changes a seat of a venue, fields left empty keep their value
+
+	mode: what to do when the seat is sold or held for an upcoming show, SeatChangeReject refuses the change and
+	SeatChangeUnsoldShows gives the shows that sold it a copy of the seat as it was
+
+Upcoming shows that did not sell the seat see the change straight away
+*/
+func (m *MovieDB) UpdateSeatMatrix(seatMatrixID uint, updatedSeatMatrix models.SeatMatrix, mode string) (models.SeatMatrix, int, error) {
 
 	// Use a transaction to ensure atomicity
 
@@ -709,18 +1128,91 @@ func (m *MovieDB) UpdateSeatMatrix(seatMatrixID uint, updatedSeatMatrix models.S
 		}
 	}()
 
-	result := tx.First(&models.SeatMatrix{}, seatMatrixID)
+	var existingSeat models.SeatMatrix
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existingSeat, seatMatrixID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return updatedSeatMatrix, 404, fmt.Errorf("seat %d not found", seatMatrixID)
+	}
 
 	if result.Error != nil {
 		tx.Rollback()
 		return updatedSeatMatrix, 500, result.Error
 	}
 
-	result = tx.Model(&models.SeatMatrix{}).Where("id = ?", seatMatrixID).Updates(&updatedSeatMatrix)
+	// A moved seat has to stay inside the seat map and off the other seats and cells
 
-	if result.Error != nil {
+	if (updatedSeatMatrix.Row != 0 && updatedSeatMatrix.Row != existingSeat.Row) || (updatedSeatMatrix.Column != 0 && updatedSeatMatrix.Column != existingSeat.Column) {
+		moved := existingSeat
+
+		if updatedSeatMatrix.Row != 0 {
+			moved.Row = updatedSeatMatrix.Row
+		}
+
+		if updatedSeatMatrix.Column != 0 {
+			moved.Column = updatedSeatMatrix.Column
+		}
+
+		if status, err := checkSeatPositions(tx, existingSeat.VenueID, []models.SeatMatrix{moved}); err != nil {
+			tx.Rollback()
+			return updatedSeatMatrix, status, err
+		}
+	}
+
+	sold, status, err := checkSoldSeats(tx, existingSeat.VenueID, []uint{seatMatrixID}, mode)
+
+	if err != nil {
 		tx.Rollback()
-		return updatedSeatMatrix, 500, result.Error
+		return updatedSeatMatrix, status, err
+	}
+
+	revised := models.SeatMatrix{
+		SeatNumber: cmp.Or(updatedSeatMatrix.SeatNumber, existingSeat.SeatNumber),
+		Row:        cmp.Or(updatedSeatMatrix.Row, existingSeat.Row),
+		Column:     cmp.Or(updatedSeatMatrix.Column, existingSeat.Column),
+		Price:      cmp.Or(updatedSeatMatrix.Price, existingSeat.Price),
+		VenueID:    existingSeat.VenueID,
+		Type:       cmp.Or(updatedSeatMatrix.Type, existingSeat.Type),
+	}
+
+	if len(sold) == 0 {
+		result = tx.Model(&models.SeatMatrix{}).Where("id = ?", seatMatrixID).Updates(&updatedSeatMatrix)
+
+		if result.Error != nil {
+			tx.Rollback()
+			return updatedSeatMatrix, 500, result.Error
+		}
+
+		revised.ID = seatMatrixID
+	} else {
+		// Shows that sold the seat keep the old row, which is removed from the venue, the others move to the copy
+
+		if err := tx.Delete(&models.SeatMatrix{}, seatMatrixID).Error; err != nil {
+			tx.Rollback()
+			return updatedSeatMatrix, 500, err
+		}
+
+		if err := tx.Create(&revised).Error; err != nil {
+			tx.Rollback()
+			return updatedSeatMatrix, 500, err
+		}
+	}
+
+	soldShows := []uint{0}
+
+	for _, seat := range sold {
+		soldShows = append(soldShows, seat.MovieTimeSlotID)
+	}
+
+	err = tx.Model(&models.BookedSeats{}).
+		Where("seat_matrix_id = ? AND movie_time_slot_id IN (?) AND movie_time_slot_id NOT IN ?", seatMatrixID, upcomingShows(tx, existingSeat.VenueID).Select("id"), soldShows).
+		Updates(map[string]any{"seat_matrix_id": revised.ID, "seat_number": revised.SeatNumber}).Error
+
+	if err != nil {
+		tx.Rollback()
+		return updatedSeatMatrix, 500, err
 	}
 
 	// Commit the transaction
@@ -729,40 +1221,90 @@ func (m *MovieDB) UpdateSeatMatrix(seatMatrixID uint, updatedSeatMatrix models.S
 		return updatedSeatMatrix, 500, fmt.Errorf("commit error: %v", err)
 	}
 
-	return updatedSeatMatrix, 200, nil
+	return revised, 200, nil
 }
 
-func (m *MovieDB) DeleteSeatMatrix(seatMatrixID uint) (int, error) {
+/*
+DeleteSeatMatrix removes a seat from a venue and from the upcoming shows that did not sell it
+
+	mode: what to do when the seat is sold or held for an upcoming show, see UpdateSeatMatrix
+
+The seat is soft deleted so the tickets and past shows that refer to it keep working
+*/
+func (m *MovieDB) DeleteSeatMatrix(seatMatrixID uint, mode string) (int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
 	var seatMatrix models.SeatMatrix
 
-	result := m.DB.Conn.Unscoped().Where("id = ?", seatMatrixID).First(&seatMatrix)
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&seatMatrix, seatMatrixID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return 404, fmt.Errorf("seat %d not found", seatMatrixID)
+	}
 
 	if result.Error != nil {
+		tx.Rollback()
 		return 500, result.Error
 	}
 
-	result = m.DB.Conn.Unscoped().Delete(&models.SeatMatrix{}, seatMatrixID)
+	if status, err := deleteSeats(tx, seatMatrix.VenueID, []uint{seatMatrixID}, mode); err != nil {
+		tx.Rollback()
+		return status, err
+	}
 
-	if result.Error != nil {
-		return 500, result.Error
+	if err := tx.Commit().Error; err != nil {
+		return 500, fmt.Errorf("commit error: %v", err)
 	}
 
 	return 200, nil
 }
 
-func (m *MovieDB) DeleteEntireSeatMatrix(venueID uint) (int, error) {
-	var seatMatrix []models.SeatMatrix
+// DeleteEntireSeatMatrix removes every seat of a venue, see DeleteSeatMatrix
+func (m *MovieDB) DeleteEntireSeatMatrix(venueID uint, mode string) (int, error) {
+	tx := m.DB.Conn.Begin()
 
-	result := m.DB.Conn.Unscoped().Where("venue_id = ?", venueID).Delete(&seatMatrix)
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var seatMatrixIDs []uint
+
+	result := tx.Model(&models.SeatMatrix{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("venue_id = ?", venueID).Pluck("id", &seatMatrixIDs)
 
 	if result.Error != nil {
+		tx.Rollback()
 		return 500, result.Error
 	}
 
+	if len(seatMatrixIDs) > 0 {
+		if status, err := deleteSeats(tx, venueID, seatMatrixIDs, mode); err != nil {
+			tx.Rollback()
+			return status, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 500, fmt.Errorf("commit error: %v", err)
+	}
+
 	return 200, nil
 }
 
-func (m *MovieDB) BookSeats(movieTimeSlotID int32, email string, phoneNumber string, seatToBeBooked []models.BookedSeats) (int, error) {
+// BookSeats fills in the customer details of seats held with LockBookedSeats, the hold token of that lock is required
+func (m *MovieDB) BookSeats(movieTimeSlotID int32, email string, phoneNumber string, seatToBeBooked []models.BookedSeats, holdToken string) (int, error) {
+
+	if holdToken == "" {
+		return 400, errors.New("hold token is required to book seats")
+	}
 
 	tx := m.DB.Conn.Begin()
 	if tx.Error != nil {
@@ -795,9 +1337,16 @@ func (m *MovieDB) BookSeats(movieTimeSlotID int32, email string, phoneNumber str
 			return 500, err
 		}
 
-		if existingSeat.IsBooked {
+		// Only the owner of the hold can book the seat
+
+		if !isHeldBy(existingSeat, holdToken) {
 			tx.Rollback()
-			return 400, fmt.Errorf("seat %s already booked", existingSeat.SeatNumber)
+			return 409, fmt.Errorf("seat %s is not held by the given hold token", existingSeat.SeatNumber)
+		}
+
+		if status, err := checkNotBlocked(tx, existingMovieTimeSlot, []models.BookedSeats{existingSeat}); err != nil {
+			tx.Rollback()
+			return status, err
 		}
 
 		// If seat has already phone number and email filled then it cannot be booked again.
@@ -859,12 +1408,16 @@ func (m *MovieDB) GetBookedSeats(movieTimeSlotID uint) ([]models.BookedSeats, in
 	return bookedSeats, 200, nil
 }
 
-func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMatrixIds []int32) (bool, []struct {
-	ID         int32
-	SeatNumber string
-	Price      int32
-	MovieName  string
-}, error) {
+/*
+IsValidToCommitSeatsForBooking checks that the seats of a movie time slot are held by the caller and can be committed,
+the seats are quoted with PriceSeat in the currency of the venue, then the convenience fee of the venue and the tax
+rules of its region are added. The quote is stored, CreateTicket charges its total
+
+	movie_time_slot_id: The ID of the movie time slot
+	seatMatrixIds: The seat matrix IDs of the seats to be booked
+	holdToken: The hold token returned by LockBookedSeats for these seats
+*/
+func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMatrixIds []int32, holdToken string) (bool, Quote, error) {
 
 	// Need to check if the seats in the seatMatrix for a particular venue and a particular time slots can be booked or not
 
@@ -875,11 +1428,11 @@ func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMat
 	result := m.DB.Conn.Model(&models.MovieTimeSlot{}).Where("id = ?", movie_time_slot_id).Find(&movieTimeSlot)
 
 	if result.Error != nil {
-		return false, nil, result.Error
+		return false, Quote{}, result.Error
 	}
 
 	if movieTimeSlot.ID == 0 {
-		return false, nil, errors.New("Movie time slot does not exists")
+		return false, Quote{}, errors.New("Movie time slot does not exists")
 	}
 
 	var movie models.Movie
@@ -887,24 +1440,39 @@ func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMat
 	result = m.DB.Conn.Model(&models.Movie{}).Where("id = ?", movieTimeSlot.MovieID).Find(&movie)
 
 	if result.Error != nil {
-		return false, nil, result.Error
+		return false, Quote{}, result.Error
 	}
 
 	if movie.ID == 0 {
-		return false, nil, errors.New("Movie does not exists")
+		return false, Quote{}, errors.New("Movie does not exists")
 	}
 
-	// Find the seatMatrix to which this movie time slot belongs
+	// Seats are quoted by the pricing engine, the price on the seat is only its default
 
-	// var toBeBookedSeats []models.BookedSeats
+	pricer, err := newShowPricer(m.DB.Conn, movieTimeSlot)
 
-	var toBeBookedSeats2 []struct {
-		ID         int32
-		SeatNumber string
-		Price      int32
-		MovieName  string
+	if err != nil {
+		return false, Quote{}, err
 	}
 
+	var venue models.Venue
+
+	result = m.DB.Conn.Select("id", "region_code", "convenience_fee").Where("id = ?", movieTimeSlot.VenueID).Find(&venue)
+
+	if result.Error != nil {
+		return false, Quote{}, result.Error
+	}
+
+	taxRules, err := quoteTaxRules(m.DB.Conn, pricer.venue.Currency, venue.RegionCode)
+
+	if err != nil {
+		return false, Quote{}, err
+	}
+
+	fee := Money{Amount: int64(venue.ConvenienceFee), Currency: pricer.venue.Currency}
+
+	var quoted []QuoteSeat
+
 	for _, v := range seatMatrixIds {
 
 		var bookedSeat models.BookedSeats
@@ -914,7 +1482,7 @@ func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMat
 		result := m.DB.Conn.Model(&models.BookedSeats{}).Where("movie_time_slot_id = ? AND seat_matrix_id = ?", movie_time_slot_id, v).Find(&bookedSeat)
 
 		if result.Error != nil {
-			return false, nil, result.Error
+			return false, Quote{}, result.Error
 		}
 
 		// Get the price of the seat
@@ -922,70 +1490,283 @@ func (m *MovieDB) IsValidToCommitSeatsForBooking(movie_time_slot_id int, seatMat
 		result = m.DB.Conn.Model(&models.SeatMatrix{}).Where("id = ?", bookedSeat.SeatMatrixID).Find(&seatMatrix)
 
 		if result.Error != nil {
-			return false, nil, result.Error
+			return false, Quote{}, result.Error
 		}
 
-		if bookedSeat.IsBooked == true {
-			return false, nil, errors.New("Seat is already booked")
+		if bookedSeat.ID == 0 {
+			return false, Quote{}, errors.New("seat does not exist")
 		}
 
-		if bookedSeat.ID == 0 {
-			return false, nil, errors.New("seat does not exist")
+		// The seat has to be held by the caller, any other booked seat is either sold or held by someone else
+
+		if !isHeldBy(bookedSeat, holdToken) || bookedSeat.Email != nil || bookedSeat.PhoneNumber != "" {
+			return false, Quote{}, errors.New("Seat is already booked")
+		}
+
+		if bookedSeat.LockedUntil == nil || bookedSeat.LockedUntil.Before(time.Now()) {
+			return false, Quote{}, errors.New("Seat hold has expired")
 		}
 
-		toBeBookedSeats2 = append(toBeBookedSeats2, struct {
-			ID         int32
-			SeatNumber string
-			Price      int32
-			MovieName  string
-		}{
-			ID:         int32(bookedSeat.ID),
-			SeatNumber: bookedSeat.SeatNumber,
-			Price:      int32(seatMatrix.Price),
-			MovieName:  movie.Title,
-		})
+		seat := QuoteTicket(pricer.price(seatMatrix), fee, venue.RegionCode, taxRules)
+		seat.BookedSeatID = bookedSeat.ID
+		seat.SeatMatrixID = bookedSeat.SeatMatrixID
+		seat.SeatNumber = bookedSeat.SeatNumber
+
+		quoted = append(quoted, seat)
+	}
+
+	quote := newQuote(movieTimeSlot, movie.Title, pricer.venue.Currency, quoted)
+
+	if err := saveQuote(m.DB.Conn, &quote, holdToken); err != nil {
+		return false, Quote{}, err
 	}
 
-	return true, toBeBookedSeats2, nil
+	return true, quote, nil
 }
 
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
 
-func (m *MovieDB) LockBookedSeats(bookedSeatsIDs []int32) (int, error) {
+// SeatHold describes the seats locked for a checkout and the token the caller has to present to use them
+type SeatHold struct {
+	HoldToken      string
+	LockedBy       string
+	LockedUntil    time.Time
+	ExtensionsLeft int
+	BookedSeats    []models.BookedSeats
+}
+
+/*
+LockBookedSeats locks the given booked seats while the payment is being processed
+
+	bookedSeatsIDs: IDs of the booked seats to lock, all of them have to belong to the same movie time slot
+	idempotentKey: idempotency key of the checkout, recorded as the owner of the lock so that only the same checkout can release it
+	holdDuration: how long to hold the seats, 0 uses the venue's hold duration or the server default. Capped at HoldConfig.MaxDuration
+
+The seats are read with SELECT ... FOR UPDATE and written in the same transaction, so when several users race for
+the same seats exactly one of them wins. The returned hold token has to be passed to BookSeats,
+IsValidToCommitSeatsForBooking and CreateTicket. Locking again with the same idempotent key returns the existing hold
+as it is, seats added to it expire with it. Only ExtendSeatHold moves the expiry of a hold.
+*/
+func (m *MovieDB) LockBookedSeats(bookedSeatsIDs []int32, idempotentKey string, holdDuration time.Duration) (SeatHold, int, error) {
+	var hold SeatHold
 	var bookedSeats []models.BookedSeats
 
-	// Lock the booked seats for the given IDs
+	if len(bookedSeatsIDs) == 0 {
+		return hold, 400, errors.New("no booked seats given to lock")
+	}
 
-	result := m.DB.Conn.Model(&models.BookedSeats{}).Where("id IN ?", bookedSeatsIDs).Find(&bookedSeats)
+	tx := m.DB.Conn.Begin()
+
+	if tx.Error != nil {
+		return hold, 500, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Rows are locked in id order so that two overlapping requests cannot deadlock each other
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", bookedSeatsIDs).Order("id").Find(&bookedSeats)
 
 	if result.Error != nil {
-		return 500, result.Error
+		tx.Rollback()
+		return hold, 500, result.Error
+	}
+
+	if len(bookedSeats) == 0 {
+		tx.Rollback()
+		return hold, 404, errors.New("no booked seats found for the given IDs")
 	}
 
-	// Before locking, check if the seats are already booked
+	if len(bookedSeats) != len(bookedSeatsIDs) {
+		tx.Rollback()
+		return hold, 404, errors.New("some of the booked seats do not exist")
+	}
 
 	for _, seat := range bookedSeats {
-		if seat.IsBooked {
-			return 400, fmt.Errorf("seat %s is already booked", seat.SeatNumber)
+		if seat.MovieTimeSlotID != bookedSeats[0].MovieTimeSlotID {
+			tx.Rollback()
+			return hold, 400, errors.New("all booked seats must belong to the same movie time slot")
 		}
 	}
 
-	// Before locking, check if it is locked by some other thread
+	if status, err := checkOnSale(tx, bookedSeats[0].MovieTimeSlotID); err != nil {
+		tx.Rollback()
+		return hold, status, err
+	}
+
+	// Seats removed from the venue after the show was created are blocked, see GetShowSeatLayout
+
+	seatMatrixIDs := make([]uint, 0, len(bookedSeats))
 
 	for _, seat := range bookedSeats {
-		if seat.LockedUntil != nil && seat.LockedUntil.After(time.Now()) {
-			return 400, fmt.Errorf("seat %s is already locked until %s", seat.SeatNumber, seat.LockedUntil.Format(time.RFC3339))
+		seatMatrixIDs = append(seatMatrixIDs, seat.SeatMatrixID)
+	}
+
+	var removedSeats []models.SeatMatrix
+
+	result = tx.Unscoped().Where("id IN ? AND deleted_at IS NOT NULL", seatMatrixIDs).Find(&removedSeats)
+
+	if result.Error != nil {
+		tx.Rollback()
+		return hold, 500, result.Error
+	}
+
+	if len(removedSeats) > 0 {
+		tx.Rollback()
+		return hold, 409, fmt.Errorf("seat %s is no longer part of the venue", removedSeats[0].SeatNumber)
+	}
+
+	var slot models.MovieTimeSlot
+
+	if err := tx.First(&slot, bookedSeats[0].MovieTimeSlotID).Error; err != nil {
+		tx.Rollback()
+		return hold, 500, err
+	}
+
+	if status, err := checkNotBlocked(tx, slot, bookedSeats); err != nil {
+		tx.Rollback()
+		return hold, status, err
+	}
+
+	venueHoldDuration, err := venueHoldDuration(tx, bookedSeats[0].MovieTimeSlotID)
+
+	if err != nil {
+		tx.Rollback()
+		return hold, 500, err
+	}
+
+	now := time.Now()
+	holdToken := ""
+	holdExtensions := 0
+	var heldUntil *time.Time
+	held := make(map[uint]bool, len(bookedSeats))
+
+	for _, seat := range bookedSeats {
+		if !seat.IsBooked {
+			continue
+		}
+
+		// A seat held by the same checkout can be locked again, this makes retries from the payment service safe
+
+		if idempotentKey != "" && seat.LockedBy != nil && *seat.LockedBy == idempotentKey && seat.HoldToken != nil &&
+			seat.LockedUntil != nil && seat.LockedUntil.After(now) {
+			holdToken = *seat.HoldToken
+			holdExtensions = seat.HoldExtensions
+			held[seat.ID] = true
+
+			if heldUntil == nil || seat.LockedUntil.Before(*heldUntil) {
+				heldUntil = seat.LockedUntil
+			}
+
+			continue
+		}
+
+		if seat.LockedUntil != nil && seat.LockedUntil.After(now) {
+			tx.Rollback()
+			return hold, 409, fmt.Errorf("seat %s is already locked until %s", seat.SeatNumber, seat.LockedUntil.Format(time.RFC3339))
+		}
+
+		// The lock expired but the seat may still have been sold before the sweeper got to it
+
+		if seat.LockedUntil == nil || seat.Email != nil || seat.PhoneNumber != "" {
+			tx.Rollback()
+			return hold, 409, fmt.Errorf("seat %s is already booked", seat.SeatNumber)
+		}
+
+		var ticketCount int64
+
+		err := tx.Model(&models.Ticket{}).Where("? = ANY(booked_seats_id)", seat.ID).Count(&ticketCount).Error
+
+		if err != nil {
+			tx.Rollback()
+			return hold, 500, err
+		}
+
+		if ticketCount > 0 {
+			tx.Rollback()
+			return hold, 409, fmt.Errorf("seat %s is already booked", seat.SeatNumber)
 		}
 	}
 
-	// Update the LockedUntil field to lock seat for next 15 minutes and all should happen in a transaction
+	if holdToken == "" {
+		token, err := helper.GenerateHoldToken()
+
+		if err != nil {
+			tx.Rollback()
+			return hold, 500, err
+		}
+
+		holdToken = token
+	}
+
+	lockedUntil := now.Add(m.HoldConfig.HoldDuration(holdDuration, venueHoldDuration))
+
+	// A retry must not keep the hold alive past its expiry, that is what the limit of ExtendSeatHold is for
+
+	if heldUntil != nil {
+		lockedUntil = *heldUntil
+	}
+
+	for i := range bookedSeats {
+		if held[bookedSeats[i].ID] && bookedSeats[i].LockedUntil.Equal(lockedUntil) {
+			continue
+		}
+
+		bookedSeats[i].LockedUntil = ptrTime(lockedUntil)
+		bookedSeats[i].IsBooked = true // Mark the seat as booked
+		bookedSeats[i].HoldToken = &holdToken
+		bookedSeats[i].HoldExtensions = holdExtensions
+		if idempotentKey != "" {
+			bookedSeats[i].LockedBy = &idempotentKey // Record which checkout holds the lock
+		}
+		if err := tx.Save(&bookedSeats[i]).Error; err != nil {
+			tx.Rollback()
+			return hold, 500, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return hold, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	// If we reach here, it means the seats are successfully locked
+	return SeatHold{
+		HoldToken:      holdToken,
+		LockedBy:       idempotentKey,
+		LockedUntil:    lockedUntil,
+		ExtensionsLeft: m.HoldConfig.MaxExtensions - holdExtensions,
+		BookedSeats:    bookedSeats,
+	}, 200, nil
+}
+
+// isHeldBy reports if the seat is currently held with the given hold token
+func isHeldBy(seat models.BookedSeats, holdToken string) bool {
+	return holdToken != "" && seat.HoldToken != nil && *seat.HoldToken == holdToken
+}
+
+/*
+ReleaseExpiredSeatLocks resets booked seats whose LockedUntil is in the past and for which no ticket was created,
+so that abandoned checkouts give their seats back to the inventory.
+
+	movieTimeSlotID: restrict the sweep to a single movie time slot, 0 sweeps every time slot
+	limit: maximum number of seats released in one call, 0 means no limit
+
+Rows are selected with FOR UPDATE SKIP LOCKED, so several replicas can sweep at the same time without
+releasing the same seat twice.
+*/
+func (m *MovieDB) ReleaseExpiredSeatLocks(movieTimeSlotID uint, limit int) ([]models.BookedSeats, int, error) {
+	var expiredSeats []models.BookedSeats
 
 	tx := m.DB.Conn.Begin()
 
 	if tx.Error != nil {
-		return 500, tx.Error
+		return nil, 500, tx.Error
 	}
 
 	defer func() {
@@ -994,24 +1775,203 @@ func (m *MovieDB) LockBookedSeats(bookedSeatsIDs []int32) (int, error) {
 		}
 	}()
 
-	for i := range bookedSeats {
-		bookedSeats[i].LockedUntil = ptrTime(time.Now().Add(15 * time.Minute)) // Lock the seat for 15 minutes
-		bookedSeats[i].IsBooked = true                                         // Mark the seat as booked
-		if err := tx.Save(&bookedSeats[i]).Error; err != nil {
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("is_booked = ? AND locked_until IS NOT NULL AND locked_until < ?", true, time.Now()).
+		Where("NOT EXISTS (SELECT 1 FROM tickets WHERE tickets.deleted_at IS NULL AND booked_seats.id = ANY(tickets.booked_seats_id))")
+
+	if movieTimeSlotID != 0 {
+		query = query.Where("movie_time_slot_id = ?", movieTimeSlotID)
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Order("id").Find(&expiredSeats).Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	if len(expiredSeats) == 0 {
+		tx.Rollback()
+		return expiredSeats, 200, nil
+	}
+
+	ids := make([]uint, 0, len(expiredSeats))
+
+	for _, seat := range expiredSeats {
+		ids = append(ids, seat.ID)
+	}
+
+	result := tx.Model(&models.BookedSeats{}).Where("id IN ?", ids).Updates(map[string]any{
+		"is_booked":       false,
+		"locked_until":    nil,
+		"locked_by":       nil,
+		"hold_token":      nil,
+		"hold_extensions": 0,
+		"email":           nil,
+		"phone_number":    "",
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, 500, result.Error
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	m.publishSeatsReleased(expiredSeats, "LOCK_EXPIRED")
+
+	return expiredSeats, 200, nil
+}
+
+/*
+ReleaseSeatLocks gives back the seats held by a checkout, e.g. when the user cancels the payment
+
+	idempotentKey: idempotency key the seats were locked with
+	bookedSeatsIDs: optional, restricts the release to these booked seats. When empty every seat held by the key is released
+
+Seats locked by a different key, or already part of a ticket, are never touched and make the whole call fail with 409.
+*/
+func (m *MovieDB) ReleaseSeatLocks(idempotentKey string, bookedSeatsIDs []int32) ([]models.BookedSeats, int, error) {
+	if idempotentKey == "" {
+		return nil, 400, errors.New("idempotent key is required to release seat locks")
+	}
+
+	var lockedSeats []models.BookedSeats
+
+	tx := m.DB.Conn.Begin()
+
+	if tx.Error != nil {
+		return nil, 500, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
 			tx.Rollback()
-			return 500, err
 		}
+	}()
+
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE"})
+
+	if len(bookedSeatsIDs) > 0 {
+		query = query.Where("id IN ?", bookedSeatsIDs)
+	} else {
+		query = query.Where("locked_by = ?", idempotentKey)
 	}
 
-	if len(bookedSeats) == 0 {
-		return 404, errors.New("no booked seats found for the given IDs")
+	if err := query.Order("id").Find(&lockedSeats).Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
 	}
 
-	// If we reach here, it means the seats are successfully locked
-	return 200, nil
+	if len(lockedSeats) == 0 {
+		tx.Rollback()
+		return nil, 404, errors.New("no seats are locked by the given idempotent key")
+	}
+
+	if len(bookedSeatsIDs) > 0 && len(lockedSeats) != len(bookedSeatsIDs) {
+		tx.Rollback()
+		return nil, 404, errors.New("some of the booked seats do not exist")
+	}
+
+	ids := make(pq.Int32Array, 0, len(lockedSeats))
+
+	for _, seat := range lockedSeats {
+		if seat.LockedBy == nil || *seat.LockedBy != idempotentKey {
+			tx.Rollback()
+			return nil, 409, fmt.Errorf("seat %s is not locked by the given idempotent key", seat.SeatNumber)
+		}
+		ids = append(ids, int32(seat.ID))
+	}
+
+	var ticketCount int64
+
+	err := tx.Model(&models.Ticket{}).Where("booked_seats_id && ?", ids).Count(&ticketCount).Error
+
+	if err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	if ticketCount > 0 {
+		tx.Rollback()
+		return nil, 409, errors.New("seats already have a ticket and cannot be released")
+	}
+
+	result := tx.Model(&models.BookedSeats{}).Where("id IN ?", ids).Updates(map[string]any{
+		"is_booked":       false,
+		"locked_until":    nil,
+		"locked_by":       nil,
+		"hold_token":      nil,
+		"hold_extensions": 0,
+		"email":           nil,
+		"phone_number":    "",
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, 500, result.Error
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	m.publishSeatsReleased(lockedSeats, "CHECKOUT_CANCELLED")
+
+	return lockedSeats, 200, nil
 }
 
-func (m *MovieDB) CreateTicket(idempotent_key string, transaction_id string) (int, error) {
+// publishSeatsReleased emits one seats released event per movie time slot, failures are only logged
+// because the seats are already back in the inventory at this point
+func (m *MovieDB) publishSeatsReleased(seats []models.BookedSeats, reason string) {
+	if m.Producer == nil || len(seats) == 0 {
+		return
+	}
+
+	events := make(map[uint]*producers.SeatsReleasedEvent)
+	order := make([]uint, 0)
+	releasedAt := time.Now()
+
+	for _, seat := range seats {
+		event, ok := events[seat.MovieTimeSlotID]
+
+		if !ok {
+			event = &producers.SeatsReleasedEvent{
+				MovieTimeSlotID: seat.MovieTimeSlotID,
+				Reason:          reason,
+				ReleasedAt:      releasedAt,
+			}
+			events[seat.MovieTimeSlotID] = event
+			order = append(order, seat.MovieTimeSlotID)
+		}
+
+		event.BookedSeatsIDs = append(event.BookedSeatsIDs, seat.ID)
+		event.SeatNumbers = append(event.SeatNumbers, seat.SeatNumber)
+	}
+
+	for _, slotID := range order {
+		if err := m.Producer.Seats_Released_Producer(*events[slotID]); err != nil {
+			log.Error("error publishing seats released event for movie time slot ", slotID, ": ", err)
+		}
+	}
+}
+
+/*
+CreateTicket commits the seats held for the idempotent key into a ticket, the hold token of the seats is required
+
+	quote_id: quote returned by IsValidToCommitSeatsForBooking, empty for the latest quote of the hold
+
+The ticket is charged the total of the quote, so prices changed after the customer saw it do not apply
+*/
+func (m *MovieDB) CreateTicket(idempotent_key string, transaction_id string, hold_token string, quote_id string) (int, error) {
+
+	if hold_token == "" {
+		return 400, errors.New("hold token is required to create a ticket")
+	}
 
 	var idempotent models.Idempotent
 
@@ -1021,19 +1981,96 @@ func (m *MovieDB) CreateTicket(idempotent_key string, transaction_id string) (in
 		return 500, result.Error
 	}
 
-	result = m.DB.Conn.Model(&models.Ticket{}).Create(&models.Ticket{
+	if idempotent.ID == 0 {
+		return 404, errors.New("idempotent key does not exist")
+	}
+
+	if len(idempotent.BookedSeatsId) == 0 {
+		return 400, errors.New("no booked seats associated with the idempotent key")
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	if tx.Error != nil {
+		return 500, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var bookedSeats []models.BookedSeats
+
+	result = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", []int32(idempotent.BookedSeatsId)).Order("id").Find(&bookedSeats)
+
+	if result.Error != nil {
+		tx.Rollback()
+		return 500, result.Error
+	}
+
+	if len(bookedSeats) != len(idempotent.BookedSeatsId) {
+		tx.Rollback()
+		return 404, errors.New("some of the booked seats do not exist")
+	}
+
+	// The hold may have expired while the payment was in flight, that is fine as long as nobody else took the seat
+
+	for _, seat := range bookedSeats {
+		if !isHeldBy(seat, hold_token) {
+			tx.Rollback()
+			return 409, fmt.Errorf("seat %s is not held by the given hold token", seat.SeatNumber)
+		}
+	}
+
+	quote, status, err := findQuote(tx, quote_id, hold_token, bookedSeats)
+
+	if err != nil {
+		tx.Rollback()
+		return status, err
+	}
+
+	result = tx.Model(&models.Ticket{}).Create(&models.Ticket{
 		BookedSeatsID: idempotent.BookedSeatsId,
 		CustomerID:    idempotent.CustomerID,
 		TransactionID: transaction_id,
+		QuoteID:       quote.QuoteID,
+		Amount:        quote.Total,
+		Currency:      quote.Currency,
 	})
 
 	if result.Error != nil {
+		tx.Rollback()
 		return 500, result.Error
 	}
 
 	if result.RowsAffected == 0 {
+		tx.Rollback()
 		return 500, errors.New("failed to create ticket, no rows affected")
 	}
 
+	// The seats are sold now, drop the hold so that the sweeper leaves them alone
+
+	result = tx.Model(&models.BookedSeats{}).Where("id IN ?", []int32(idempotent.BookedSeatsId)).Updates(map[string]any{
+		"is_booked":    true,
+		"locked_until": nil,
+		"hold_token":   nil,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return 500, result.Error
+	}
+
+	if err := refreshSoldOut(tx, bookedSeats[0].MovieTimeSlotID); err != nil {
+		tx.Rollback()
+		return 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 500, fmt.Errorf("commit error: %v", err)
+	}
+
 	return 200, nil
 }
@@ -0,0 +1,548 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// Opening hours of a screen that has none set
+const (
+	DefaultOpeningTime = "09:00"
+	DefaultClosingTime = "00:00"
+)
+
+// DefaultPlanGranularity rounds planned start times up so shows begin at tidy times
+const DefaultPlanGranularity = 5 * time.Minute
+
+// MaxPlanDays bounds how many days a single plan can cover
+const MaxPlanDays = 31
+
+/*
+PlannedMovie is a movie the planner can program
+
+	Movie: the movie, its Duration is the length of a show
+	TargetShows: shows wanted over the whole plan, 0 fills the screen time the other movies leave
+	Priority: higher priorities are programmed first and get a bigger share of the time left over
+	MovieFormat: format to show the movie in, empty uses the first resolution of the movie the screen supports
+*/
+type PlannedMovie struct {
+	Movie       models.Movie
+	TargetShows int
+	Priority    int
+	MovieFormat string
+}
+
+/*
+PlanScreen is a screen the planner can use
+
+	Venue: the screen, its opening hours and supported formats are respected
+	Location: time zone of the cinema, nil is UTC
+	Shows: shows already on the screen, planned shows are fitted around them
+*/
+type PlanScreen struct {
+	Venue    models.Venue
+	Location *time.Location
+	Shows    []models.MovieTimeSlot
+}
+
+// WeeklyPlanRequest is everything the planner needs to program a set of screens
+type WeeklyPlanRequest struct {
+	WeekStart        time.Time // First day of the plan, only the date is used
+	Days             int       // Days to plan, 0 plans a week
+	Movies           []PlannedMovie
+	Screens          []PlanScreen
+	TurnaroundBuffer time.Duration
+	Granularity      time.Duration // 0 uses DefaultPlanGranularity
+	NotBefore        time.Time     // No show is planned to start before it
+}
+
+// WeeklyPlan is the shows the planner came up with, nothing is written until it is committed
+type WeeklyPlan struct {
+	MovieTimeSlots []models.MovieTimeSlot
+	PlannedShows   map[uint]int     // Shows planned by movie
+	MissingShows   map[uint]int     // Target shows that did not fit, by movie
+	ScreenUse      map[uint]float64 // Share of the opening hours of each screen taken by shows, by venue
+}
+
+// OpeningHours is when a screen opens and closes on a calendar day
+func OpeningHours(venue models.Venue, date time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	opening, closing := venue.OpeningTime, venue.ClosingTime
+
+	if opening == "" {
+		opening = DefaultOpeningTime
+	}
+
+	if closing == "" {
+		closing = DefaultClosingTime
+	}
+
+	o, err := time.Parse("15:04", opening)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid opening time %s of venue %d", opening, venue.ID)
+	}
+
+	c, err := time.Parse("15:04", closing)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid closing time %s of venue %d", closing, venue.ID)
+	}
+
+	opensAt := time.Date(date.Year(), date.Month(), date.Day(), o.Hour(), o.Minute(), 0, 0, loc)
+	closesAt := time.Date(date.Year(), date.Month(), date.Day(), c.Hour(), c.Minute(), 0, 0, loc)
+
+	if !closesAt.After(opensAt) {
+		closesAt = time.Date(date.Year(), date.Month(), date.Day()+1, c.Hour(), c.Minute(), 0, 0, loc)
+	}
+
+	return opensAt, closesAt, nil
+}
+
+// planFormat is the format a movie is shown in on a screen, empty when the screen cannot show it
+func planFormat(movie PlannedMovie, venue models.Venue) string {
+	if movie.MovieFormat != "" {
+		if venueSupportsFormat(venue, movie.MovieFormat) {
+			return movie.MovieFormat
+		}
+
+		return ""
+	}
+
+	for _, format := range movie.Movie.MovieResolution {
+		if venueSupportsFormat(venue, format) {
+			return format
+		}
+	}
+
+	return ""
+}
+
+func roundUp(t time.Time, step time.Duration) time.Time {
+	rounded := t.Truncate(step)
+
+	if rounded.Before(t) {
+		rounded = rounded.Add(step)
+	}
+
+	return rounded
+}
+
+/*
+planOrder sorts the movies in the order the planner tries them
+
+Movies still short of their shows for the day come first by priority, then the movies without a target
+by how far behind their share of the time they are, then the movies ahead of their daily spread.
+Movies that reached their target are dropped
+*/
+func planOrder(movies []PlannedMovie, remaining, today, planned map[uint]int) []PlannedMovie {
+	tier := func(movie PlannedMovie) int {
+		id := movie.Movie.ID
+
+		switch {
+		case today[id] > 0:
+			return 0
+		case movie.TargetShows == 0:
+			return 1
+		case remaining[id] > 0:
+			return 2
+		}
+
+		return 3
+	}
+
+	share := func(movie PlannedMovie) float64 {
+		return float64(planned[movie.Movie.ID]) / float64(max(movie.Priority, 0)+1)
+	}
+
+	ordered := make([]PlannedMovie, 0, len(movies))
+
+	for _, movie := range movies {
+		if tier(movie) < 3 {
+			ordered = append(ordered, movie)
+		}
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+
+		if tier(a) != tier(b) {
+			return tier(a) < tier(b)
+		}
+
+		if tier(a) == 1 && share(a) != share(b) {
+			return share(a) < share(b)
+		}
+
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+
+		return remaining[a.Movie.ID] > remaining[b.Movie.ID]
+	})
+
+	return ordered
+}
+
+// nextFree is when the screen is free again after the first show blocking it from cursor on
+func nextFree(shows []models.MovieTimeSlot, cursor time.Time, buffer time.Duration) (time.Time, bool) {
+	var blocking *models.MovieTimeSlot
+
+	for i := range shows {
+		if !shows[i].EndTime.Add(buffer).After(cursor) {
+			continue
+		}
+
+		if blocking == nil || shows[i].StartTime.Before(blocking.StartTime) {
+			blocking = &shows[i]
+		}
+	}
+
+	if blocking == nil {
+		return cursor, false
+	}
+
+	return blocking.EndTime.Add(buffer), true
+}
+
+/*
+PlanWeek programmes the screens day by day, filling each screen from opening to closing time
+
+Target shows are spread evenly over the days of the plan, priorities decide which movie gets a gap first.
+Shows never overlap each other or the existing shows, keep the turnaround buffer, end before the screen closes,
+use a format the screen supports and start on or after the release date of the movie
+*/
+func PlanWeek(request WeeklyPlanRequest) (WeeklyPlan, error) {
+	plan := WeeklyPlan{
+		PlannedShows: make(map[uint]int),
+		MissingShows: make(map[uint]int),
+		ScreenUse:    make(map[uint]float64),
+	}
+
+	if len(request.Movies) == 0 {
+		return plan, errors.New("at least one movie is required")
+	}
+
+	if len(request.Screens) == 0 {
+		return plan, errors.New("at least one screen is required")
+	}
+
+	days := request.Days
+
+	if days == 0 {
+		days = 7
+	}
+
+	if days < 0 || days > MaxPlanDays {
+		return plan, fmt.Errorf("a plan can cover 1 to %d days", MaxPlanDays)
+	}
+
+	step := request.Granularity
+
+	if step <= 0 {
+		step = DefaultPlanGranularity
+	}
+
+	buffer := request.TurnaroundBuffer
+	remaining := make(map[uint]int)
+
+	for _, movie := range request.Movies {
+		if movie.Movie.Duration <= 0 {
+			return plan, fmt.Errorf("movie %d has no runtime", movie.Movie.ID)
+		}
+
+		if movie.TargetShows < 0 {
+			return plan, fmt.Errorf("target shows of movie %d cannot be negative", movie.Movie.ID)
+		}
+
+		remaining[movie.Movie.ID] = movie.TargetShows
+		plan.PlannedShows[movie.Movie.ID] = 0
+	}
+
+	busy := make([][]models.MovieTimeSlot, len(request.Screens))
+	opened := make([]time.Duration, len(request.Screens))
+
+	for i, screen := range request.Screens {
+		busy[i] = append(busy[i], screen.Shows...)
+	}
+
+	first := time.Date(request.WeekStart.Year(), request.WeekStart.Month(), request.WeekStart.Day(), 0, 0, 0, 0, time.UTC)
+
+	for day := 0; day < days; day++ {
+		date := first.AddDate(0, 0, day)
+
+		// Shows each movie still needs today to stay on track for its target
+
+		today := make(map[uint]int)
+
+		for id, left := range remaining {
+			if left > 0 {
+				today[id] = (left + days - day - 1) / (days - day)
+			}
+		}
+
+		for i, screen := range request.Screens {
+			loc := screen.Location
+
+			if loc == nil {
+				loc = time.UTC
+			}
+
+			opensAt, closesAt, err := OpeningHours(screen.Venue, date, loc)
+
+			if err != nil {
+				return plan, err
+			}
+
+			opened[i] += closesAt.Sub(opensAt)
+
+			cursor := opensAt
+
+			if cursor.Before(request.NotBefore) {
+				cursor = request.NotBefore
+			}
+
+			for cursor.Before(closesAt) {
+				cursor = roundUp(cursor, step)
+
+				var show *models.MovieTimeSlot
+
+				for _, movie := range planOrder(request.Movies, remaining, today, plan.PlannedShows) {
+					format := planFormat(movie, screen.Venue)
+
+					if format == "" || cursor.Before(movie.Movie.ReleaseDate) {
+						continue
+					}
+
+					length := time.Duration(movie.Movie.Duration) * time.Minute
+
+					candidate := models.MovieTimeSlot{
+						StartTime:   cursor,
+						EndTime:     cursor.Add(length),
+						Duration:    movie.Movie.Duration,
+						MovieID:     movie.Movie.ID,
+						Date:        date,
+						MovieFormat: format,
+						VenueID:     screen.Venue.ID,
+					}
+
+					if candidate.EndTime.After(closesAt) || FindScheduleConflict(candidate, busy[i], buffer) != nil {
+						continue
+					}
+
+					show = &candidate
+					break
+				}
+
+				if show == nil {
+					next, ok := nextFree(busy[i], cursor, buffer)
+
+					if !ok {
+						break
+					}
+
+					cursor = next
+					continue
+				}
+
+				busy[i] = append(busy[i], *show)
+				plan.MovieTimeSlots = append(plan.MovieTimeSlots, *show)
+				plan.PlannedShows[show.MovieID]++
+
+				if remaining[show.MovieID] > 0 {
+					remaining[show.MovieID]--
+				}
+
+				if today[show.MovieID] > 0 {
+					today[show.MovieID]--
+				}
+
+				cursor = show.EndTime.Add(buffer)
+			}
+		}
+	}
+
+	for id, left := range remaining {
+		if left > 0 {
+			plan.MissingShows[id] = left
+		}
+	}
+
+	for i, screen := range request.Screens {
+		var used time.Duration
+
+		for _, show := range busy[i] {
+			used += show.EndTime.Sub(show.StartTime)
+		}
+
+		if opened[i] > 0 {
+			plan.ScreenUse[screen.Venue.ID] = min(float64(used)/float64(opened[i]), 1)
+		}
+	}
+
+	sort.SliceStable(plan.MovieTimeSlots, func(i, j int) bool {
+		a, b := plan.MovieTimeSlots[i], plan.MovieTimeSlots[j]
+
+		if !a.StartTime.Equal(b.StartTime) {
+			return a.StartTime.Before(b.StartTime)
+		}
+
+		return a.VenueID < b.VenueID
+	})
+
+	return plan, nil
+}
+
+/*
+PlanProgramme plans the shows of the screens for the given days and optionally writes them
+
+	weekStart: first day of the plan
+	days: days to plan, 0 plans a week
+	venueIDs: screens to programme
+	movies: movies to programme, only the ID of each Movie is read, the rest is loaded
+	commit: write the plan through the same checks as AddMovieTimeSlot, otherwise it is only a preview
+
+Committing plans again inside the transaction, so the shows written are the ones returned.
+A show added by someone else in the meantime fails the whole commit with a conflict
+*/
+func (m *MovieDB) PlanProgramme(weekStart time.Time, days int, venueIDs []uint, movies []PlannedMovie, commit bool) (WeeklyPlan, int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	request := WeeklyPlanRequest{
+		WeekStart:        weekStart,
+		Days:             days,
+		TurnaroundBuffer: m.ScheduleConfig.TurnaroundBuffer,
+		NotBefore:        time.Now(),
+	}
+
+	if status, err := loadPlanMovies(tx, movies, &request); err != nil {
+		tx.Rollback()
+		return WeeklyPlan{}, status, err
+	}
+
+	if status, err := loadPlanScreens(tx, venueIDs, &request); err != nil {
+		tx.Rollback()
+		return WeeklyPlan{}, status, err
+	}
+
+	plan, err := PlanWeek(request)
+
+	if err != nil {
+		tx.Rollback()
+		return plan, 400, err
+	}
+
+	if !commit {
+		tx.Rollback()
+		return plan, 200, nil
+	}
+
+	for i, show := range plan.MovieTimeSlots {
+		created, status, err := m.addMovieTimeSlot(tx, show)
+
+		if status != 200 || err != nil {
+			tx.Rollback()
+			return plan, status, err
+		}
+
+		plan.MovieTimeSlots[i] = created
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return plan, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return plan, 200, nil
+}
+
+func loadPlanMovies(tx *gorm.DB, movies []PlannedMovie, request *WeeklyPlanRequest) (int, error) {
+	ids := make([]uint, 0, len(movies))
+
+	for _, movie := range movies {
+		ids = append(ids, movie.Movie.ID)
+	}
+
+	var found []models.Movie
+
+	if len(ids) > 0 {
+		if err := tx.Find(&found, ids).Error; err != nil {
+			return 500, err
+		}
+	}
+
+	byID := make(map[uint]models.Movie, len(found))
+
+	for _, movie := range found {
+		byID[movie.ID] = movie
+	}
+
+	for _, movie := range movies {
+		loaded, ok := byID[movie.Movie.ID]
+
+		if !ok {
+			return 404, fmt.Errorf("movie %d not found", movie.Movie.ID)
+		}
+
+		movie.Movie = loaded
+		request.Movies = append(request.Movies, movie)
+	}
+
+	return 200, nil
+}
+
+func loadPlanScreens(tx *gorm.DB, venueIDs []uint, request *WeeklyPlanRequest) (int, error) {
+	var venues []models.Venue
+
+	if len(venueIDs) > 0 {
+		if err := tx.Order("id ASC").Find(&venues, venueIDs).Error; err != nil {
+			return 500, err
+		}
+	}
+
+	if len(venues) != len(venueIDs) {
+		return 404, errors.New("one or more venues not found")
+	}
+
+	// A day longer on both sides covers the opening hours of every time zone
+
+	days := request.Days
+
+	if days == 0 {
+		days = 7
+	}
+
+	from := request.WeekStart.AddDate(0, 0, -1)
+	to := request.WeekStart.AddDate(0, 0, days+1)
+
+	for _, venue := range venues {
+		loc, err := venueTimezone(tx, venue.ID)
+
+		if err != nil {
+			return 500, err
+		}
+
+		var shows []models.MovieTimeSlot
+
+		result := tx.Where("venue_id = ? AND status <> ? AND start_time < ? AND end_time > ?", venue.ID, ShowtimeCancelled, to, from).
+			Order("start_time ASC").
+			Find(&shows)
+
+		if result.Error != nil {
+			return 500, result.Error
+		}
+
+		request.Screens = append(request.Screens, PlanScreen{Venue: venue, Location: loc, Shows: shows})
+	}
+
+	return 200, nil
+}
@@ -0,0 +1,332 @@
+package api
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// Kinds of price rules
+const (
+	PriceRulePrice     = "PRICE"     // Replaces the price of the seat
+	PriceRuleSurcharge = "SURCHARGE" // Added to the price of the seat, negative for a discount
+)
+
+// PriceAdjustment is a surcharge added to the price of a seat
+type PriceAdjustment struct {
+	RuleID uint
+	Name   string
+	Amount Money
+}
+
+// SeatPrice is the price of a seat for one show, Total is Base with every surcharge added, rounded the way the venue rounds
+type SeatPrice struct {
+	Base       Money
+	BaseRuleID uint // PRICE rule the base comes from, 0 for the price of the seat itself
+	Surcharges []PriceAdjustment
+	Total      Money
+}
+
+// ValidatePriceRule checks a price rule and normalizes its kind, seat type and format
+func ValidatePriceRule(rule *models.PriceRule) error {
+	rule.Kind = strings.ToUpper(strings.TrimSpace(rule.Kind))
+	rule.SeatType = strings.ToUpper(strings.TrimSpace(rule.SeatType))
+	rule.Name = strings.TrimSpace(rule.Name)
+
+	if rule.MovieFormat != "" {
+		rule.MovieFormat = NormalizeMovieFormat(rule.MovieFormat)
+	}
+
+	if rule.Currency != "" {
+		currency, err := ParseCurrency(rule.Currency)
+
+		if err != nil {
+			return err
+		}
+
+		rule.Currency = currency
+	}
+
+	if rule.Name == "" {
+		return errors.New("price rule name is required")
+	}
+
+	switch rule.Kind {
+	case PriceRulePrice:
+		if rule.Amount < 0 {
+			return errors.New("the price of a rule cannot be negative")
+		}
+	case PriceRuleSurcharge:
+	default:
+		return fmt.Errorf("invalid price rule kind %s, expected %s or %s", rule.Kind, PriceRulePrice, PriceRuleSurcharge)
+	}
+
+	for _, day := range rule.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("invalid day of week %d, expected 0 (Sunday) to 6", day)
+		}
+	}
+
+	for _, t := range []string{rule.FromTime, rule.ToTime} {
+		if t == "" {
+			continue
+		}
+
+		if _, err := time.Parse("15:04", t); err != nil {
+			return fmt.Errorf("invalid time %s, expected HH:MM", t)
+		}
+	}
+
+	return nil
+}
+
+// priceRuleMatches tells if a rule applies to a seat of a show that starts at start, local to the venue
+func priceRuleMatches(rule models.PriceRule, seat models.SeatMatrix, slot models.MovieTimeSlot, currency string, start time.Time) bool {
+	if rule.VenueID != nil && *rule.VenueID != slot.VenueID {
+		return false
+	}
+
+	if !strings.EqualFold(cmp.Or(rule.Currency, DefaultCurrency), currency) {
+		return false
+	}
+
+	if rule.MovieTimeSlotID != nil && *rule.MovieTimeSlotID != slot.ID {
+		return false
+	}
+
+	if rule.SeatType != "" && !strings.EqualFold(rule.SeatType, seat.Type) {
+		return false
+	}
+
+	if rule.MovieFormat != "" && NormalizeMovieFormat(rule.MovieFormat) != NormalizeMovieFormat(slot.MovieFormat) {
+		return false
+	}
+
+	if len(rule.DaysOfWeek) > 0 && !slices.Contains(rule.DaysOfWeek, int32(start.Weekday())) {
+		return false
+	}
+
+	return inTimeOfDay(start, rule.FromTime, rule.ToTime)
+}
+
+// inTimeOfDay tells if t is at or after from and before to on the clock, a to at or before from is on the next day
+func inTimeOfDay(t time.Time, from string, to string) bool {
+	minute := t.Hour()*60 + t.Minute()
+
+	clock := func(value string) int {
+		parsed, _ := time.Parse("15:04", value)
+		return parsed.Hour()*60 + parsed.Minute()
+	}
+
+	switch {
+	case from == "" && to == "":
+		return true
+	case to == "":
+		return minute >= clock(from)
+	case from == "":
+		return minute < clock(to)
+	case clock(to) <= clock(from):
+		return minute >= clock(from) || minute < clock(to)
+	default:
+		return minute >= clock(from) && minute < clock(to)
+	}
+}
+
+// priceRuleSpecificity ranks PRICE rules, a rule for the show beats one for the seat type, which beats one for the day,
+// the time of day, the format and the venue in that order. A rule for the show without a seat type is on purpose a
+// flat price for every seat of the show, tiers for the show need a rule per seat type or a SURCHARGE rule for the show
+func priceRuleSpecificity(rule models.PriceRule) int {
+	specificity := 0
+
+	if rule.MovieTimeSlotID != nil {
+		specificity += 32
+	}
+
+	if rule.SeatType != "" {
+		specificity += 16
+	}
+
+	if len(rule.DaysOfWeek) > 0 {
+		specificity += 8
+	}
+
+	if rule.FromTime != "" || rule.ToTime != "" {
+		specificity += 4
+	}
+
+	if rule.MovieFormat != "" {
+		specificity += 2
+	}
+
+	if rule.VenueID != nil {
+		specificity += 1
+	}
+
+	return specificity
+}
+
+/*
+PriceSeat works out the price of a seat for a show
+
+	venue: time zone, currency and rounding of the venue. Days and times of rules are local to it and only rules in its
+	currency apply
+
+The most specific matching PRICE rule sets the base price, the latest rule wins a tie and the price of the seat is used
+when no PRICE rule matches. Every matching SURCHARGE rule is added to the base, the total is never below 0 and is
+rounded the way the venue rounds
+*/
+func PriceSeat(seat models.SeatMatrix, slot models.MovieTimeSlot, venue VenuePricing, rules []models.PriceRule) SeatPrice {
+	price := SeatPrice{Base: Money{Amount: int64(seat.Price), Currency: venue.Currency}}
+	start := slot.StartTime.In(venue.Location)
+	best := -1
+
+	for _, rule := range rules {
+		if !priceRuleMatches(rule, seat, slot, venue.Currency, start) {
+			continue
+		}
+
+		amount := Money{Amount: int64(rule.Amount), Currency: venue.Currency}
+
+		if rule.Kind == PriceRuleSurcharge {
+			price.Surcharges = append(price.Surcharges, PriceAdjustment{RuleID: rule.ID, Name: rule.Name, Amount: amount})
+			continue
+		}
+
+		specificity := priceRuleSpecificity(rule)
+
+		if specificity > best || (specificity == best && rule.ID > price.BaseRuleID) {
+			best = specificity
+			price.Base = amount
+			price.BaseRuleID = rule.ID
+		}
+	}
+
+	total := price.Base.Amount
+
+	for _, surcharge := range price.Surcharges {
+		total += surcharge.Amount.Amount
+	}
+
+	price.Total = Money{Amount: venue.Rounding.Round(max(total, 0)), Currency: venue.Currency}
+
+	return price
+}
+
+// showPricer prices the seats of a show with the rules of its venue
+type showPricer struct {
+	slot  models.MovieTimeSlot
+	venue VenuePricing
+	rules []models.PriceRule
+}
+
+func newShowPricer(db *gorm.DB, slot models.MovieTimeSlot) (showPricer, error) {
+	pricer := showPricer{slot: slot}
+
+	venue, err := venuePricing(db, slot.VenueID)
+
+	if err != nil {
+		return pricer, err
+	}
+
+	pricer.venue = venue
+
+	err = db.Where("(venue_id IS NULL OR venue_id = ?) AND (movie_time_slot_id IS NULL OR movie_time_slot_id = ?)", slot.VenueID, slot.ID).
+		Where("currency = ?", venue.Currency).
+		Order("id ASC").
+		Find(&pricer.rules).Error
+
+	return pricer, err
+}
+
+func (p showPricer) price(seat models.SeatMatrix) SeatPrice {
+	return PriceSeat(seat, p.slot, p.venue, p.rules)
+}
+
+/*
+AddPriceRule adds a price rule, it applies to every seat that is not sold yet
+
+A rule for a venue or a show is in the currency of the venue, a rule for every venue needs its currency and only
+applies to the venues charging in it
+*/
+func (m *MovieDB) AddPriceRule(rule models.PriceRule) (models.PriceRule, int, error) {
+	if err := ValidatePriceRule(&rule); err != nil {
+		return rule, 400, err
+	}
+
+	if rule.MovieTimeSlotID != nil {
+		var slot models.MovieTimeSlot
+
+		result := m.DB.Conn.First(&slot, *rule.MovieTimeSlotID)
+
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return rule, 404, errors.New("movie time slot not found")
+		}
+
+		if result.Error != nil {
+			return rule, 500, result.Error
+		}
+
+		rule.VenueID = &slot.VenueID
+	}
+
+	if rule.VenueID == nil && rule.Currency == "" {
+		return rule, 400, errors.New("a price rule for every venue needs a currency")
+	}
+
+	if rule.VenueID != nil {
+		currency, status, err := venueCurrency(m.DB.Conn, *rule.VenueID)
+
+		if err != nil {
+			return rule, status, err
+		}
+
+		if err := checkCurrency(rule.Currency, currency); err != nil {
+			return rule, 400, err
+		}
+
+		rule.Currency = currency
+	}
+
+	if err := m.DB.Conn.Create(&rule).Error; err != nil {
+		return rule, 500, err
+	}
+
+	return rule, 200, nil
+}
+
+// GetPriceRules returns the rules that can apply to the shows of a venue, 0 returns only the rules for every venue
+func (m *MovieDB) GetPriceRules(venueID uint) ([]models.PriceRule, int, error) {
+	var rules []models.PriceRule
+
+	query := m.DB.Conn.Where("venue_id IS NULL")
+
+	if venueID != 0 {
+		query = m.DB.Conn.Where("venue_id IS NULL OR venue_id = ?", venueID)
+	}
+
+	if err := query.Order("id ASC").Find(&rules).Error; err != nil {
+		return nil, 500, err
+	}
+
+	return rules, 200, nil
+}
+
+func (m *MovieDB) DeletePriceRule(ruleID uint) (int, error) {
+	result := m.DB.Conn.Delete(&models.PriceRule{}, ruleID)
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return 404, fmt.Errorf("price rule %d not found", ruleID)
+	}
+
+	return 200, nil
+}
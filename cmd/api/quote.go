@@ -0,0 +1,400 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// What a tax rule is charged on
+const (
+	TaxOnTicket = "TICKET" // The price of the ticket
+	TaxOnFee    = "FEE"    // The convenience fee
+)
+
+// TaxAmount is a tax charged on one ticket, or on all of them in the totals of a quote
+type TaxAmount struct {
+	TaxRuleID uint
+	Name      string
+	AppliesTo string
+	Rate      int  // In basis points
+	Inclusive bool // Part of the price already, not added to the total
+	Amount    Money
+}
+
+// QuoteSeat is the breakdown of one ticket, Price is Base with the surcharges, discounts and Rounding added
+type QuoteSeat struct {
+	BookedSeatID   uint
+	SeatMatrixID   uint
+	SeatNumber     string
+	Base           Money
+	BaseRuleID     uint
+	Surcharges     []PriceAdjustment
+	Discounts      []PriceAdjustment // Surcharges below 0
+	Rounding       Money             // Difference the rounding of the venue made, also keeps the price from going below 0
+	Price          Money
+	ConvenienceFee Money
+	Taxes          []TaxAmount
+	Total          Money // Price, convenience fee and the taxes that are not inclusive
+}
+
+// Quote is the breakdown of a booking, every amount of it is in Currency
+type Quote struct {
+	QuoteID         string
+	MovieTimeSlotID uint
+	MovieName       string
+	Currency        string
+	Seats           []QuoteSeat
+	Base            Money
+	Surcharges      Money
+	Discounts       Money // 0 or below
+	Rounding        Money
+	ConvenienceFees Money
+	Taxes           []TaxAmount // By tax rule
+	Total           Money
+	CreatedAt       time.Time
+}
+
+// ValidateTaxRule checks a tax rule and normalizes its name, currency, regions and what it applies to
+func ValidateTaxRule(rule *models.TaxRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.AppliesTo = strings.ToUpper(strings.TrimSpace(rule.AppliesTo))
+
+	if rule.Name == "" {
+		return errors.New("tax rule name is required")
+	}
+
+	if rule.Currency == "" {
+		return errors.New("tax rule currency is required")
+	}
+
+	currency, err := ParseCurrency(rule.Currency)
+
+	if err != nil {
+		return err
+	}
+
+	rule.Currency = currency
+
+	if rule.AppliesTo != TaxOnTicket && rule.AppliesTo != TaxOnFee {
+		return fmt.Errorf("invalid tax rule target %s, expected %s or %s", rule.AppliesTo, TaxOnTicket, TaxOnFee)
+	}
+
+	if rule.Rate <= 0 || rule.Rate > 10000 {
+		return fmt.Errorf("invalid tax rate %d, expected 1 to 10000 basis points", rule.Rate)
+	}
+
+	if rule.MinAmount < 0 || rule.MaxAmount < 0 || (rule.MaxAmount != 0 && rule.MaxAmount <= rule.MinAmount) {
+		return errors.New("the maximum amount of a tax rule has to be above its minimum amount")
+	}
+
+	for i, region := range rule.RegionCodes {
+		rule.RegionCodes[i] = strings.ToUpper(strings.TrimSpace(region))
+	}
+
+	return nil
+}
+
+// taxRuleMatches tells if a rule taxes an amount of a venue in a region
+func taxRuleMatches(rule models.TaxRule, appliesTo string, amount Money, region string) bool {
+	if rule.AppliesTo != appliesTo || !strings.EqualFold(rule.Currency, amount.Currency) {
+		return false
+	}
+
+	if len(rule.RegionCodes) > 0 && !slices.ContainsFunc(rule.RegionCodes, func(code string) bool { return strings.EqualFold(code, region) }) {
+		return false
+	}
+
+	if amount.Amount <= int64(rule.MinAmount) {
+		return false
+	}
+
+	return rule.MaxAmount == 0 || amount.Amount <= int64(rule.MaxAmount)
+}
+
+// divideHalfUp divides two amounts that are not below 0, halves are rounded up
+func divideHalfUp(a int64, b int64) int64 {
+	return (2*a + b) / (2 * b)
+}
+
+// taxes works out the taxes the rules charge on an amount, an inclusive tax is the part of the amount that is tax
+func taxes(rules []models.TaxRule, appliesTo string, amount Money, region string) []TaxAmount {
+	var charged []TaxAmount
+
+	for _, rule := range rules {
+		if !taxRuleMatches(rule, appliesTo, amount, region) {
+			continue
+		}
+
+		tax := divideHalfUp(amount.Amount*int64(rule.Rate), 10000)
+
+		if rule.Inclusive {
+			tax = amount.Amount - divideHalfUp(amount.Amount*10000, int64(10000+rule.Rate))
+		}
+
+		charged = append(charged, TaxAmount{
+			TaxRuleID: rule.ID,
+			Name:      rule.Name,
+			AppliesTo: rule.AppliesTo,
+			Rate:      rule.Rate,
+			Inclusive: rule.Inclusive,
+			Amount:    Money{Amount: tax, Currency: amount.Currency},
+		})
+	}
+
+	return charged
+}
+
+/*
+QuoteTicket works out the breakdown of one ticket
+
+	price: price of the seat for the show, see PriceSeat
+	fee: convenience fee of the venue per ticket
+	region: region code of the venue, tax rules for other regions are left out
+
+Tax rules for TICKET are charged on the price and those for FEE on the convenience fee, each rounded half up to the
+minor unit
+*/
+func QuoteTicket(price SeatPrice, fee Money, region string, taxRules []models.TaxRule) QuoteSeat {
+	seat := QuoteSeat{
+		Base:           price.Base,
+		BaseRuleID:     price.BaseRuleID,
+		Price:          price.Total,
+		ConvenienceFee: fee,
+	}
+
+	adjusted := price.Base.Amount
+
+	for _, surcharge := range price.Surcharges {
+		if surcharge.Amount.Amount < 0 {
+			seat.Discounts = append(seat.Discounts, surcharge)
+		} else {
+			seat.Surcharges = append(seat.Surcharges, surcharge)
+		}
+
+		adjusted += surcharge.Amount.Amount
+	}
+
+	seat.Rounding = Money{Amount: price.Total.Amount - adjusted, Currency: price.Total.Currency}
+
+	seat.Taxes = append(taxes(taxRules, TaxOnTicket, price.Total, region), taxes(taxRules, TaxOnFee, fee, region)...)
+
+	total := price.Total.Amount + fee.Amount
+
+	for _, tax := range seat.Taxes {
+		if !tax.Inclusive {
+			total += tax.Amount.Amount
+		}
+	}
+
+	seat.Total = Money{Amount: total, Currency: price.Total.Currency}
+
+	return seat
+}
+
+// newQuote adds up the tickets of a booking
+func newQuote(slot models.MovieTimeSlot, movieName string, currency string, seats []QuoteSeat) Quote {
+	quote := Quote{
+		MovieTimeSlotID: slot.ID,
+		MovieName:       movieName,
+		Currency:        currency,
+		Seats:           seats,
+		Base:            Money{Currency: currency},
+		Surcharges:      Money{Currency: currency},
+		Discounts:       Money{Currency: currency},
+		Rounding:        Money{Currency: currency},
+		ConvenienceFees: Money{Currency: currency},
+		Total:           Money{Currency: currency},
+	}
+
+	byRule := make(map[uint]int)
+
+	for _, seat := range seats {
+		quote.Base.Amount += seat.Base.Amount
+		quote.Rounding.Amount += seat.Rounding.Amount
+		quote.ConvenienceFees.Amount += seat.ConvenienceFee.Amount
+		quote.Total.Amount += seat.Total.Amount
+
+		for _, surcharge := range seat.Surcharges {
+			quote.Surcharges.Amount += surcharge.Amount.Amount
+		}
+
+		for _, discount := range seat.Discounts {
+			quote.Discounts.Amount += discount.Amount.Amount
+		}
+
+		for _, tax := range seat.Taxes {
+			if i, ok := byRule[tax.TaxRuleID]; ok {
+				quote.Taxes[i].Amount.Amount += tax.Amount.Amount
+				continue
+			}
+
+			byRule[tax.TaxRuleID] = len(quote.Taxes)
+			quote.Taxes = append(quote.Taxes, tax)
+		}
+	}
+
+	return quote
+}
+
+// quoteTaxRules loads the tax rules in a currency that can apply to a region
+func quoteTaxRules(db *gorm.DB, currency string, region string) ([]models.TaxRule, error) {
+	var rules []models.TaxRule
+
+	err := db.Where("currency = ?", currency).
+		Where("region_codes IS NULL OR cardinality(region_codes) = 0 OR ? = ANY(region_codes)", strings.ToUpper(region)).
+		Order("id ASC").
+		Find(&rules).Error
+
+	return rules, err
+}
+
+// saveQuote stores a quote under a new ID so CreateTicket can charge what the customer was shown
+func saveQuote(db *gorm.DB, quote *Quote, holdToken string) error {
+	quoteID, err := helper.GenerateQuoteID()
+
+	if err != nil {
+		return err
+	}
+
+	quote.QuoteID = quoteID
+	quote.CreatedAt = time.Now().UTC()
+
+	breakdown, err := json.Marshal(quote)
+
+	if err != nil {
+		return err
+	}
+
+	stored := models.BookingQuote{
+		QuoteID:         quote.QuoteID,
+		MovieTimeSlotID: quote.MovieTimeSlotID,
+		HoldToken:       holdToken,
+		Currency:        quote.Currency,
+		Total:           quote.Total.Amount,
+		Breakdown:       string(breakdown),
+	}
+
+	for _, seat := range quote.Seats {
+		stored.SeatMatrixIDs = append(stored.SeatMatrixIDs, int32(seat.SeatMatrixID))
+	}
+
+	return db.Create(&stored).Error
+}
+
+/*
+findQuote returns the quote a ticket is charged for
+
+	quoteID: empty for the latest quote of the hold
+
+The quote has to be for the hold and for exactly the seats being booked
+*/
+func findQuote(tx *gorm.DB, quoteID string, holdToken string, seats []models.BookedSeats) (models.BookingQuote, int, error) {
+	var quote models.BookingQuote
+
+	query := tx.Where("hold_token = ?", holdToken)
+
+	if quoteID != "" {
+		query = query.Where("quote_id = ?", quoteID)
+	}
+
+	result := query.Order("id DESC").First(&quote)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return quote, 409, errors.New("the seats were not quoted for this hold, call IsValidToCommitSeatsForBooking first")
+	}
+
+	if result.Error != nil {
+		return quote, 500, result.Error
+	}
+
+	quoted := slices.Clone(quote.SeatMatrixIDs)
+	booked := make([]int32, 0, len(seats))
+
+	for _, seat := range seats {
+		booked = append(booked, int32(seat.SeatMatrixID))
+	}
+
+	slices.Sort(quoted)
+	slices.Sort(booked)
+
+	if !slices.Equal(quoted, booked) {
+		return quote, 409, fmt.Errorf("quote %s is for other seats than the ones being booked", quote.QuoteID)
+	}
+
+	return quote, 200, nil
+}
+
+// GetBookingQuote returns a quote given by IsValidToCommitSeatsForBooking
+func (m *MovieDB) GetBookingQuote(quoteID string) (Quote, int, error) {
+	var stored models.BookingQuote
+
+	result := m.DB.Conn.Where("quote_id = ?", quoteID).First(&stored)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return Quote{}, 404, fmt.Errorf("quote %s not found", quoteID)
+	}
+
+	if result.Error != nil {
+		return Quote{}, 500, result.Error
+	}
+
+	var quote Quote
+
+	if err := json.Unmarshal([]byte(stored.Breakdown), &quote); err != nil {
+		return Quote{}, 500, err
+	}
+
+	return quote, 200, nil
+}
+
+// AddTaxRule adds a tax rule, it applies to the quotes given from now on
+func (m *MovieDB) AddTaxRule(rule models.TaxRule) (models.TaxRule, int, error) {
+	if err := ValidateTaxRule(&rule); err != nil {
+		return rule, 400, err
+	}
+
+	if err := m.DB.Conn.Create(&rule).Error; err != nil {
+		return rule, 500, err
+	}
+
+	return rule, 200, nil
+}
+
+// GetTaxRules returns the tax rules in a currency, empty returns every rule
+func (m *MovieDB) GetTaxRules(currency string) ([]models.TaxRule, int, error) {
+	var rules []models.TaxRule
+
+	query := m.DB.Conn.Order("id ASC")
+
+	if currency != "" {
+		query = query.Where("currency = ?", strings.ToUpper(currency))
+	}
+
+	if err := query.Find(&rules).Error; err != nil {
+		return nil, 500, err
+	}
+
+	return rules, 200, nil
+}
+
+func (m *MovieDB) DeleteTaxRule(ruleID uint) (int, error) {
+	result := m.DB.Conn.Delete(&models.TaxRule{}, ruleID)
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return 404, fmt.Errorf("tax rule %d not found", ruleID)
+	}
+
+	return 200, nil
+}
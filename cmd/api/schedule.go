@@ -0,0 +1,217 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultTurnaroundBuffer is the time kept free between two shows of a screen for cleaning and ads
+const DefaultTurnaroundBuffer = 15 * time.Minute
+
+// Reasons a show does not fit the schedule
+const (
+	ScheduleConflictOverlap         = "OVERLAP"
+	ScheduleConflictTurnaround      = "TURNAROUND"
+	ScheduleConflictInvalidTimes    = "INVALID_TIMES"
+	ScheduleConflictDuration        = "DURATION_MISMATCH"
+	ScheduleConflictRuntime         = "SHORTER_THAN_RUNTIME"
+	ScheduleConflictFormat          = "FORMAT_NOT_SUPPORTED"
+	ScheduleConflictVenueNotFound   = "VENUE_NOT_FOUND"
+	ScheduleConflictMovieNotFound   = "MOVIE_NOT_FOUND"
+	ScheduleConflictVenueNotMovable = "VENUE_CHANGE"
+	ScheduleConflictHasBookings     = "HAS_BOOKINGS"
+	ScheduleConflictLanguage        = "LANGUAGE_NOT_SUPPORTED"
+)
+
+// ScheduleConfig holds the scheduling rules of the screens
+type ScheduleConfig struct {
+	TurnaroundBuffer time.Duration
+}
+
+func DefaultScheduleConfig() ScheduleConfig {
+	return ScheduleConfig{
+		TurnaroundBuffer: DefaultTurnaroundBuffer,
+	}
+}
+
+// ScheduleConfigFromEnv reads SHOW_TURNAROUND_BUFFER, e.g. 20m, falling back to the default when it is unset or invalid
+func ScheduleConfigFromEnv() ScheduleConfig {
+	config := DefaultScheduleConfig()
+
+	if buffer, err := time.ParseDuration(os.Getenv("SHOW_TURNAROUND_BUFFER")); err == nil && buffer >= 0 {
+		config.TurnaroundBuffer = buffer
+	}
+
+	return config
+}
+
+/*
+ScheduleConflictError is returned when a show breaks a scheduling rule
+
+	Reason: one of the ScheduleConflict constants
+	MovieTimeSlot: the show it clashes with, nil when the rule does not involve another show
+*/
+type ScheduleConflictError struct {
+	Reason        string
+	Message       string
+	MovieTimeSlot *models.MovieTimeSlot
+}
+
+func (e *ScheduleConflictError) Error() string {
+	if e.MovieTimeSlot == nil {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s, conflicts with movie time slot %d from %s to %s", e.Message, e.MovieTimeSlot.ID,
+		e.MovieTimeSlot.StartTime.Format(time.RFC3339), e.MovieTimeSlot.EndTime.Format(time.RFC3339))
+}
+
+// Status is the http status matching the conflict
+func (e *ScheduleConflictError) Status() int {
+	switch e.Reason {
+	case ScheduleConflictOverlap, ScheduleConflictTurnaround, ScheduleConflictHasBookings:
+		return 409
+	case ScheduleConflictVenueNotFound, ScheduleConflictMovieNotFound:
+		return 404
+	}
+
+	return 400
+}
+
+/*
+ValidateShowTimes checks the rules a show has to follow on its own
+
+	slot: the show, a Duration of 0 is taken from the start and end time
+	movie: the movie of the show, its runtime is the shortest possible show
+	venue: the screen of the show, it has to support the format of the show
+	and with movie the audio language and subtitles
+*/
+func ValidateShowTimes(slot *models.MovieTimeSlot, movie models.Movie, venue models.Venue) error {
+	if !slot.EndTime.After(slot.StartTime) {
+		return &ScheduleConflictError{Reason: ScheduleConflictInvalidTimes, Message: "end time has to be after the start time"}
+	}
+
+	length := slot.EndTime.Sub(slot.StartTime)
+
+	if slot.Duration == 0 && length%time.Minute == 0 {
+		slot.Duration = int(length / time.Minute)
+	}
+
+	if time.Duration(slot.Duration)*time.Minute != length {
+		return &ScheduleConflictError{
+			Reason:  ScheduleConflictDuration,
+			Message: fmt.Sprintf("duration of %d minutes does not match the %s between start and end time", slot.Duration, length),
+		}
+	}
+
+	if slot.Duration < movie.Duration {
+		return &ScheduleConflictError{
+			Reason:  ScheduleConflictRuntime,
+			Message: fmt.Sprintf("show of %d minutes is shorter than the %d minutes runtime of the movie", slot.Duration, movie.Duration),
+		}
+	}
+
+	if !venueSupportsFormat(venue, slot.MovieFormat) {
+		return &ScheduleConflictError{
+			Reason:  ScheduleConflictFormat,
+			Message: fmt.Sprintf("venue %d does not support the %s format", venue.ID, slot.MovieFormat),
+		}
+	}
+
+	return validateShowLanguages(slot, movie, venue)
+}
+
+/*
+FindScheduleConflict checks a show against the other shows of its screen
+
+	slot: the show, its own ID is skipped in others so it can be used for updates
+	others: shows of the same screen
+	buffer: time to keep free between two shows
+*/
+func FindScheduleConflict(slot models.MovieTimeSlot, others []models.MovieTimeSlot, buffer time.Duration) error {
+	for i := range others {
+		other := others[i]
+
+		if other.ID != 0 && other.ID == slot.ID {
+			continue
+		}
+
+		if slot.StartTime.Before(other.EndTime) && other.StartTime.Before(slot.EndTime) {
+			return &ScheduleConflictError{Reason: ScheduleConflictOverlap, Message: "show overlaps another show", MovieTimeSlot: &other}
+		}
+
+		if slot.StartTime.Before(other.EndTime.Add(buffer)) && other.StartTime.Before(slot.EndTime.Add(buffer)) {
+			return &ScheduleConflictError{
+				Reason:        ScheduleConflictTurnaround,
+				Message:       fmt.Sprintf("show leaves less than %s after or before another show", buffer),
+				MovieTimeSlot: &other,
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+checkSchedule validates a show against its movie, its screen and the other shows of the screen
+
+The venue row is locked for the rest of tx so two shows cannot be squeezed into the same gap concurrently
+*/
+func (m *MovieDB) checkSchedule(tx *gorm.DB, slot *models.MovieTimeSlot) (int, error) {
+	var venue models.Venue
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&venue, slot.VenueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		err := &ScheduleConflictError{Reason: ScheduleConflictVenueNotFound, Message: fmt.Sprintf("venue %d not found", slot.VenueID)}
+		return err.Status(), err
+	}
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	var movie models.Movie
+
+	result = tx.First(&movie, slot.MovieID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		err := &ScheduleConflictError{Reason: ScheduleConflictMovieNotFound, Message: fmt.Sprintf("movie %d not found", slot.MovieID)}
+		return err.Status(), err
+	}
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if err := ValidateShowTimes(slot, movie, venue); err != nil {
+		return err.(*ScheduleConflictError).Status(), err
+	}
+
+	buffer := m.ScheduleConfig.TurnaroundBuffer
+
+	var others []models.MovieTimeSlot
+
+	// A cancelled show gives its time back to the screen
+
+	result = tx.Where("venue_id = ? AND id <> ? AND status <> ? AND start_time < ? AND end_time > ?",
+		slot.VenueID, slot.ID, ShowtimeCancelled, slot.EndTime.Add(buffer), slot.StartTime.Add(-buffer)).
+		Order("start_time ASC").
+		Find(&others)
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if err := FindScheduleConflict(*slot, others, buffer); err != nil {
+		return err.(*ScheduleConflictError).Status(), err
+	}
+
+	return 200, nil
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+// Markers put around the matched words of the search snippets
+const (
+	SearchHighlightStart = "<mark>"
+	SearchHighlightStop  = "</mark>"
+)
+
+// MovieSearchFilters narrow down a search, empty fields are ignored
+type MovieSearchFilters struct {
+	Language        string
+	Genre           string
+	MovieResolution string
+}
+
+// MovieSearchResult is a movie matching a search together with why it matched
+type MovieSearchResult struct {
+	Movie              models.Movie
+	Rank               float64
+	TitleHighlight     string
+	DescriptionSnippet string
+	MatchedCast        []string
+}
+
+type movieSearchRow struct {
+	ID                 uint
+	Rank               float64
+	TitleHighlight     string
+	DescriptionSnippet string
+	MatchedCast        pq.StringArray `gorm:"type:text[]"`
+}
+
+/*
+SearchMovies does a ranked full-text search over the movie title, description and cast and crew names
+
+	query: free text as typed by the user, supports quotes, OR and -word
+	filters: optional language, genre and resolution filters
+	pageSize: number of results per page, defaults to 20 and is capped at 100
+	pageToken: next page token returned by the previous call, empty for the first page
+
+Words are matched with the full-text indexes, misspelled words still match titles and names through trigram similarity
+*/
+func (m *MovieDB) SearchMovies(query string, filters MovieSearchFilters, pageSize int, pageToken string) ([]MovieSearchResult, string, int, error) {
+	query = strings.TrimSpace(query)
+
+	if query == "" {
+		return nil, "", 400, errors.New("search query is required")
+	}
+
+	// The page token holds the number of results already returned, ranks are not unique enough for a keyset
+	offset, err := helper.DecodePageToken(pageToken)
+
+	if err != nil {
+		return nil, "", 400, err
+	}
+
+	pageSize = helper.PageSize(pageSize)
+
+	headline := "StartSel=" + SearchHighlightStart + ", StopSel=" + SearchHighlightStop
+
+	sql := `SELECT movies.id,
+		ts_rank_cd(` + helper.MovieSearchDocument + `, search.tsq) * 2
+			+ word_similarity(@query, movies.title)
+			+ COALESCE(cast_match.rank, 0) AS rank,
+		ts_headline('english', movies.title, search.tsq, 'HighlightAll=true, ` + headline + `') AS title_highlight,
+		ts_headline('english', movies.description, search.tsq, 'MaxFragments=2, MaxWords=30, MinWords=10, ` + headline + `') AS description_snippet,
+		cast_match.names AS matched_cast
+	FROM movies
+	CROSS JOIN (SELECT websearch_to_tsquery('english', @query) AS tsq, plainto_tsquery('simple', @query) AS name_tsq) AS search
+	LEFT JOIN LATERAL (
+		SELECT array_agg(cast_and_crews.name ORDER BY cast_and_crews.id) AS names,
+			max(word_similarity(@query, cast_and_crews.name)) AS rank
+		FROM cast_and_crews
+		WHERE cast_and_crews.movie_id = movies.id
+		AND cast_and_crews.deleted_at IS NULL
+		AND (` + helper.CastSearchDocument + ` @@ search.name_tsq OR @query <% cast_and_crews.name)
+	) AS cast_match ON true
+	WHERE movies.deleted_at IS NULL
+	AND (` + helper.MovieSearchDocument + ` @@ search.tsq OR @query <% movies.title OR cast_match.names IS NOT NULL)`
+
+	args := map[string]interface{}{
+		"query":  query,
+		"limit":  pageSize + 1,
+		"offset": offset,
+	}
+
+	if filters.Language != "" {
+		sql += ` AND @language = ANY(movies.language)`
+		args["language"] = filters.Language
+	}
+
+	if filters.Genre != "" {
+		sql += ` AND @genre = ANY(movies.type)`
+		args["genre"] = filters.Genre
+	}
+
+	if filters.MovieResolution != "" {
+		sql += ` AND @resolution = ANY(movies.movie_resolution)`
+		args["resolution"] = filters.MovieResolution
+	}
+
+	// Fetch one extra row to know if there is a next page
+
+	sql += ` ORDER BY rank DESC, movies.id ASC LIMIT @limit OFFSET @offset`
+
+	var rows []movieSearchRow
+
+	result := m.DB.Conn.Raw(sql, args).Scan(&rows)
+
+	if result.Error != nil {
+		return nil, "", 500, result.Error
+	}
+
+	nextPageToken := ""
+
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextPageToken = helper.EncodePageToken(offset + uint(pageSize))
+	}
+
+	if len(rows) == 0 {
+		return []MovieSearchResult{}, "", 200, nil
+	}
+
+	ids := make([]uint, 0, len(rows))
+
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	var movies []models.Movie
+
+	result = m.DB.Conn.Preload("CastCrew").Where("id IN ?", ids).Find(&movies)
+
+	if result.Error != nil {
+		return nil, "", 500, result.Error
+	}
+
+	moviesByID := make(map[uint]models.Movie, len(movies))
+
+	for _, movie := range movies {
+		moviesByID[movie.ID] = movie
+	}
+
+	results := make([]MovieSearchResult, 0, len(rows))
+
+	for _, row := range rows {
+		movie, ok := moviesByID[row.ID]
+
+		if !ok {
+			// Deleted between the two queries
+			continue
+		}
+
+		results = append(results, MovieSearchResult{
+			Movie:              movie,
+			Rank:               row.Rank,
+			TitleHighlight:     row.TitleHighlight,
+			DescriptionSnippet: row.DescriptionSnippet,
+			MatchedCast:        row.MatchedCast,
+		})
+	}
+
+	return results, nextPageToken, 200, nil
+}
@@ -0,0 +1,333 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Reasons a seat is taken off sale
+const (
+	SeatBlockBroken     = "BROKEN"
+	SeatBlockHouse      = "HOUSE" // House seats and VIP holds kept back by the cinema
+	SeatBlockDistancing = "DISTANCING"
+	SeatBlockCamera     = "CAMERA"
+	SeatBlockRemoved    = "REMOVED" // Reported for seats removed from the venue after the show was created, a block cannot have it
+)
+
+var seatBlockReasons = []string{SeatBlockBroken, SeatBlockHouse, SeatBlockDistancing, SeatBlockCamera}
+
+// SeatBlockCovers tells if a block takes its seat off sale for a show, a block covers the shows that overlap its times
+func SeatBlockCovers(block models.SeatBlock, slot models.MovieTimeSlot) bool {
+	if block.VenueID != slot.VenueID {
+		return false
+	}
+
+	if block.MovieTimeSlotID != nil && *block.MovieTimeSlotID != slot.ID {
+		return false
+	}
+
+	if block.StartTime != nil && !block.StartTime.Before(slot.EndTime) {
+		return false
+	}
+
+	if block.EndTime != nil && !block.EndTime.After(slot.StartTime) {
+		return false
+	}
+
+	return true
+}
+
+// ValidateSeatBlock checks the reason and times of a block, a block for one show covers the whole show and has no times
+func ValidateSeatBlock(block models.SeatBlock) error {
+	if !slices.Contains(seatBlockReasons, block.Reason) {
+		return fmt.Errorf("invalid block reason %s", block.Reason)
+	}
+
+	if block.MovieTimeSlotID != nil && (block.StartTime != nil || block.EndTime != nil) {
+		return errors.New("a block for one show cannot have a start or end time")
+	}
+
+	if block.StartTime != nil && block.EndTime != nil && !block.EndTime.After(*block.StartTime) {
+		return errors.New("the end time of a block has to be after its start time")
+	}
+
+	return nil
+}
+
+// seatBlocksCovering is the query for the blocks SeatBlockCovers reports for a show
+func seatBlocksCovering(tx *gorm.DB, slot models.MovieTimeSlot) *gorm.DB {
+	return tx.Model(&models.SeatBlock{}).
+		Where("venue_id = ? AND (movie_time_slot_id IS NULL OR movie_time_slot_id = ?)", slot.VenueID, slot.ID).
+		Where("(start_time IS NULL OR start_time < ?) AND (end_time IS NULL OR end_time > ?)", slot.EndTime, slot.StartTime)
+}
+
+// blockedSeats returns the first block of every blocked seat of a show by seat matrix ID
+func blockedSeats(tx *gorm.DB, slot models.MovieTimeSlot) (map[uint]models.SeatBlock, error) {
+	var blocks []models.SeatBlock
+
+	if err := seatBlocksCovering(tx, slot).Order("id ASC").Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[uint]models.SeatBlock, len(blocks))
+
+	for _, block := range blocks {
+		if _, ok := blocked[block.SeatMatrixID]; !ok {
+			blocked[block.SeatMatrixID] = block
+		}
+	}
+
+	return blocked, nil
+}
+
+// checkNotBlocked refuses to sell seats of a show that are blocked
+func checkNotBlocked(tx *gorm.DB, slot models.MovieTimeSlot, seats []models.BookedSeats) (int, error) {
+	blocked, err := blockedSeats(tx, slot)
+
+	if err != nil {
+		return 500, err
+	}
+
+	for _, seat := range seats {
+		if block, ok := blocked[seat.SeatMatrixID]; ok {
+			return 409, fmt.Errorf("seat %s is blocked (%s)", seat.SeatNumber, block.Reason)
+		}
+	}
+
+	return 200, nil
+}
+
+/*
+BlockSeats takes seats off sale
+
+	block: the reason, note and times of the block. With MovieTimeSlotID only that show is blocked, otherwise every show
+	of VenueID between StartTime and EndTime
+	seatMatrixIDs: seats of the venue to block, every one of them gets its own block
+
+A seat that is sold or held for a show the block covers cannot be blocked
+*/
+func (m *MovieDB) BlockSeats(block models.SeatBlock, seatMatrixIDs []uint) ([]models.SeatBlock, int, error) {
+	if len(seatMatrixIDs) == 0 {
+		return nil, 400, errors.New("no seats given to block")
+	}
+
+	if err := ValidateSeatBlock(block); err != nil {
+		return nil, 400, err
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if block.MovieTimeSlotID != nil {
+		slot, status, err := lockShowtime(tx, *block.MovieTimeSlotID)
+
+		if err != nil {
+			tx.Rollback()
+			return nil, status, err
+		}
+
+		block.VenueID = slot.VenueID
+	}
+
+	if block.VenueID == 0 {
+		tx.Rollback()
+		return nil, 400, errors.New("a venue or a show is required")
+	}
+
+	var seats []models.SeatMatrix
+
+	if err := tx.Where("id IN ? AND venue_id = ?", seatMatrixIDs, block.VenueID).Find(&seats).Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	if len(seats) != len(seatMatrixIDs) {
+		tx.Rollback()
+		return nil, 404, fmt.Errorf("some of the seats are not part of venue %d", block.VenueID)
+	}
+
+	shows, err := coveredShows(tx, block)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	sold, err := soldSeats(tx, block.VenueID, seatMatrixIDs)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	for _, seat := range sold {
+		if _, ok := shows[seat.MovieTimeSlotID]; ok {
+			tx.Rollback()
+			return nil, 409, fmt.Errorf("seat %s is sold or held for show %d", seat.SeatNumber, seat.MovieTimeSlotID)
+		}
+	}
+
+	blocks := make([]models.SeatBlock, 0, len(seats))
+
+	for _, seat := range seats {
+		seatBlock := block
+		seatBlock.ID = 0
+		seatBlock.SeatMatrixID = seat.ID
+		blocks = append(blocks, seatBlock)
+	}
+
+	if err := tx.Create(&blocks).Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	// Blocking the last unsold seats sells a show out
+
+	for _, slot := range shows {
+		if slot.Status != ShowtimeOnSale {
+			continue
+		}
+
+		if err := refreshSoldOut(tx, slot.ID); err != nil {
+			tx.Rollback()
+			return nil, 500, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return blocks, 200, nil
+}
+
+// UnblockSeats puts the seats of blocks back on sale, sold out shows that get a seat back are on sale again
+func (m *MovieDB) UnblockSeats(blockIDs []uint) (int, error) {
+	if len(blockIDs) == 0 {
+		return 400, errors.New("no seat blocks given")
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var blocks []models.SeatBlock
+
+	if err := tx.Where("id IN ?", blockIDs).Find(&blocks).Error; err != nil {
+		tx.Rollback()
+		return 500, err
+	}
+
+	if len(blocks) != len(blockIDs) {
+		tx.Rollback()
+		return 404, errors.New("some of the seat blocks do not exist")
+	}
+
+	if err := tx.Delete(&models.SeatBlock{}, blockIDs).Error; err != nil {
+		tx.Rollback()
+		return 500, err
+	}
+
+	for _, block := range blocks {
+		shows, err := coveredShows(tx, block)
+
+		if err != nil {
+			tx.Rollback()
+			return 500, err
+		}
+
+		for _, slot := range shows {
+			if slot.Status != ShowtimeSoldOut {
+				continue
+			}
+
+			unsold, err := unsoldSeats(tx, slot)
+
+			if err != nil {
+				tx.Rollback()
+				return 500, err
+			}
+
+			if unsold == 0 {
+				continue
+			}
+
+			if err := tx.Model(&models.MovieTimeSlot{}).Where("id = ?", slot.ID).Update("status", ShowtimeOnSale).Error; err != nil {
+				tx.Rollback()
+				return 500, err
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return 200, nil
+}
+
+/*
+GetSeatBlocks returns the blocks of a venue that did not end, or with movieTimeSlotID the blocks that cover that show
+
+	venueID: ignored when movieTimeSlotID is given
+*/
+func (m *MovieDB) GetSeatBlocks(venueID uint, movieTimeSlotID uint) ([]models.SeatBlock, int, error) {
+	var blocks []models.SeatBlock
+
+	query := m.DB.Conn.Model(&models.SeatBlock{}).Where("venue_id = ? AND (end_time IS NULL OR end_time > now())", venueID)
+
+	if movieTimeSlotID != 0 {
+		var slot models.MovieTimeSlot
+
+		result := m.DB.Conn.First(&slot, movieTimeSlotID)
+
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, 404, errors.New("movie time slot not found")
+		}
+
+		if result.Error != nil {
+			return nil, 500, result.Error
+		}
+
+		query = seatBlocksCovering(m.DB.Conn, slot)
+	}
+
+	if err := query.Order("id ASC").Find(&blocks).Error; err != nil {
+		return nil, 500, err
+	}
+
+	return blocks, 200, nil
+}
+
+// coveredShows locks the upcoming shows a block covers, by ID
+func coveredShows(tx *gorm.DB, block models.SeatBlock) (map[uint]models.MovieTimeSlot, error) {
+	var slots []models.MovieTimeSlot
+
+	if err := upcomingShows(tx, block.VenueID).Clauses(clause.Locking{Strength: "UPDATE"}).Order("id ASC").Find(&slots).Error; err != nil {
+		return nil, err
+	}
+
+	shows := make(map[uint]models.MovieTimeSlot)
+
+	for _, slot := range slots {
+		if SeatBlockCovers(block, slot) {
+			shows[slot.ID] = slot
+		}
+	}
+
+	return shows, nil
+}
@@ -0,0 +1,277 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// How a change to the seat matrix of a venue treats upcoming shows that sold or hold one of the seats it touches
+const (
+	SeatChangeReject      = "REJECT"       // Refuse the change
+	SeatChangeUnsoldShows = "UNSOLD_SHOWS" // Change the seat for the shows where it is unsold, shows that sold it keep it as it was
+)
+
+// soldSeatSQL matches the booked seats SeatStatus reports as BOOKED or HELD
+const soldSeatSQL = "booked_seats.is_booked = true AND (booked_seats.email IS NOT NULL OR booked_seats.phone_number <> '' " +
+	"OR booked_seats.hold_token IS NULL OR booked_seats.locked_until > now())"
+
+// ShowSeatChanges is what ApplySeatMatrixToShows changed in the seats of one show
+type ShowSeatChanges struct {
+	MovieTimeSlotID uint
+	Added           int // Seats of the venue the show did not have yet
+	Removed         int // Unsold seats that are no longer part of the venue
+	Renumbered      int // Unsold seats that took the new number of their seat
+	Kept            int // Sold or held seats that are no longer part of the venue, they stay until the show is over
+}
+
+func validSeatChangeMode(mode string) error {
+	if mode != SeatChangeReject && mode != SeatChangeUnsoldShows {
+		return fmt.Errorf("invalid seat change mode %s", mode)
+	}
+
+	return nil
+}
+
+// upcomingShows is the query for the shows of a venue that did not end and were not cancelled
+func upcomingShows(tx *gorm.DB, venueID uint) *gorm.DB {
+	return tx.Model(&models.MovieTimeSlot{}).
+		Where("venue_id = ? AND end_time > now() AND status NOT IN ?", venueID, []string{ShowtimeCancelled, ShowtimeCompleted})
+}
+
+// soldSeats returns the booked seats of upcoming shows that sold or hold one of the given seats
+func soldSeats(tx *gorm.DB, venueID uint, seatMatrixIDs []uint) ([]models.BookedSeats, error) {
+	var sold []models.BookedSeats
+
+	err := tx.Where("seat_matrix_id IN ? AND movie_time_slot_id IN (?)", seatMatrixIDs, upcomingShows(tx, venueID).Select("id")).
+		Where(soldSeatSQL).
+		Find(&sold).Error
+
+	return sold, err
+}
+
+// removeUnsoldShowSeats takes seats that are no longer part of the venue out of the upcoming shows that did not sell them
+func removeUnsoldShowSeats(tx *gorm.DB, venueID uint, seatMatrixIDs []uint) error {
+	return tx.Unscoped().
+		Where("seat_matrix_id IN ? AND movie_time_slot_id IN (?)", seatMatrixIDs, upcomingShows(tx, venueID).Select("id")).
+		Where("NOT (" + soldSeatSQL + ")").
+		Delete(&models.BookedSeats{}).Error
+}
+
+// checkSoldSeats refuses a change in SeatChangeReject mode when one of the seats is sold or held for an upcoming show
+func checkSoldSeats(tx *gorm.DB, venueID uint, seatMatrixIDs []uint, mode string) ([]models.BookedSeats, int, error) {
+	if err := validSeatChangeMode(mode); err != nil {
+		return nil, 400, err
+	}
+
+	sold, err := soldSeats(tx, venueID, seatMatrixIDs)
+
+	if err != nil {
+		return nil, 500, err
+	}
+
+	if len(sold) > 0 && mode == SeatChangeReject {
+		shows := make(map[uint]bool)
+
+		for _, seat := range sold {
+			shows[seat.MovieTimeSlotID] = true
+		}
+
+		return nil, 409, fmt.Errorf("seat %s is sold or held for %d upcoming shows", sold[0].SeatNumber, len(shows))
+	}
+
+	return sold, 200, nil
+}
+
+// deleteSeats soft deletes seats of a venue and takes them out of the upcoming shows that did not sell them
+func deleteSeats(tx *gorm.DB, venueID uint, seatMatrixIDs []uint, mode string) (int, error) {
+	if _, status, err := checkSoldSeats(tx, venueID, seatMatrixIDs, mode); err != nil {
+		return status, err
+	}
+
+	if err := removeUnsoldShowSeats(tx, venueID, seatMatrixIDs); err != nil {
+		return 500, err
+	}
+
+	if err := tx.Where("id IN ?", seatMatrixIDs).Delete(&models.SeatMatrix{}).Error; err != nil {
+		return 500, err
+	}
+
+	return 200, nil
+}
+
+/*
+ApplySeatMatrixToShows brings the seats of the upcoming shows of a venue in line with its seat matrix
+
+Shows take their seats from the seat matrix when they are created, later changes only reach them through this.
+Seats added to the venue are added to every show, unsold seats that were removed are taken out and sold or held
+seats are never touched. With dryRun the changes are only counted
+*/
+func (m *MovieDB) ApplySeatMatrixToShows(venueID uint, dryRun bool) ([]ShowSeatChanges, int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var venue models.Venue
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return nil, 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, 500, result.Error
+	}
+
+	changes, err := reconcileShowSeats(tx, venueID)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	if dryRun {
+		tx.Rollback()
+		return changes, 200, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return changes, 200, nil
+}
+
+// reconcileShowSeats updates the booked seats of every upcoming show of a venue locked in tx, see ApplySeatMatrixToShows
+func reconcileShowSeats(tx *gorm.DB, venueID uint) ([]ShowSeatChanges, error) {
+	var seats []models.SeatMatrix
+
+	// Removed seats are read too, a sold seat that was removed still takes up its place in the shows that sold it
+
+	if err := tx.Unscoped().Where("venue_id = ?", venueID).Order("id ASC").Find(&seats).Error; err != nil {
+		return nil, err
+	}
+
+	var slots []models.MovieTimeSlot
+
+	if err := upcomingShows(tx, venueID).Clauses(clause.Locking{Strength: "UPDATE"}).Order("id ASC").Find(&slots).Error; err != nil {
+		return nil, err
+	}
+
+	seatsByID := make(map[uint]models.SeatMatrix, len(seats))
+
+	for _, seat := range seats {
+		seatsByID[seat.ID] = seat
+	}
+
+	changes := make([]ShowSeatChanges, 0, len(slots))
+
+	for _, slot := range slots {
+		change, err := reconcileShow(tx, slot, seats, seatsByID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func reconcileShow(tx *gorm.DB, slot models.MovieTimeSlot, seats []models.SeatMatrix, seatsByID map[uint]models.SeatMatrix) (ShowSeatChanges, error) {
+	change := ShowSeatChanges{MovieTimeSlotID: slot.ID}
+
+	var bookedSeats []models.BookedSeats
+
+	if err := tx.Where("movie_time_slot_id = ?", slot.ID).Find(&bookedSeats).Error; err != nil {
+		return change, err
+	}
+
+	now := time.Now()
+	has := make(map[uint]bool, len(bookedSeats))
+	taken := make(map[[2]int]bool)
+
+	for _, bookedSeat := range bookedSeats {
+		seat, ok := seatsByID[bookedSeat.SeatMatrixID]
+		free := SeatStatus(bookedSeat, now) == SeatStatusFree
+
+		if ok && !seat.DeletedAt.Valid {
+			has[seat.ID] = true
+
+			if free && bookedSeat.SeatNumber != seat.SeatNumber {
+				if err := tx.Model(&models.BookedSeats{}).Where("id = ?", bookedSeat.ID).Update("seat_number", seat.SeatNumber).Error; err != nil {
+					return change, err
+				}
+
+				change.Renumbered++
+			}
+
+			continue
+		}
+
+		if free {
+			if err := tx.Unscoped().Delete(&models.BookedSeats{}, bookedSeat.ID).Error; err != nil {
+				return change, err
+			}
+
+			change.Removed++
+			continue
+		}
+
+		change.Kept++
+
+		if ok {
+			taken[[2]int{seat.Row, seat.Column}] = true
+		}
+	}
+
+	added := make([]models.BookedSeats, 0)
+
+	for _, seat := range seats {
+		if seat.DeletedAt.Valid || has[seat.ID] || taken[[2]int{seat.Row, seat.Column}] {
+			continue
+		}
+
+		added = append(added, models.BookedSeats{
+			SeatNumber:      seat.SeatNumber,
+			MovieTimeSlotID: slot.ID,
+			SeatMatrixID:    seat.ID,
+		})
+	}
+
+	if len(added) > 0 {
+		if err := tx.Create(&added).Error; err != nil {
+			return change, err
+		}
+
+		change.Added = len(added)
+	}
+
+	// A sold out show with new seats is back on sale, one that lost its last unsold seats may be sold out now
+
+	if change.Added > 0 && slot.Status == ShowtimeSoldOut {
+		if err := tx.Model(&models.MovieTimeSlot{}).Where("id = ?", slot.ID).Update("status", ShowtimeOnSale).Error; err != nil {
+			return change, err
+		}
+	}
+
+	if change.Removed > 0 && slot.Status == ShowtimeOnSale {
+		if err := refreshSoldOut(tx, slot.ID); err != nil {
+			return change, err
+		}
+	}
+
+	return change, nil
+}
@@ -0,0 +1,527 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Formats of a seat grid
+const (
+	SeatGridText = "TEXT"
+	SeatGridCSV  = "CSV"
+)
+
+// seatCodes are the cells of a seat grid that are seats, by the type of the seat. A seat of a type without a code is
+// written as its type in brackets, e.g. [RECLINER]
+var seatCodes = map[string]string{
+	"N": "NORMAL",
+	"V": "VIP",
+	"2": "TWO_D",
+	"3": "THREE_D",
+	"4": "FOUR_D",
+}
+
+// seatCellType is the seat type of a cell of a seat grid, false when the cell is not a seat
+func seatCellType(code string) (string, bool) {
+	if seatType, ok := seatCodes[strings.ToUpper(code)]; ok {
+		return seatType, true
+	}
+
+	if len(code) > 2 && strings.HasPrefix(code, "[") && strings.HasSuffix(code, "]") {
+		return strings.ToUpper(strings.TrimSpace(code[1 : len(code)-1])), true
+	}
+
+	return "", false
+}
+
+// seatCellCode is how a seat of a type is written in a seat grid
+func seatCellCode(seatType string) (string, bool) {
+	for code, codeType := range seatCodes {
+		if codeType == seatType {
+			return code, true
+		}
+	}
+
+	if seatType == "" || strings.ContainsAny(seatType, "[] \t\r\n") {
+		return "", false
+	}
+
+	return "[" + seatType + "]", true
+}
+
+// cellCodes are the cells of a seat grid that are not seats
+var cellCodes = map[string]string{
+	"_": LayoutCellEmpty,
+	"|": LayoutCellAisle,
+	"=": LayoutCellStairs,
+	"#": LayoutCellPillar,
+	"W": LayoutCellWheelchair,
+}
+
+// SeatGrid is a seat map read from a grid, ready to be written to a venue
+type SeatGrid struct {
+	Rows      int
+	Columns   int
+	RowLabels []string
+	Seats     []models.SeatMatrix
+	Cells     []models.LayoutCell
+}
+
+/*
+ParseSeatGrid reads a seat map drawn as a grid, one line per row from the row closest to the screen
+
+	data: in TEXT the cells of a row are separated by spaces and the row can start with its label, e.g. "A: V V _ N N".
+	In CSV the first field is the label, empty for the default one, and every other field a cell
+	prices: price of a seat by its type, every seat type used in the grid needs one
+
+N is a normal seat, V a VIP seat, 2, 3 and 4 TWO_D, THREE_D and FOUR_D seats and a type in brackets a seat of that type,
+e.g. [RECLINER]. W is a wheelchair space, | an aisle, = stairs, # a pillar and _ an empty cell. Seats are numbered by their row label and their place among the seats of the row, e.g. A1, A2
+*/
+func ParseSeatGrid(data string, format string, prices map[string]int) (SeatGrid, error) {
+	var grid SeatGrid
+	var lines [][]string
+	var labels []string
+
+	switch strings.ToUpper(format) {
+	case SeatGridText, "":
+		for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			label := ""
+
+			if i := strings.Index(line, ":"); i >= 0 {
+				label = strings.TrimSpace(line[:i])
+				line = line[i+1:]
+			}
+
+			labels = append(labels, label)
+			lines = append(lines, strings.Fields(line))
+		}
+	case SeatGridCSV:
+		reader := csv.NewReader(strings.NewReader(data))
+		reader.FieldsPerRecord = -1
+
+		records, err := reader.ReadAll()
+
+		if err != nil {
+			return grid, fmt.Errorf("error reading csv: %v", err)
+		}
+
+		for _, record := range records {
+			if len(record) == 0 || (len(record) == 1 && strings.TrimSpace(record[0]) == "") {
+				continue
+			}
+
+			labels = append(labels, strings.TrimSpace(record[0]))
+
+			cells := make([]string, 0, len(record)-1)
+
+			for _, cell := range record[1:] {
+				cells = append(cells, strings.TrimSpace(cell))
+			}
+
+			lines = append(lines, cells)
+		}
+	default:
+		return grid, fmt.Errorf("unknown seat grid format %s, expected %s or %s", format, SeatGridText, SeatGridCSV)
+	}
+
+	if len(lines) == 0 {
+		return grid, errors.New("seat grid is empty")
+	}
+
+	grid.Rows = len(lines)
+
+	// Labels are only kept when one is given, the others fall back to the default letters
+
+	if slices.ContainsFunc(labels, func(label string) bool { return label != "" }) {
+		grid.RowLabels = make([]string, len(labels))
+
+		for i, label := range labels {
+			if label == "" {
+				label = RowLabel(i+1, nil)
+			}
+
+			grid.RowLabels[i] = label
+		}
+	}
+
+	for r, cells := range lines {
+		row := r + 1
+		label := RowLabel(row, grid.RowLabels)
+		number := 0
+
+		grid.Columns = max(grid.Columns, len(cells))
+
+		for c, code := range cells {
+			column := c + 1
+
+			if seatType, ok := seatCellType(code); ok {
+				price, ok := prices[seatType]
+
+				if !ok {
+					return grid, fmt.Errorf("no price given for %s seats", seatType)
+				}
+
+				number++
+
+				grid.Seats = append(grid.Seats, models.SeatMatrix{
+					SeatNumber: fmt.Sprintf("%s%d", label, number),
+					Row:        row,
+					Column:     column,
+					Price:      price,
+					Type:       seatType,
+				})
+
+				continue
+			}
+
+			kind, ok := cellCodes[strings.ToUpper(code)]
+
+			if !ok {
+				return grid, fmt.Errorf("unknown cell %q in row %s column %d", code, label, column)
+			}
+
+			// Empty cells are the default and are not stored
+			if kind != LayoutCellEmpty {
+				grid.Cells = append(grid.Cells, models.LayoutCell{Row: row, Column: column, Kind: kind})
+			}
+		}
+	}
+
+	if len(grid.Seats) == 0 {
+		return grid, errors.New("seat grid has no seats")
+	}
+
+	return grid, nil
+}
+
+// SeatGridPrices is the price of every seat type of a seat map, an error is returned when seats of one type have different prices
+func SeatGridPrices(seats []models.SeatMatrix) (map[string]int, error) {
+	prices := make(map[string]int)
+
+	for _, seat := range seats {
+		if price, ok := prices[seat.Type]; ok && price != seat.Price {
+			return nil, fmt.Errorf("%s seats have different prices, the grid can only hold one price per seat type", seat.Type)
+		}
+
+		prices[seat.Type] = seat.Price
+	}
+
+	return prices, nil
+}
+
+// FormatSeatGrid writes the seat map of a venue as a grid ParseSeatGrid reads back
+func FormatSeatGrid(layout VenueLayout, format string) (string, error) {
+	codes := make(map[string]string, len(cellCodes))
+
+	for code, kind := range cellCodes {
+		codes[kind] = code
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	for _, row := range layout.Rows {
+		cells := make([]string, 0, len(row.Cells))
+
+		for _, cell := range row.Cells {
+			code, ok := codes[cell.Kind]
+
+			if cell.Seat != nil {
+				code, ok = seatCellCode(cell.Seat.Type)
+			}
+
+			if !ok {
+				return "", fmt.Errorf("cell at row %s column %d has no code in a seat grid", row.Label, cell.Column)
+			}
+
+			cells = append(cells, code)
+		}
+
+		switch strings.ToUpper(format) {
+		case SeatGridText, "":
+			buffer.WriteString(row.Label + ": " + strings.Join(cells, " ") + "\n")
+		case SeatGridCSV:
+			if err := writer.Write(append([]string{row.Label}, cells...)); err != nil {
+				return "", err
+			}
+		default:
+			return "", fmt.Errorf("unknown seat grid format %s, expected %s or %s", format, SeatGridText, SeatGridCSV)
+		}
+	}
+
+	writer.Flush()
+
+	return buffer.String(), writer.Error()
+}
+
+/*
+ImportSeatGrid creates the seats and layout cells of a venue from a grid, see ParseSeatGrid
+
+	prices: in minor units of currency
+	currency: empty for the currency of the venue, another currency is refused
+
+The venue takes the size and row labels of the grid. It cannot have seats yet, everything is written in one transaction
+and nothing is written with dryRun
+*/
+func (m *MovieDB) ImportSeatGrid(venueID uint, data string, format string, prices map[string]int, currency string, dryRun bool) (VenueLayout, int, error) {
+	grid, err := ParseSeatGrid(data, format, prices)
+
+	if err != nil {
+		return VenueLayout{}, 400, err
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var venue models.Venue
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Sections").First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return VenueLayout{}, 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, result.Error
+	}
+
+	if err := checkCurrency(currency, venue.Currency); err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 400, err
+	}
+
+	var seatCount int64
+
+	if err := tx.Model(&models.SeatMatrix{}).Where("venue_id = ?", venueID).Count(&seatCount).Error; err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if seatCount > 0 {
+		tx.Rollback()
+		return VenueLayout{}, 409, fmt.Errorf("venue %d already has %d seats", venueID, seatCount)
+	}
+
+	if status, err := writeSeatGrid(tx, &venue, grid, dryRun); err != nil {
+		tx.Rollback()
+		return VenueLayout{}, status, err
+	}
+
+	if dryRun {
+		tx.Rollback()
+		return BuildVenueLayout(venue, venue.Seats), 200, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return VenueLayout{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return BuildVenueLayout(venue, venue.Seats), 200, nil
+}
+
+/*
+writeSeatGrid gives a venue locked in tx the size, row labels, layout cells and seats of grid
+
+A seat at a position the venue already had a seat at keeps the ID of that seat so the shows that sold it still find it,
+seats at positions the grid leaves out are soft deleted. The upcoming shows of the venue are brought in line with the
+new seats like ApplySeatMatrixToShows does. With dryRun the grid is only validated
+*/
+func writeSeatGrid(tx *gorm.DB, venue *models.Venue, grid SeatGrid, dryRun bool) (int, error) {
+	venue.Rows = grid.Rows
+	venue.Columns = grid.Columns
+	venue.RowLabels = grid.RowLabels
+	venue.LayoutCells = grid.Cells
+	venue.Seats = grid.Seats
+
+	for i := range grid.Seats {
+		grid.Seats[i].ID = 0
+		grid.Seats[i].VenueID = venue.ID
+	}
+
+	for i := range grid.Cells {
+		grid.Cells[i].ID = 0
+		grid.Cells[i].VenueID = venue.ID
+	}
+
+	if err := ValidateVenueLayout(*venue, grid.Seats); err != nil {
+		return 400, err
+	}
+
+	if dryRun {
+		return 200, nil
+	}
+
+	err := tx.Model(&models.Venue{}).Where("id = ?", venue.ID).Updates(map[string]any{
+		"rows":       venue.Rows,
+		"columns":    venue.Columns,
+		"row_labels": venue.RowLabels,
+	}).Error
+
+	if err != nil {
+		return 500, err
+	}
+
+	if err := tx.Unscoped().Where("venue_id = ?", venue.ID).Delete(&models.LayoutCell{}).Error; err != nil {
+		return 500, err
+	}
+
+	if len(grid.Cells) > 0 {
+		if err := tx.Create(&grid.Cells).Error; err != nil {
+			return 500, err
+		}
+	}
+
+	// Seats deleted earlier are brought back when the grid has a seat at their position again
+
+	var existing []models.SeatMatrix
+
+	if err := tx.Unscoped().Where("venue_id = ?", venue.ID).Order("id ASC").Find(&existing).Error; err != nil {
+		return 500, err
+	}
+
+	byPosition := make(map[[2]int]models.SeatMatrix, len(existing))
+
+	for _, seat := range existing {
+		position := [2]int{seat.Row, seat.Column}
+
+		if current, ok := byPosition[position]; !ok || (current.DeletedAt.Valid && !seat.DeletedAt.Valid) {
+			byPosition[position] = seat
+		}
+	}
+
+	added := make([]*models.SeatMatrix, 0)
+
+	for i := range grid.Seats {
+		seat := &grid.Seats[i]
+		position := [2]int{seat.Row, seat.Column}
+
+		old, ok := byPosition[position]
+
+		if !ok {
+			added = append(added, seat)
+			continue
+		}
+
+		delete(byPosition, position)
+
+		seat.ID = old.ID
+
+		err := tx.Unscoped().Model(&models.SeatMatrix{}).Where("id = ?", old.ID).Updates(map[string]any{
+			"seat_number": seat.SeatNumber,
+			"type":        seat.Type,
+			"price":       seat.Price,
+			"deleted_at":  nil,
+		}).Error
+
+		if err != nil {
+			return 500, err
+		}
+	}
+
+	for _, seat := range added {
+		if err := tx.Create(seat).Error; err != nil {
+			return 500, err
+		}
+	}
+
+	removed := make([]uint, 0, len(byPosition))
+
+	for _, seat := range byPosition {
+		if !seat.DeletedAt.Valid {
+			removed = append(removed, seat.ID)
+		}
+	}
+
+	if len(removed) > 0 {
+		if err := tx.Where("id IN ?", removed).Delete(&models.SeatMatrix{}).Error; err != nil {
+			return 500, err
+		}
+	}
+
+	if _, err := reconcileShowSeats(tx, venue.ID); err != nil {
+		return 500, err
+	}
+
+	return 200, nil
+}
+
+// ExportSeatGrid writes the seat map of a venue as a grid together with the price of every seat type and their currency
+func (m *MovieDB) ExportSeatGrid(venueID uint, format string) (string, map[string]int, string, int, error) {
+	layout, status, err := m.GetVenueLayout(venueID)
+
+	if status != 200 || err != nil {
+		return "", nil, "", status, err
+	}
+
+	seats := make([]models.SeatMatrix, 0)
+
+	for _, row := range layout.Rows {
+		for _, cell := range row.Cells {
+			if cell.Seat != nil {
+				seats = append(seats, *cell.Seat)
+			}
+		}
+	}
+
+	prices, err := SeatGridPrices(seats)
+
+	if err != nil {
+		return "", nil, "", 400, err
+	}
+
+	data, err := FormatSeatGrid(layout, format)
+
+	if err != nil {
+		return "", nil, "", 400, err
+	}
+
+	return data, prices, layout.Venue.Currency, 200, nil
+}
+
+// ParseSeatPrices reads prices in minor units given as TYPE=PRICE pairs separated by commas, e.g. VIP=45000,NORMAL=25000
+func ParseSeatPrices(value string) (map[string]int, error) {
+	prices := make(map[string]int)
+
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("price %q should be TYPE=PRICE", pair)
+		}
+
+		seatType := strings.ToUpper(strings.TrimSpace(parts[0]))
+		price, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+
+		if err != nil || price < 0 {
+			return nil, fmt.Errorf("invalid price %q for %s seats", parts[1], seatType)
+		}
+
+		prices[seatType] = price
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SeatHoldConfig bounds how long seats can stay locked for a checkout
+type SeatHoldConfig struct {
+	DefaultDuration time.Duration // used when neither the request nor the venue asks for a duration
+	MaxDuration     time.Duration // upper bound for a hold and for every extension of it
+	MaxExtensions   int           // how many times the same hold can be extended
+}
+
+func DefaultSeatHoldConfig() SeatHoldConfig {
+	return SeatHoldConfig{
+		DefaultDuration: 15 * time.Minute,
+		MaxDuration:     30 * time.Minute,
+		MaxExtensions:   1,
+	}
+}
+
+// SeatHoldConfigFromEnv reads SEAT_HOLD_DEFAULT_DURATION, SEAT_HOLD_MAX_DURATION and SEAT_HOLD_MAX_EXTENSIONS,
+// falling back to the defaults for values that are missing or invalid
+func SeatHoldConfigFromEnv() SeatHoldConfig {
+	config := DefaultSeatHoldConfig()
+
+	if d, err := time.ParseDuration(os.Getenv("SEAT_HOLD_DEFAULT_DURATION")); err == nil && d > 0 {
+		config.DefaultDuration = d
+	}
+
+	if d, err := time.ParseDuration(os.Getenv("SEAT_HOLD_MAX_DURATION")); err == nil && d > 0 {
+		config.MaxDuration = d
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("SEAT_HOLD_MAX_EXTENSIONS")); err == nil && n >= 0 {
+		config.MaxExtensions = n
+	}
+
+	if config.DefaultDuration > config.MaxDuration {
+		config.DefaultDuration = config.MaxDuration
+	}
+
+	return config
+}
+
+/*
+HoldDuration picks the duration of a new hold
+
+	requested: duration asked for by the caller, 0 when not given
+	venueDefault: the venue's configured hold duration, 0 when not configured
+
+The result never exceeds MaxDuration.
+*/
+func (c SeatHoldConfig) HoldDuration(requested time.Duration, venueDefault time.Duration) time.Duration {
+	duration := c.DefaultDuration
+
+	if venueDefault > 0 {
+		duration = venueDefault
+	}
+
+	if requested > 0 {
+		duration = requested
+	}
+
+	if duration > c.MaxDuration {
+		duration = c.MaxDuration
+	}
+
+	return duration
+}
+
+// venueHoldDuration returns the hold duration configured on the venue of the movie time slot, 0 when there is none
+func venueHoldDuration(db *gorm.DB, movieTimeSlotID uint) (time.Duration, error) {
+	var venue models.Venue
+
+	err := db.
+		Joins("JOIN movie_time_slots mts ON mts.venue_id = venues.id").
+		Where("mts.id = ?", movieTimeSlotID).
+		Select("venues.id, venues.seat_hold_minutes").
+		Find(&venue).Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(venue.SeatHoldMinutes) * time.Minute, nil
+}
+
+/*
+ExtendSeatHold pushes the expiry of an existing hold further, e.g. while a 3DS or UPI confirmation is still pending
+
+	holdToken: The hold token returned by LockBookedSeats
+	extendBy: How much to extend the hold by, 0 uses the default hold duration. Capped at the maximum hold duration
+
+A hold can only be extended while it is still active and at most MaxExtensions times.
+*/
+func (m *MovieDB) ExtendSeatHold(holdToken string, extendBy time.Duration) (SeatHold, int, error) {
+	var hold SeatHold
+	var bookedSeats []models.BookedSeats
+
+	if holdToken == "" {
+		return hold, 400, errors.New("hold token is required to extend a seat hold")
+	}
+
+	if extendBy < 0 {
+		return hold, 400, errors.New("hold extension cannot be negative")
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	if tx.Error != nil {
+		return hold, 500, tx.Error
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("hold_token = ?", holdToken).Order("id").Find(&bookedSeats)
+
+	if result.Error != nil {
+		tx.Rollback()
+		return hold, 500, result.Error
+	}
+
+	if len(bookedSeats) == 0 {
+		tx.Rollback()
+		return hold, 404, errors.New("no seats are held by the given hold token")
+	}
+
+	now := time.Now()
+	current := bookedSeats[0]
+
+	if current.LockedUntil == nil || current.LockedUntil.Before(now) {
+		tx.Rollback()
+		return hold, 409, errors.New("seat hold has already expired")
+	}
+
+	if current.HoldExtensions >= m.HoldConfig.MaxExtensions {
+		tx.Rollback()
+		return hold, 409, fmt.Errorf("seat hold cannot be extended more than %d times", m.HoldConfig.MaxExtensions)
+	}
+
+	if extendBy == 0 || extendBy > m.HoldConfig.MaxDuration {
+		extendBy = m.HoldConfig.HoldDuration(extendBy, 0)
+	}
+
+	lockedUntil := current.LockedUntil.Add(extendBy)
+
+	for i := range bookedSeats {
+		bookedSeats[i].LockedUntil = ptrTime(lockedUntil)
+		bookedSeats[i].HoldExtensions = current.HoldExtensions + 1
+	}
+
+	result = tx.Model(&models.BookedSeats{}).Where("hold_token = ?", holdToken).Updates(map[string]any{
+		"locked_until":    lockedUntil,
+		"hold_extensions": current.HoldExtensions + 1,
+	})
+
+	if result.Error != nil {
+		tx.Rollback()
+		return hold, 500, result.Error
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return hold, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	lockedBy := ""
+
+	if current.LockedBy != nil {
+		lockedBy = *current.LockedBy
+	}
+
+	return SeatHold{
+		HoldToken:      holdToken,
+		LockedBy:       lockedBy,
+		LockedUntil:    lockedUntil,
+		ExtensionsLeft: m.HoldConfig.MaxExtensions - bookedSeats[0].HoldExtensions,
+		BookedSeats:    bookedSeats,
+	}, 200, nil
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+// Live status of a seat for a particular show
+const (
+	SeatStatusFree    = "FREE"
+	SeatStatusHeld    = "HELD"
+	SeatStatusBooked  = "BOOKED"
+	SeatStatusBlocked = "BLOCKED"
+)
+
+// ShowSeat is a seat of the venue together with its status for one show
+type ShowSeat struct {
+	BookedSeatID uint
+	SeatMatrixID uint
+	SeatNumber   string
+	Row          int
+	Column       int
+	Type         string
+	Price        Money
+	Status       string
+	HeldUntil    *time.Time
+	BlockReason  string // Why a BLOCKED seat is off sale, see SeatBlockBroken
+}
+
+// ShowSeatLayout is the seat map of a show as displayed to a customer
+type ShowSeatLayout struct {
+	MovieTimeSlot models.MovieTimeSlot
+	Venue         models.Venue
+	Seats         []ShowSeat
+	FreeSeats     int
+}
+
+/*
+SeatStatus works out the status of a booked seat at the given time
+
+  - BOOKED when a customer committed the seat, i.e. it is booked and no longer held by a checkout
+  - HELD while a checkout holds the seat and the hold has not expired
+  - FREE otherwise, including holds that expired but were not swept yet
+*/
+func SeatStatus(seat models.BookedSeats, now time.Time) string {
+	if !seat.IsBooked {
+		return SeatStatusFree
+	}
+
+	if seat.Email != nil || seat.PhoneNumber != "" || seat.HoldToken == nil {
+		return SeatStatusBooked
+	}
+
+	if seat.LockedUntil != nil && seat.LockedUntil.After(now) {
+		return SeatStatusHeld
+	}
+
+	return SeatStatusFree
+}
+
+// GetShowSeatLayout returns every seat of a show ordered by row and column with its live status and price
+func (m *MovieDB) GetShowSeatLayout(movieTimeSlotID uint) (ShowSeatLayout, int, error) {
+	var layout ShowSeatLayout
+
+	result := m.DB.Conn.Find(&layout.MovieTimeSlot, movieTimeSlotID)
+
+	if result.Error != nil {
+		return layout, 500, result.Error
+	}
+
+	if layout.MovieTimeSlot.ID == 0 {
+		return layout, 404, errors.New("movie time slot does not exist")
+	}
+
+	result = m.DB.Conn.Find(&layout.Venue, layout.MovieTimeSlot.VenueID)
+
+	if result.Error != nil {
+		return layout, 500, result.Error
+	}
+
+	var bookedSeats []models.BookedSeats
+
+	result = m.DB.Conn.Where("movie_time_slot_id = ?", movieTimeSlotID).Find(&bookedSeats)
+
+	if result.Error != nil {
+		return layout, 500, result.Error
+	}
+
+	blocked, err := blockedSeats(m.DB.Conn, layout.MovieTimeSlot)
+
+	if err != nil {
+		return layout, 500, err
+	}
+
+	pricer, err := newShowPricer(m.DB.Conn, layout.MovieTimeSlot)
+
+	if err != nil {
+		return layout, 500, err
+	}
+
+	// Seats removed from the layout after the show was created can no longer be sold, they are reported as blocked
+
+	var seatMatrix []models.SeatMatrix
+
+	result = m.DB.Conn.Unscoped().Where("venue_id = ?", layout.MovieTimeSlot.VenueID).Order("\"row\" ASC").Order("\"column\" ASC").Find(&seatMatrix)
+
+	if result.Error != nil {
+		return layout, 500, result.Error
+	}
+
+	seatsByMatrixID := make(map[uint]models.BookedSeats, len(bookedSeats))
+
+	for _, seat := range bookedSeats {
+		seatsByMatrixID[seat.SeatMatrixID] = seat
+	}
+
+	now := time.Now()
+
+	for _, matrix := range seatMatrix {
+		bookedSeat, ok := seatsByMatrixID[matrix.ID]
+
+		if !ok {
+			continue
+		}
+
+		delete(seatsByMatrixID, matrix.ID)
+
+		status := SeatStatus(bookedSeat, now)
+		blockReason := ""
+
+		if block, ok := blocked[matrix.ID]; ok && status == SeatStatusFree {
+			status = SeatStatusBlocked
+			blockReason = block.Reason
+		}
+
+		if matrix.DeletedAt.Valid && status == SeatStatusFree {
+			status = SeatStatusBlocked
+			blockReason = SeatBlockRemoved
+		}
+
+		seat := ShowSeat{
+			BookedSeatID: bookedSeat.ID,
+			SeatMatrixID: matrix.ID,
+			SeatNumber:   bookedSeat.SeatNumber,
+			Row:          matrix.Row,
+			Column:       matrix.Column,
+			Type:         matrix.Type,
+			Price:        pricer.price(matrix).Total,
+			Status:       status,
+			BlockReason:  blockReason,
+		}
+
+		if status == SeatStatusHeld {
+			seat.HeldUntil = bookedSeat.LockedUntil
+		}
+
+		if status == SeatStatusFree {
+			layout.FreeSeats++
+		}
+
+		layout.Seats = append(layout.Seats, seat)
+	}
+
+	for _, bookedSeat := range bookedSeats {
+		if _, ok := seatsByMatrixID[bookedSeat.SeatMatrixID]; !ok {
+			continue
+		}
+
+		seat := ShowSeat{
+			BookedSeatID: bookedSeat.ID,
+			SeatMatrixID: bookedSeat.SeatMatrixID,
+			SeatNumber:   bookedSeat.SeatNumber,
+			Status:       SeatStatus(bookedSeat, now),
+		}
+
+		if seat.Status == SeatStatusFree {
+			seat.Status = SeatStatusBlocked
+			seat.BlockReason = SeatBlockRemoved
+		}
+
+		layout.Seats = append(layout.Seats, seat)
+	}
+
+	return layout, 200, nil
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SeatLockSweeper periodically gives back seats locked by LockBookedSeats whose payment never completed
+// and marks the shows that ended as completed
+type SeatLockSweeper struct {
+	MovieDB   *MovieDB
+	Interval  time.Duration
+	BatchSize int
+}
+
+func NewSeatLockSweeper(m *MovieDB, interval time.Duration) *SeatLockSweeper {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &SeatLockSweeper{
+		MovieDB:   m,
+		Interval:  interval,
+		BatchSize: 500,
+	}
+}
+
+// Start runs the sweep on every tick until the context is cancelled, it is meant to be started in its own goroutine
+func (s *SeatLockSweeper) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stopping the seat lock sweeper")
+			return
+		case <-ticker.C:
+			s.sweep()
+		}
+	}
+}
+
+// sweep keeps releasing batches until a batch comes back smaller than the batch size, then closes the shows that ended
+func (s *SeatLockSweeper) sweep() {
+	defer s.completeShowtimes()
+
+	for {
+		released, status, err := s.MovieDB.ReleaseExpiredSeatLocks(0, s.BatchSize)
+
+		if err != nil || status != 200 {
+			log.Error("error releasing expired seat locks: ", err)
+			return
+		}
+
+		if len(released) > 0 {
+			log.Info("Released ", len(released), " seats with expired locks")
+		}
+
+		if len(released) < s.BatchSize {
+			return
+		}
+	}
+}
+
+func (s *SeatLockSweeper) completeShowtimes() {
+	completed, status, err := s.MovieDB.CompleteEndedShowtimes()
+
+	if err != nil || status != 200 {
+		log.Error("error completing ended shows: ", err)
+		return
+	}
+
+	if completed > 0 {
+		log.Info("Marked ", completed, " ended shows as completed")
+	}
+}
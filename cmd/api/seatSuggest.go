@@ -0,0 +1,175 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxSuggestedSeats is the largest party seats are suggested for
+const MaxSuggestedSeats = 10
+
+// suggestHoldAttempts is how often SuggestSeats looks for seats again when another checkout takes the suggested ones first
+const suggestHoldAttempts = 3
+
+// SeatSuggestion is the block of seats suggested to a party, Hold is set when the seats were held straight away
+type SeatSuggestion struct {
+	Seats []ShowSeat
+	Hold  *SeatHold
+}
+
+/*
+SuggestSeatBlock picks the best block of partySize free seats next to each other in one row
+
+	seats: the seats of the show, as returned by GetShowSeatLayout
+	seatType: optional, only seats of this type, e.g. VIP
+
+Blocks that leave a single free seat between them and a taken seat or the end of the row come last as
+such a seat is hard to sell. Otherwise blocks closer to the centre row and then to the centre of their row come first.
+*/
+func SuggestSeatBlock(seats []ShowSeat, partySize int, seatType string) ([]ShowSeat, error) {
+	if partySize < 1 || partySize > MaxSuggestedSeats {
+		return nil, fmt.Errorf("party size has to be between 1 and %d", MaxSuggestedSeats)
+	}
+
+	rows := make(map[int][]ShowSeat)
+	minRow, maxRow := math.MaxInt, math.MinInt
+
+	for _, seat := range seats {
+		// Seats that were removed from the layout have no position
+		if seat.Row == 0 && seat.Column == 0 {
+			continue
+		}
+
+		rows[seat.Row] = append(rows[seat.Row], seat)
+		minRow = min(minRow, seat.Row)
+		maxRow = max(maxRow, seat.Row)
+	}
+
+	centreRow := float64(minRow+maxRow) / 2
+	halfRows := math.Max(1, float64(maxRow-minRow)/2)
+
+	var best []ShowSeat
+	bestScore := math.Inf(1)
+
+	for row, rowSeats := range rows {
+		sort.Slice(rowSeats, func(i, j int) bool {
+			return rowSeats[i].Column < rowSeats[j].Column
+		})
+
+		centreColumn := float64(rowSeats[0].Column+rowSeats[len(rowSeats)-1].Column) / 2
+		halfColumns := math.Max(1, float64(rowSeats[len(rowSeats)-1].Column-rowSeats[0].Column)/2)
+
+		// Runs of free seats of the type with no gap in the columns
+
+		for start := 0; start < len(rowSeats); {
+			end := start
+
+			for end < len(rowSeats) && suggestable(rowSeats[end], seatType) &&
+				(end == start || rowSeats[end].Column == rowSeats[end-1].Column+1) {
+				end++
+			}
+
+			if end == start {
+				start++
+				continue
+			}
+
+			for i := start; i+partySize <= end; i++ {
+				left := i - start
+				right := end - i - partySize
+
+				score := math.Abs(float64(row)-centreRow)/halfRows +
+					0.5*math.Abs(float64(rowSeats[i].Column+rowSeats[i+partySize-1].Column)/2-centreColumn)/halfColumns
+
+				if left == 1 {
+					score += 10
+				}
+
+				if right == 1 {
+					score += 10
+				}
+
+				if score < bestScore || (score == bestScore && (row < best[0].Row || (row == best[0].Row && rowSeats[i].Column < best[0].Column))) {
+					bestScore = score
+					best = append([]ShowSeat{}, rowSeats[i:i+partySize]...)
+				}
+			}
+
+			start = end
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no %d free seats next to each other", partySize)
+	}
+
+	return best, nil
+}
+
+func suggestable(seat ShowSeat, seatType string) bool {
+	return seat.Status == SeatStatusFree && (seatType == "" || strings.EqualFold(seat.Type, seatType))
+}
+
+/*
+SuggestSeats suggests the best block of free seats of a show to a party
+
+	partySize: number of seats next to each other
+	seatType: optional, only seats of this type
+	hold: hold the suggested seats with LockBookedSeats, idempotentKey and holdDuration are passed on to it
+
+When another checkout takes the suggested seats before they are held the seats are suggested again
+*/
+func (m *MovieDB) SuggestSeats(movieTimeSlotID uint, partySize int, seatType string, hold bool, idempotentKey string, holdDuration time.Duration) (SeatSuggestion, int, error) {
+	var suggestion SeatSuggestion
+
+	if partySize < 1 || partySize > MaxSuggestedSeats {
+		return suggestion, 400, fmt.Errorf("party size has to be between 1 and %d", MaxSuggestedSeats)
+	}
+
+	for attempt := 1; ; attempt++ {
+		layout, status, err := m.GetShowSeatLayout(movieTimeSlotID)
+
+		if status != 200 || err != nil {
+			return suggestion, status, err
+		}
+
+		if layout.MovieTimeSlot.Status != ShowtimeOnSale {
+			return suggestion, 409, fmt.Errorf("show is %s, seats cannot be sold", strings.ToLower(strings.ReplaceAll(layout.MovieTimeSlot.Status, "_", " ")))
+		}
+
+		seats, err := SuggestSeatBlock(layout.Seats, partySize, seatType)
+
+		if err != nil {
+			return suggestion, 409, err
+		}
+
+		suggestion.Seats = seats
+
+		if !hold {
+			return suggestion, 200, nil
+		}
+
+		ids := make([]int32, 0, len(seats))
+
+		for _, seat := range seats {
+			ids = append(ids, int32(seat.BookedSeatID))
+		}
+
+		seatHold, status, err := m.LockBookedSeats(ids, idempotentKey, holdDuration)
+
+		if status == 409 && attempt < suggestHoldAttempts {
+			continue
+		}
+
+		if status != 200 || err != nil {
+			return suggestion, status, err
+		}
+
+		suggestion.Hold = &seatHold
+
+		return suggestion, 200, nil
+	}
+}
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kartik7120/booking_moviedb_service/cmd/consumers"
@@ -103,18 +106,27 @@ func (m *MoviedbService) AddMovie(ctx context.Context, in *moviedb.Movie) (*movi
 				EndTime:     ed,
 				Duration:    int(slot.Duration),
 				Date:        timestring,
-				MovieFormat: slot.MovieFormat.String(),
+				MovieFormat: movieFormatFromProto(slot.MovieFormat),
+				Language:    slot.Language,
+				Subtitles:   slot.Subtitles,
 			}
 
 			movieTimeSlots = append(movieTimeSlots, timeSlot)
 		}
 
 		for _, seat := range v.Seats {
+			if err := checkCurrency(seat.GetPriceMoney().GetCurrency(), cmp.Or(strings.ToUpper(v.Currency), DefaultCurrency)); err != nil {
+				return &moviedb.MovieResponse{
+					Status:  400,
+					Message: "error reading seat price",
+					Error:   err.Error(),
+				}, err
+			}
 
 			seat := models.SeatMatrix{
 				SeatNumber: seat.SeatNumber,
 				Type:       seat.Type.String(),
-				Price:      int(seat.Price),
+				Price:      seatPriceFromProto(seat),
 				Row:        int(seat.Row),
 				Column:     int(seat.Column),
 			}
@@ -131,6 +143,7 @@ func (m *MoviedbService) AddMovie(ctx context.Context, in *moviedb.Movie) (*movi
 			ScreenNumber: int(v.ScreenNumber),
 			Longitude:    float64(v.Longitude),
 			Latitude:     float64(v.Latitude),
+			Currency:     strings.ToUpper(v.Currency),
 		}
 		venues = append(venues, venue)
 	}
@@ -404,6 +417,53 @@ func (m *MoviedbService) UpdateVenue(ctx context.Context, in *moviedb.Venue) (*m
 		ScreenNumber: int(in.ScreenNumber),
 		Longitude:    float64(in.Longitude),
 		Latitude:     float64(in.Latitude),
+
+		SeatHoldMinutes: int(in.SeatHoldMinutes),
+		RegionCode:      in.RegionCode,
+		OpeningTime:     in.OpeningTime,
+		ClosingTime:     in.ClosingTime,
+		Timezone:        in.Timezone,
+		Currency:        strings.ToUpper(in.Currency),
+		PriceRounding:   int(in.PriceRounding),
+		ConvenienceFee:  int(in.GetConvenienceFee().GetAmount()),
+	}
+
+	// The convenience fee is in the currency of the venue, the one being set or else the one it has
+
+	if feeCurrency := in.GetConvenienceFee().GetCurrency(); feeCurrency != "" {
+		currency := v.Currency
+
+		if currency == "" {
+			var status int
+			var err error
+
+			if currency, status, err = venueCurrency(m.MovieDB.DB.Conn, uint(in.Id)); err != nil {
+				return &moviedb.VenueResponse{
+					Status:  int32(status),
+					Message: "error updating venue",
+					Error:   err.Error(),
+				}, nil
+			}
+		}
+
+		if err := checkCurrency(feeCurrency, currency); err != nil {
+			return &moviedb.VenueResponse{
+				Status:  400,
+				Message: "error updating venue",
+				Error:   err.Error(),
+			}, nil
+		}
+	}
+
+	// The rounding mode is given together with the step, ROUND_HALF_UP is also what an unset mode reads as
+
+	if in.PriceRounding != 0 {
+		v.RoundingMode = strings.TrimPrefix(in.RoundingMode.String(), "ROUND_")
+	}
+
+	if in.Cinemaid != 0 {
+		cinemaID := uint(in.Cinemaid)
+		v.CinemaID = &cinemaID
 	}
 
 	movieFormatSupported := make([]string, 0)
@@ -462,6 +522,29 @@ func (m *MoviedbService) AddVenue(ctx context.Context, in *moviedb.Venue) (*movi
 		ScreenNumber: int(in.ScreenNumber),
 		Longitude:    float64(in.Longitude),
 		Latitude:     float64(in.Latitude),
+
+		SeatHoldMinutes: int(in.SeatHoldMinutes),
+		RegionCode:      in.RegionCode,
+		OpeningTime:     in.OpeningTime,
+		ClosingTime:     in.ClosingTime,
+		Timezone:        in.Timezone,
+		Currency:        strings.ToUpper(in.Currency),
+		PriceRounding:   int(in.PriceRounding),
+		RoundingMode:    strings.TrimPrefix(in.RoundingMode.String(), "ROUND_"),
+		ConvenienceFee:  int(in.GetConvenienceFee().GetAmount()),
+	}
+
+	if err := checkCurrency(in.GetConvenienceFee().GetCurrency(), cmp.Or(venue.Currency, DefaultCurrency)); err != nil {
+		return &moviedb.VenueResponse{
+			Status:  400,
+			Message: "error adding a new venue",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if in.Cinemaid != 0 {
+		cinemaID := uint(in.Cinemaid)
+		venue.CinemaID = &cinemaID
 	}
 
 	movieFormatSupported := make([]string, 0)
@@ -483,10 +566,18 @@ func (m *MoviedbService) AddVenue(ctx context.Context, in *moviedb.Venue) (*movi
 	seats := make([]models.SeatMatrix, 0)
 
 	for _, val := range in.Seats {
+		if err := checkCurrency(val.GetPriceMoney().GetCurrency(), cmp.Or(venue.Currency, DefaultCurrency)); err != nil {
+			return &moviedb.VenueResponse{
+				Status:  400,
+				Message: "error adding a new venue",
+				Error:   err.Error(),
+			}, nil
+		}
+
 		seat := models.SeatMatrix{
 			SeatNumber: val.SeatNumber,
 			Type:       val.Type.String(),
-			Price:      int(val.Price),
+			Price:      seatPriceFromProto(val),
 			Row:        int(val.Row),
 			Column:     int(val.Column),
 		}
@@ -559,7 +650,7 @@ func (m *MoviedbService) GetUpcomingMovies(ctx context.Context, in *moviedb.GetU
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	movies, status, err := m.MovieDB.GetUpcomingMovies(in.Date)
+	movies, status, err := m.MovieDB.GetUpcomingMovies(in.Date, in.Timezone)
 
 	if status != 200 {
 		return &moviedb.GetUpcomingMovieResponse{
@@ -625,10 +716,17 @@ func (m *MoviedbService) GetNowPlayingMovies(ctx context.Context, in *moviedb.Ge
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	movies, status, err := m.MovieDB.GetNowPlayingMovies(
-		int32(in.Longitude),
-		int32(in.Latitude),
-	)
+	location, err := locationFromProto(in.Location, float64(in.OldLatitude), float64(in.OldLongitude))
+
+	if err != nil {
+		return &moviedb.GetUpcomingMovieResponse{
+			Status:  400,
+			Message: "invalid location",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	movies, status, err := m.MovieDB.GetNowPlayingMovies(location)
 
 	if status != 200 {
 		return &moviedb.GetUpcomingMovieResponse{
@@ -651,7 +749,8 @@ func (m *MoviedbService) GetNowPlayingMovies(ctx context.Context, in *moviedb.Ge
 	movielist := make([]*moviedb.Movie, 0)
 	var castAndCrew []*moviedb.CastAndCrew
 
-	for _, v := range movies {
+	for _, nearby := range movies {
+		v := nearby.Movie
 		castAndCrew = make([]*moviedb.CastAndCrew, 0)
 
 		for _, cc := range v.CastCrew {
@@ -678,6 +777,8 @@ func (m *MoviedbService) GetNowPlayingMovies(ctx context.Context, in *moviedb.Ge
 			Ranking:         int32(v.Ranking),
 			Id:              int32(v.ID),
 			CastCrew:        castAndCrew,
+			DistanceKm:      nearby.DistanceKm,
+			NearestVenueid:  int32(nearby.VenueID),
 		})
 	}
 
@@ -864,14 +965,24 @@ func (m *MoviedbService) GetMovieTimeSlots(ctx context.Context, in *moviedb.GetM
 		}, nil
 	}
 
-	if in.Latitude == 0 && in.Longitude == 0 {
+	location, err := locationFromProto(in.Location, float64(in.Latitude), float64(in.Longitude))
+
+	if err != nil {
+		return &moviedb.GetMovieTimeSlotResponse{
+			Status:  400,
+			Message: "invalid location",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if location.IsZero() {
 		return &moviedb.GetMovieTimeSlotResponse{
 			Status:  400,
-			Message: "No latitude or longitude provided",
+			Message: "No latitude, longitude or region code provided",
 		}, nil
 	}
 
-	venues, timeSlots, status, err := m.MovieDB.GetMovieTimeSlots(in.StartDate, in.EndDate, uint(movieID), in.Latitude, in.Longitude)
+	venues, timeSlots, status, err := m.MovieDB.GetMovieTimeSlots(in.StartDate, in.EndDate, uint(movieID), location, in.Language, in.MovieFormat)
 
 	if status != 200 || err != nil {
 		return &moviedb.GetMovieTimeSlotResponse{
@@ -882,46 +993,35 @@ func (m *MoviedbService) GetMovieTimeSlots(ctx context.Context, in *moviedb.GetM
 	}
 
 	timeSlotList := make([]*moviedb.MovieTimeSlot, 0)
+	timeSlotsByVenue := make(map[uint][]*moviedb.MovieTimeSlot)
 
 	for _, v := range timeSlots {
-		movieFormat := moviedb.SeatType_TWO_D
-
-		if v.MovieFormat == "IMAX" {
-			movieFormat = moviedb.SeatType_VIP
-		} else if v.MovieFormat == "FOUR_D" {
-			movieFormat = moviedb.SeatType_FOUR_D
-		} else if v.MovieFormat == "THREE_D" {
-			movieFormat = moviedb.SeatType_THREE_D
-		} else if v.MovieFormat == "TWO_D" {
-			movieFormat = moviedb.SeatType_TWO_D
-		}
-
-		st := fmt.Sprintf("%02d:%02d", v.StartTime.Hour(), v.StartTime.Minute())
-		ed := fmt.Sprintf("%02d:%02d", v.EndTime.Hour(), v.EndTime.Minute())
+		// The shows are in the time zone of their venue, so the date is the local one
 
-		year, month, day := v.StartTime.Date()
-
-		y := fmt.Sprintf("%02d", year)
-		m := fmt.Sprintf("%02d", int(month))
-		d := fmt.Sprintf("%02d", day)
-
-		dt := fmt.Sprintf("%s", y+"-"+m+"-"+d)
-
-		timeSlotList = append(timeSlotList, &moviedb.MovieTimeSlot{
-			StartTime:   st,
-			EndTime:     ed,
-			Date:        dt,
+		timeSlot := &moviedb.MovieTimeSlot{
+			Date:        v.StartTime.Format(time.DateOnly),
 			Duration:    int32(v.Duration),
-			MovieFormat: movieFormat,
+			MovieFormat: movieFormatToProto(v.MovieFormat),
 			Venueid:     int32(v.VenueID),
 			Movieid:     int32(v.MovieID),
-		})
+			Id:          int32(v.ID),
+			Status:      v.Status,
+			Language:    v.Language,
+			Subtitles:   v.Subtitles,
+		}
+
+		setShowtimeTimes(timeSlot, v)
+
+		timeSlotList = append(timeSlotList, timeSlot)
+		timeSlotsByVenue[v.VenueID] = append(timeSlotsByVenue[v.VenueID], timeSlot)
 	}
 
 	venueArr := make([]*moviedb.Venue, 0)
+	venuesByID := make(map[uint]*moviedb.Venue)
 
-	for _, v := range venues {
-		venueArr = append(venueArr, &moviedb.Venue{
+	for _, nearby := range venues {
+		v := nearby.Venue
+		venue := &moviedb.Venue{
 			Name:         v.Name,
 			Address:      v.Address,
 			Type:         moviedb.VenueType(moviedb.VenueType_value[v.Type]),
@@ -934,13 +1034,24 @@ func (m *MoviedbService) GetMovieTimeSlots(ctx context.Context, in *moviedb.GetM
 			Id:                   int32(v.ID),
 			MovieFormatSupported: v.MovieFormatSupported,
 			LanguageSupported:    v.LanguagesSupported,
-		})
+			RegionCode:           v.RegionCode,
+			DistanceKm:           nearby.DistanceKm,
+			Timezone:             v.Timezone,
+			Currency:             v.Currency,
+		}
+
+		if v.CinemaID != nil {
+			venue.Cinemaid = int32(*v.CinemaID)
+		}
+
+		venueArr = append(venueArr, venue)
+		venuesByID[v.ID] = venue
 	}
 
-	if len(venueArr) == 0 && in.Latitude != 0 && in.Longitude != 0 {
+	if len(venueArr) == 0 && location.HasPoint() {
 		return &moviedb.GetMovieTimeSlotResponse{
 			Status:  200,
-			Message: "No venues found within 40km radius",
+			Message: fmt.Sprintf("No venues found within %gkm radius", m.MovieDB.searchRadiusKm(location.RadiusKm)),
 		}, nil
 	}
 
@@ -951,23 +1062,49 @@ func (m *MoviedbService) GetMovieTimeSlots(ctx context.Context, in *moviedb.GetM
 		}, nil
 	}
 
+	groups, status, err := m.MovieDB.GroupShowtimesByCinema(venues)
+
+	if status != 200 || err != nil {
+		return &moviedb.GetMovieTimeSlotResponse{
+			Status:  int32(status),
+			Message: "error grouping movie time slots by cinema",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	cinemas := make([]*moviedb.CinemaShowtimes, 0, len(groups))
+
+	for _, group := range groups {
+		screens := make([]*moviedb.ScreenShowtimes, 0, len(group.Screens))
+
+		for _, screen := range group.Screens {
+			screens = append(screens, &moviedb.ScreenShowtimes{
+				Venue:          venuesByID[screen.Venue.ID],
+				MovieTimeSlots: timeSlotsByVenue[screen.Venue.ID],
+			})
+		}
+
+		cinemas = append(cinemas, &moviedb.CinemaShowtimes{
+			Cinema:     cinemaToProto(group.Cinema),
+			DistanceKm: group.DistanceKm,
+			Screens:    screens,
+		})
+	}
+
 	return &moviedb.GetMovieTimeSlotResponse{
 		Status:         200,
 		Message:        "success",
 		MovieTimeSlots: timeSlotList,
 		Error:          "",
 		Venues:         venueArr,
+		Cinemas:        cinemas,
 	}, nil
 }
 
 func (m *MoviedbService) AddMovieTimeSlot(ctx context.Context, in *moviedb.MovieTimeSlot) (*moviedb.MovieTimeSlotResponse, error) {
-	fmt.Println("AddMovieTimeSlot function is called in server.go")
-
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	fmt.Printf("Movie time slot: %#v", in)
-
 	d, err := time.Parse("2006-01-02", in.Date)
 
 	if err != nil {
@@ -1005,16 +1142,21 @@ func (m *MoviedbService) AddMovieTimeSlot(ctx context.Context, in *moviedb.Movie
 		Duration:    int(in.Duration),
 		MovieID:     uint(in.Movieid),
 		VenueID:     uint(in.Venueid),
-		MovieFormat: in.MovieFormat.String(),
+		MovieFormat: movieFormatFromProto(in.MovieFormat),
+		Language:    in.Language,
+		Subtitles:   in.Subtitles,
 	}
 
+	movieTimeSlot.Status = in.Status
+
 	_, status, err := m.MovieDB.AddMovieTimeSlot(movieTimeSlot)
 
 	if status != 200 || err != nil {
 		return &moviedb.MovieTimeSlotResponse{
-			Status:  int32(status),
-			Message: "error adding movie time slot",
-			Error:   err.Error(),
+			Status:   int32(status),
+			Message:  "error adding movie time slot",
+			Error:    err.Error(),
+			Conflict: scheduleConflictToProto(err),
 		}, nil
 	}
 
@@ -1031,12 +1173,13 @@ func (m *MoviedbService) DeleteMovieTimeSlot(ctx context.Context, in *moviedb.Mo
 
 	status, err := m.MovieDB.DeleteMovieTimeSlot(uint(in.MovieTimeSlotId))
 
-	if err != nil {
+	if status != 200 || err != nil {
 		return &moviedb.MovieTimeSlotResponse{
-			Status:  int32(status),
-			Message: "error deleting movie time slot",
-			Error:   err.Error(),
-		}, err
+			Status:   int32(status),
+			Message:  "error deleting movie time slot",
+			Error:    err.Error(),
+			Conflict: scheduleConflictToProto(err),
+		}, nil
 	}
 
 	return &moviedb.MovieTimeSlotResponse{
@@ -1088,23 +1231,27 @@ func (m *MoviedbService) UpdateMovieTimeSlot(ctx context.Context, in *moviedb.Mo
 		Duration:    int(in.Duration),
 		MovieID:     uint(in.Movieid),
 		VenueID:     uint(in.Venueid),
-		MovieFormat: in.MovieFormat.String(),
+		MovieFormat: movieFormatFromProto(in.MovieFormat),
+		Language:    in.Language,
+		Subtitles:   in.Subtitles,
 	}
 
-	_, status, err := m.MovieDB.UpdateMovieTimeSlot(uint(in.MovieTimeSlotId), movieTimeSlot)
+	updated, status, err := m.MovieDB.UpdateMovieTimeSlot(uint(in.MovieTimeSlotId), movieTimeSlot)
 
 	if status != 200 || err != nil {
 		return &moviedb.MovieTimeSlotUpdateResponse{
-			Status:  int32(status),
-			Message: "error updating movie time slot",
-			Error:   err.Error(),
+			Status:   int32(status),
+			Message:  "error updating movie time slot",
+			Error:    err.Error(),
+			Conflict: scheduleConflictToProto(err),
 		}, nil
 	}
 
 	return &moviedb.MovieTimeSlotUpdateResponse{
-		Status:  200,
-		Message: "movie time slot updated successfully",
-		Error:   "",
+		Status:        200,
+		Message:       "movie time slot updated successfully",
+		MovieTimeSlot: movieTimeSlotToProto(updated),
+		Error:         "",
 	}, nil
 }
 
@@ -1122,19 +1269,20 @@ func (m *MoviedbService) GetSeatMatrix(ctx context.Context, in *moviedb.GetSeatM
 		}, nil
 	}
 
+	currency, status, err := venueCurrency(m.MovieDB.DB.Conn, uint(in.Venueid))
+
+	if err != nil {
+		return &moviedb.GetSeatMatrixResponse{
+			Status:  int32(status),
+			Message: "error getting seat matrix",
+			Error:   err.Error(),
+		}, nil
+	}
+
 	var seats []*moviedb.SeatMatrix
 
 	for _, v := range seatMatrix {
-		seat := &moviedb.SeatMatrix{
-			SeatNumber: v.SeatNumber,
-			Type:       moviedb.SeatType(moviedb.SeatType_value[v.Type]),
-			Price:      int32(v.Price),
-			Row:        int32(v.Row),
-			Column:     int32(v.Column),
-			Id:         int32(v.ID),
-		}
-
-		seats = append(seats, seat)
+		seats = append(seats, seatMatrixToProto(v, currency))
 	}
 
 	return &moviedb.GetSeatMatrixResponse{
@@ -1149,6 +1297,14 @@ func (m *MoviedbService) AddSeatMatrix(ctx context.Context, in *moviedb.AddSeatM
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
+	if status, err := m.checkSeatCurrency(uint(in.Venueid), in.Seats...); err != nil {
+		return &moviedb.AddSeatMatrixResponse{
+			Status:  int32(status),
+			Message: "error adding seat matrix",
+			Error:   err.Error(),
+		}, nil
+	}
+
 	var seats []models.SeatMatrix
 
 	for _, v := range in.Seats {
@@ -1156,7 +1312,7 @@ func (m *MoviedbService) AddSeatMatrix(ctx context.Context, in *moviedb.AddSeatM
 		seat := models.SeatMatrix{
 			SeatNumber: v.SeatNumber,
 			Type:       v.Type.String(),
-			Price:      int(v.Price),
+			Price:      seatPriceFromProto(v),
 			Row:        int(v.Row),
 			Column:     int(v.Column),
 			VenueID:    uint(in.Venueid),
@@ -1186,13 +1342,21 @@ func (m *MoviedbService) UpdateSeatMatrix(ctx context.Context, in *moviedb.Updat
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
+	if status, err := m.checkSeatCurrency(uint(in.Venueid), in.Seats...); err != nil {
+		return &moviedb.UpdateSeatMatrixResponse{
+			Status:  int32(status),
+			Message: "error updating seat matrix",
+			Error:   err.Error(),
+		}, nil
+	}
+
 	var seats []models.SeatMatrix
 
 	for _, v := range in.Seats {
 		seat := models.SeatMatrix{
 			SeatNumber: v.SeatNumber,
 			Type:       v.Type.String(),
-			Price:      int(v.Price),
+			Price:      seatPriceFromProto(v),
 			Row:        int(v.Row),
 			Column:     int(v.Column),
 			VenueID:    uint(in.Venueid),
@@ -1205,13 +1369,13 @@ func (m *MoviedbService) UpdateSeatMatrix(ctx context.Context, in *moviedb.Updat
 		seat := models.SeatMatrix{
 			SeatNumber: v.SeatNumber,
 			Type:       v.Type.String(),
-			Price:      int(v.Price),
+			Price:      seatPriceFromProto(v),
 			Row:        int(v.Row),
 			Column:     int(v.Column),
 			VenueID:    uint(in.Venueid),
 		}
 
-		_, status, err := m.MovieDB.UpdateSeatMatrix(uint(v.Id), seat)
+		_, status, err := m.MovieDB.UpdateSeatMatrix(uint(v.Id), seat, strings.TrimPrefix(in.Mode.String(), "SEAT_CHANGE_"))
 
 		if status != 200 || err != nil {
 			return &moviedb.UpdateSeatMatrixResponse{
@@ -1233,7 +1397,7 @@ func (m *MoviedbService) DeleteSeatMatrix(ctx context.Context, in *moviedb.Delet
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	status, err := m.MovieDB.DeleteSeatMatrix(uint(in.SeatMatrixId))
+	status, err := m.MovieDB.DeleteSeatMatrix(uint(in.SeatMatrixId), strings.TrimPrefix(in.Mode.String(), "SEAT_CHANGE_"))
 
 	if status != 200 || err != nil {
 		return &moviedb.DeleteSeatMatrixResponse{
@@ -1254,7 +1418,7 @@ func (m *MoviedbService) DeleteEntireSeatMatrix(ctx context.Context, in *moviedb
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	status, err := m.MovieDB.DeleteEntireSeatMatrix(uint(in.Venueid))
+	status, err := m.MovieDB.DeleteEntireSeatMatrix(uint(in.Venueid), strings.TrimPrefix(in.Mode.String(), "SEAT_CHANGE_"))
 
 	if status != 200 || err != nil {
 		return &moviedb.DeleteEntireSeatMatrixResponse{
@@ -1271,6 +1435,44 @@ func (m *MoviedbService) DeleteEntireSeatMatrix(ctx context.Context, in *moviedb
 	}, nil
 }
 
+// Brings the seats of the upcoming shows of a venue in line with its seat matrix, sold and held seats are kept
+func (m *MoviedbService) ApplySeatMatrixToShows(ctx context.Context, in *moviedb.ApplySeatMatrixToShowsRequest) (*moviedb.ApplySeatMatrixToShowsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	changes, status, err := m.MovieDB.ApplySeatMatrixToShows(uint(in.Venueid), in.DryRun)
+
+	if status != 200 || err != nil {
+		return &moviedb.ApplySeatMatrixToShowsResponse{
+			Status:  int32(status),
+			Message: "error applying seat matrix to shows",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := &moviedb.ApplySeatMatrixToShowsResponse{
+		Status:  200,
+		Message: "seat matrix applied to upcoming shows",
+		Shows:   make([]*moviedb.ShowSeatChanges, 0, len(changes)),
+	}
+
+	if in.DryRun {
+		response.Message = "seat matrix changes counted"
+	}
+
+	for _, change := range changes {
+		response.Shows = append(response.Shows, &moviedb.ShowSeatChanges{
+			MovieTimeSlotId: int32(change.MovieTimeSlotID),
+			Added:           int32(change.Added),
+			Removed:         int32(change.Removed),
+			Renumbered:      int32(change.Renumbered),
+			Kept:            int32(change.Kept),
+		})
+	}
+
+	return response, nil
+}
+
 func (m *MoviedbService) BookSeats(ctx context.Context, in *moviedb.BookSeatsRequest) (*moviedb.BookSeatsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1287,7 +1489,7 @@ func (m *MoviedbService) BookSeats(ctx context.Context, in *moviedb.BookSeatsReq
 		seats = append(seats, seat)
 	}
 
-	status, err := m.MovieDB.BookSeats(in.MovieTimeSlotId, in.Email, in.PhoneNumber, seats)
+	status, err := m.MovieDB.BookSeats(in.MovieTimeSlotId, in.Email, in.PhoneNumber, seats, in.HoldToken)
 
 	if status != 200 || err != nil {
 		return &moviedb.BookSeatsResponse{
@@ -1349,15 +1551,10 @@ func (m *MoviedbService) IsValidToCommitSeatsForBooking(ctx context.Context, in
 	done := make(chan struct{})
 	var isValid bool
 	var err error
-	var toBeBookedSeats []struct {
-		ID         int32
-		SeatNumber string
-		Price      int32
-		MovieName  string
-	}
+	var quote Quote
 
 	go func() {
-		isValid, toBeBookedSeats, err = m.MovieDB.IsValidToCommitSeatsForBooking(int(in.MovieTimeSlotId), in.SeatMatrixIds)
+		isValid, quote, err = m.MovieDB.IsValidToCommitSeatsForBooking(int(in.MovieTimeSlotId), in.SeatMatrixIds, in.HoldToken)
 		close(done)
 	}()
 
@@ -1386,14 +1583,19 @@ func (m *MoviedbService) IsValidToCommitSeatsForBooking(ctx context.Context, in
 			}, nil
 		}
 
+		// The price of a seat is what the ticket costs with the fee and taxes, the quote has the breakdown
+
 		var toBeBookedSeats2 []*moviedb.BookedSeats
 
-		for _, v := range toBeBookedSeats {
+		for _, v := range quote.Seats {
 			toBeBookedSeats2 = append(toBeBookedSeats2, &moviedb.BookedSeats{
-				Id:         int32(v.ID),
-				SeatNumber: v.SeatNumber,
-				Price:      v.Price,
-				MovieName:  v.MovieName,
+				Id:              int32(v.BookedSeatID),
+				SeatNumber:      v.SeatNumber,
+				MovieTimeSlotID: int32(quote.MovieTimeSlotID),
+				SeatMatrixID:    int32(v.SeatMatrixID),
+				Price:           int32(v.Total.Amount),
+				PriceMoney:      moneyToProto(v.Total),
+				MovieName:       quote.MovieName,
 			})
 		}
 
@@ -1402,18 +1604,19 @@ func (m *MoviedbService) IsValidToCommitSeatsForBooking(ctx context.Context, in
 			Error:           "",
 			Status:          200,
 			ToBeBookedSeats: toBeBookedSeats2,
+			Quote:           quoteToProto(quote),
 		}, nil
 	}
 }
 
 // Lock the seats when seats are selected and before payment confirmation
 // This prevents other users from booking the same seats while the payment is being processed.
-// The seats will be locked for a short duration (e.g., 10 minutes) to allow the user to complete the payment.
-func (m *MoviedbService) LockSeatsForBooking(ctx context.Context, in *moviedb.GetBookedSeatsDetailsRequest) (*moviedb.GetBookedSeatsDetailsResponse, error) {
+// The seats will be locked for a short duration (15 minutes by default) to allow the user to complete the payment, the expiry is returned so clients can show a countdown.
+func (m *MoviedbService) LockBookedSeats(ctx context.Context, in *moviedb.GetBookedSeatsDetailsRequest) (*moviedb.GetBookedSeatsDetailsResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	status, err := m.MovieDB.LockBookedSeats(in.BookedSeatsIds)
+	hold, status, err := m.MovieDB.LockBookedSeats(in.BookedSeatsIds, in.IdempotentKey, time.Duration(in.HoldDurationSeconds)*time.Second)
 
 	if status != 200 || err != nil {
 		return &moviedb.GetBookedSeatsDetailsResponse{
@@ -1424,17 +1627,32 @@ func (m *MoviedbService) LockSeatsForBooking(ctx context.Context, in *moviedb.Ge
 		}, nil
 	}
 
+	bookedSeats := make([]*moviedb.BookedSeats, 0, len(hold.BookedSeats))
+
+	for _, val := range hold.BookedSeats {
+		bookedSeats = append(bookedSeats, &moviedb.BookedSeats{
+			Id:              int32(val.ID),
+			SeatNumber:      val.SeatNumber,
+			MovieTimeSlotID: int32(val.MovieTimeSlotID),
+			SeatMatrixID:    int32(val.SeatMatrixID),
+			IsBooked:        val.IsBooked,
+		})
+	}
+
 	return &moviedb.GetBookedSeatsDetailsResponse{
-		Status:      200,
-		Message:     "Seats locked successfully",
-		BookedSeats: nil, // No booked seats returned in this case
-		Error:       "",
+		Status:         200,
+		Message:        "Seats locked successfully",
+		BookedSeats:    bookedSeats,
+		Error:          "",
+		HoldToken:      hold.HoldToken,
+		LockedUntil:    hold.LockedUntil.Format(time.RFC3339),
+		ExtensionsLeft: int32(hold.ExtensionsLeft),
 	}, nil
 }
 
 func (m *MoviedbService) CreateTicket(ctx context.Context, in *moviedb.CreateTicketRequest) (*moviedb.CreateRequestResponse, error) {
 
-	status, err := m.MovieDB.CreateTicket(in.IdempotentKey, in.TrasactionId)
+	status, err := m.MovieDB.CreateTicket(in.IdempotentKey, in.TrasactionId, in.HoldToken, in.QuoteId)
 
 	if err != nil || status != 200 {
 		return &moviedb.CreateRequestResponse{
@@ -1448,3 +1666,1903 @@ func (m *MoviedbService) CreateTicket(ctx context.Context, in *moviedb.CreateTic
 		Error:  "",
 	}, nil
 }
+
+// Releases seats whose lock has expired without a ticket being created, the same sweep also runs periodically in the background
+func (m *MoviedbService) ReleaseExpiredSeatLocks(ctx context.Context, in *moviedb.ReleaseExpiredSeatLocksRequest) (*moviedb.ReleaseExpiredSeatLocksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	releasedSeats, status, err := m.MovieDB.ReleaseExpiredSeatLocks(uint(in.MovieTimeSlotId), 0)
+
+	if status != 200 || err != nil {
+		return &moviedb.ReleaseExpiredSeatLocksResponse{
+			Status:  int32(status),
+			Message: "error releasing expired seat locks",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	releasedIDs := make([]int32, 0, len(releasedSeats))
+
+	for _, seat := range releasedSeats {
+		releasedIDs = append(releasedIDs, int32(seat.ID))
+	}
+
+	return &moviedb.ReleaseExpiredSeatLocksResponse{
+		Status:                 200,
+		Message:                fmt.Sprintf("released %d seats", len(releasedIDs)),
+		ReleasedBookedSeatsIds: releasedIDs,
+		Error:                  "",
+	}, nil
+}
+
+// Unlocks the seats held by a checkout when the user cancels it, seats locked by a different idempotent key are refused
+func (m *MoviedbService) ReleaseSeatLocks(ctx context.Context, in *moviedb.ReleaseSeatLocksRequest) (*moviedb.ReleaseSeatLocksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	releasedSeats, status, err := m.MovieDB.ReleaseSeatLocks(in.IdempotentKey, in.BookedSeatsIds)
+
+	if status != 200 || err != nil {
+		return &moviedb.ReleaseSeatLocksResponse{
+			Status:  int32(status),
+			Message: "error releasing seat locks",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	releasedIDs := make([]int32, 0, len(releasedSeats))
+
+	for _, seat := range releasedSeats {
+		releasedIDs = append(releasedIDs, int32(seat.ID))
+	}
+
+	return &moviedb.ReleaseSeatLocksResponse{
+		Status:                 200,
+		Message:                "Seats released successfully",
+		ReleasedBookedSeatsIds: releasedIDs,
+		Error:                  "",
+	}, nil
+}
+
+// Extends an active seat hold for the same owner, e.g. when a card payment needs a 3DS confirmation
+func (m *MoviedbService) ExtendSeatHold(ctx context.Context, in *moviedb.ExtendSeatHoldRequest) (*moviedb.ExtendSeatHoldResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	hold, status, err := m.MovieDB.ExtendSeatHold(in.HoldToken, time.Duration(in.ExtendBySeconds)*time.Second)
+
+	if status != 200 || err != nil {
+		return &moviedb.ExtendSeatHoldResponse{
+			Status:  int32(status),
+			Message: "error extending seat hold",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.ExtendSeatHoldResponse{
+		Status:         200,
+		Message:        "Seat hold extended successfully",
+		LockedUntil:    hold.LockedUntil.Format(time.RFC3339),
+		ExtensionsLeft: int32(hold.ExtensionsLeft),
+		Error:          "",
+	}, nil
+}
+
+// Lists the whole catalog page by page for the admin console
+func (m *MoviedbService) GetAllMovies(ctx context.Context, in *moviedb.GetAllMoviesRequest) (*moviedb.MovieListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	movies, nextPageToken, status, err := m.MovieDB.GetAllMovies(int(in.PageSize), in.PageToken, in.Language, in.Type)
+
+	if status != 200 || err != nil {
+		return &moviedb.MovieListResponse{
+			Status:  int32(status),
+			Message: "error getting movies",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	movielist := make([]*moviedb.Movie, 0, len(movies))
+
+	for _, v := range movies {
+		movielist = append(movielist, movieToProto(v))
+	}
+
+	return &moviedb.MovieListResponse{
+		Status:  200,
+		Message: "success",
+		MovieList: &moviedb.MovieList{
+			Movies: movielist,
+		},
+		Error:         "",
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Lists every venue page by page for the admin console
+func (m *MoviedbService) GetAllVenues(ctx context.Context, in *moviedb.GetAllVenuesRequest) (*moviedb.VenueListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	venues, nextPageToken, status, err := m.MovieDB.GetAllVenues(int(in.PageSize), in.PageToken, in.VenueType)
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueListResponse{
+			Status:  int32(status),
+			Message: "error getting venues",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	venueArr := make([]*moviedb.Venue, 0, len(venues))
+
+	for _, v := range venues {
+		venueArr = append(venueArr, venueToProto(v))
+	}
+
+	return &moviedb.VenueListResponse{
+		Status:        200,
+		Message:       "success",
+		Venues:        venueArr,
+		Error:         "",
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *MoviedbService) AddSingleSeatMatrix(ctx context.Context, in *moviedb.AddSingleSeatMatrixInput) (*moviedb.AddSingleSeatMatrixResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if in.Seat == nil {
+		return &moviedb.AddSingleSeatMatrixResponse{
+			Status:  400,
+			Message: "error adding seat",
+			Error:   "seat is required",
+		}, nil
+	}
+
+	if status, err := m.checkSeatCurrency(uint(in.Venueid), in.Seat); err != nil {
+		return &moviedb.AddSingleSeatMatrixResponse{
+			Status:  int32(status),
+			Message: "error adding seat",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	seat := models.SeatMatrix{
+		SeatNumber: in.Seat.SeatNumber,
+		Type:       in.Seat.Type.String(),
+		Price:      seatPriceFromProto(in.Seat),
+		Row:        int(in.Seat.Row),
+		Column:     int(in.Seat.Column),
+		VenueID:    uint(in.Venueid),
+	}
+
+	status, err := m.MovieDB.AddSeatMatrix(int(in.Venueid), []models.SeatMatrix{seat})
+
+	if status != 200 || err != nil {
+		return &moviedb.AddSingleSeatMatrixResponse{
+			Status:  int32(status),
+			Message: "error adding seat",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.AddSingleSeatMatrixResponse{
+		Status:  200,
+		Message: "seat added successfully",
+		Error:   "",
+	}, nil
+}
+
+func movieToProto(v models.Movie) *moviedb.Movie {
+	castAndCrew := make([]*moviedb.CastAndCrew, 0, len(v.CastCrew))
+
+	for _, cc := range v.CastCrew {
+		castAndCrew = append(castAndCrew, &moviedb.CastAndCrew{
+			Type:          moviedb.CastAndCrewType(moviedb.CastAndCrewType_value[cc.Type]),
+			Name:          cc.Name,
+			CharacterName: cc.Character,
+			Photourl:      cc.PhotoURL,
+		})
+	}
+
+	return &moviedb.Movie{
+		Title:           v.Title,
+		Description:     v.Description,
+		Duration:        int32(v.Duration),
+		Language:        v.Language,
+		Type:            v.Type,
+		PosterUrl:       v.PosterURL,
+		TrailerUrl:      v.TrailerURL,
+		ReleaseDate:     v.ReleaseDate.Format(time.DateOnly),
+		MovieResolution: v.MovieResolution,
+		Votes:           int64(v.Votes),
+		Ranking:         int32(v.Ranking),
+		Id:              int32(v.ID),
+		CastCrew:        castAndCrew,
+	}
+}
+
+func venueToProto(v models.Venue) *moviedb.Venue {
+	venue := &moviedb.Venue{
+		Name:                 v.Name,
+		Address:              v.Address,
+		Type:                 moviedb.VenueType(moviedb.VenueType_value[v.Type]),
+		Rows:                 int32(v.Rows),
+		Columns:              int32(v.Columns),
+		Longitude:            float32(v.Longitude),
+		Latitude:             float32(v.Latitude),
+		ScreenNumber:         int32(v.ScreenNumber),
+		Id:                   int32(v.ID),
+		MovieFormatSupported: v.MovieFormatSupported,
+		LanguageSupported:    v.LanguagesSupported,
+		SeatHoldMinutes:      int32(v.SeatHoldMinutes),
+		RegionCode:           v.RegionCode,
+		OpeningTime:          v.OpeningTime,
+		ClosingTime:          v.ClosingTime,
+		Timezone:             v.Timezone,
+		LayoutVersion:        int32(v.LayoutVersion),
+		Currency:             v.Currency,
+		PriceRounding:        int32(v.PriceRounding),
+		RoundingMode:         moviedb.RoundingMode(moviedb.RoundingMode_value["ROUND_"+v.RoundingMode]),
+		ConvenienceFee:       moneyToProto(Money{Amount: int64(v.ConvenienceFee), Currency: cmp.Or(v.Currency, DefaultCurrency)}),
+	}
+
+	if v.CinemaID != nil {
+		venue.Cinemaid = int32(*v.CinemaID)
+	}
+
+	if v.LayoutTemplateID != nil {
+		venue.LayoutTemplateId = int32(*v.LayoutTemplateID)
+	}
+
+	return venue
+}
+
+func cinemaToProto(v models.Cinema) *moviedb.Cinema {
+	screens := make([]*moviedb.Venue, 0, len(v.Screens))
+
+	for _, screen := range v.Screens {
+		screens = append(screens, venueToProto(screen))
+	}
+
+	return &moviedb.Cinema{
+		Id:          int32(v.ID),
+		Name:        v.Name,
+		Address:     v.Address,
+		City:        v.City,
+		RegionCode:  v.RegionCode,
+		Latitude:    v.Latitude,
+		Longitude:   v.Longitude,
+		Timezone:    v.Timezone,
+		Amenities:   v.Amenities,
+		PhoneNumber: v.PhoneNumber,
+		Email:       v.Email,
+		Website:     v.Website,
+		Screens:     screens,
+	}
+}
+
+func cinemaFromProto(in *moviedb.Cinema) models.Cinema {
+	return models.Cinema{
+		Name:        in.Name,
+		Address:     in.Address,
+		City:        in.City,
+		RegionCode:  in.RegionCode,
+		Latitude:    in.Latitude,
+		Longitude:   in.Longitude,
+		Timezone:    in.Timezone,
+		Amenities:   in.Amenities,
+		PhoneNumber: in.PhoneNumber,
+		Email:       in.Email,
+		Website:     in.Website,
+	}
+}
+
+/*
+locationFromProto builds the location of a request
+
+	in: location message of the request, takes precedence when it has coordinates or a region code
+	oldLatitude, oldLongitude: deprecated coordinate fields still sent by older clients
+*/
+func locationFromProto(in *moviedb.Location, oldLatitude float64, oldLongitude float64) (Location, error) {
+	location := Location{
+		Point: GeoPoint{
+			Latitude:  oldLatitude,
+			Longitude: oldLongitude,
+		},
+	}
+
+	if in == nil {
+		return location, nil
+	}
+
+	radiusKm, err := RadiusToKm(in.Radius, in.RadiusUnit.String())
+
+	if err != nil {
+		return location, err
+	}
+
+	location.RadiusKm = radiusKm
+	location.RegionCode = in.RegionCode
+
+	if in.Latitude != 0 || in.Longitude != 0 || in.RegionCode != "" {
+		location.Point = GeoPoint{
+			Latitude:  in.Latitude,
+			Longitude: in.Longitude,
+		}
+	}
+
+	return location, nil
+}
+
+// Lists the shows of a movie, optionally filtered by venue, format and date
+func (m *MoviedbService) GetMovieShowtimes(ctx context.Context, in *moviedb.GetMovieShowtimesRequest) (*moviedb.GetMovieShowtimesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	timeSlots, status, err := m.MovieDB.GetMovieShowtimes(uint(in.Movieid), uint(in.Venueid), in.MovieFormat, in.Date)
+
+	if status != 200 || err != nil {
+		return &moviedb.GetMovieShowtimesResponse{
+			Status:  int32(status),
+			Message: "error getting movie showtimes",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	timeSlotList := make([]*moviedb.MovieTimeSlot, 0, len(timeSlots))
+
+	for _, v := range timeSlots {
+		timeSlotList = append(timeSlotList, movieTimeSlotToProto(v))
+	}
+
+	return &moviedb.GetMovieShowtimesResponse{
+		Status:         200,
+		Message:        "success",
+		MovieTimeSlots: timeSlotList,
+		Error:          "",
+	}, nil
+}
+
+// Returns the seat map of a show with the live status and price of every seat
+func (m *MoviedbService) GetShowSeatLayout(ctx context.Context, in *moviedb.GetShowSeatLayoutRequest) (*moviedb.GetShowSeatLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var layout ShowSeatLayout
+	var status int
+	var err error
+
+	if in.MovieTimeSlotId != 0 {
+		layout, status, err = m.MovieDB.GetShowSeatLayout(uint(in.MovieTimeSlotId))
+	} else {
+		layout, status, err = m.MovieDB.GetMovieSeatLayout(uint(in.Movieid), uint(in.Venueid), in.MovieFormat, in.Date, in.StartTime)
+	}
+
+	if status != 200 || err != nil {
+		return &moviedb.GetShowSeatLayoutResponse{
+			Status:  int32(status),
+			Message: "error getting show seat layout",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	seats := make([]*moviedb.ShowSeat, 0, len(layout.Seats))
+
+	for _, v := range layout.Seats {
+		seats = append(seats, showSeatToProto(v))
+	}
+
+	return &moviedb.GetShowSeatLayoutResponse{
+		Status:        200,
+		Message:       "success",
+		MovieTimeSlot: movieTimeSlotToProto(layout.MovieTimeSlot),
+		Venue:         venueToProto(layout.Venue),
+		Seats:         seats,
+		FreeSeats:     int32(layout.FreeSeats),
+		Error:         "",
+	}, nil
+}
+
+// seatMatrixToProto converts a seat of a venue, currency is the currency of the venue
+func seatMatrixToProto(v models.SeatMatrix, currency string) *moviedb.SeatMatrix {
+	return &moviedb.SeatMatrix{
+		SeatNumber: v.SeatNumber,
+		Type:       moviedb.SeatType(moviedb.SeatType_value[v.Type]),
+		Price:      int32(v.Price),
+		Row:        int32(v.Row),
+		Column:     int32(v.Column),
+		Id:         int32(v.ID),
+		PriceMoney: moneyToProto(Money{Amount: int64(v.Price), Currency: currency}),
+	}
+}
+
+func moneyToProto(v Money) *moviedb.Money {
+	return &moviedb.Money{Amount: v.Amount, Currency: v.Currency}
+}
+
+// seatPriceFromProto is the price of a seat in minor units, price_money takes the place of the deprecated price
+func seatPriceFromProto(v *moviedb.SeatMatrix) int {
+	if v.PriceMoney != nil {
+		return int(v.PriceMoney.Amount)
+	}
+
+	return int(v.Price)
+}
+
+// checkSeatCurrency refuses seats priced in another currency than the one of their venue
+func (m *MoviedbService) checkSeatCurrency(venueID uint, seats ...*moviedb.SeatMatrix) (int, error) {
+	currency := ""
+
+	for _, seat := range seats {
+		if seat.GetPriceMoney().GetCurrency() == "" {
+			continue
+		}
+
+		if currency == "" {
+			var status int
+			var err error
+
+			if currency, status, err = venueCurrency(m.MovieDB.DB.Conn, venueID); err != nil {
+				return status, err
+			}
+		}
+
+		if err := checkCurrency(seat.PriceMoney.Currency, currency); err != nil {
+			return 400, err
+		}
+	}
+
+	return 200, nil
+}
+
+// Full seat map of a venue including aisles and other cells that are not seats, ready to be drawn
+func (m *MoviedbService) GetVenueLayout(ctx context.Context, in *moviedb.GetVenueLayoutRequest) (*moviedb.VenueLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	layout, status, err := m.MovieDB.GetVenueLayout(uint(in.Venueid))
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueLayoutResponse{
+			Status:  int32(status),
+			Message: "error getting venue layout",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := venueLayoutToProto(layout)
+	response.Status = 200
+	response.Message = "success"
+
+	return response, nil
+}
+
+// Replaces the cells, sections, row labels and screen position of a venue, the seats stay as they are
+func (m *MoviedbService) SetVenueLayout(ctx context.Context, in *moviedb.SetVenueLayoutRequest) (*moviedb.VenueLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	venue := models.Venue{
+		ScreenPosition: strings.TrimPrefix(in.ScreenPosition.String(), "SCREEN_"),
+		CurvedRows:     in.CurvedRows,
+		RowLabels:      in.RowLabels,
+	}
+
+	for _, cell := range in.Cells {
+		venue.LayoutCells = append(venue.LayoutCells, models.LayoutCell{
+			Row:    int(cell.Row),
+			Column: int(cell.Column),
+			Kind:   strings.TrimPrefix(cell.Kind.String(), "CELL_"),
+		})
+	}
+
+	for _, section := range in.Sections {
+		venue.Sections = append(venue.Sections, models.SeatSection{
+			Name:        section.Name,
+			FirstRow:    int(section.FirstRow),
+			LastRow:     int(section.LastRow),
+			FirstColumn: int(section.FirstColumn),
+			LastColumn:  int(section.LastColumn),
+		})
+	}
+
+	layout, status, err := m.MovieDB.SetVenueLayout(uint(in.Venueid), venue)
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueLayoutResponse{
+			Status:  int32(status),
+			Message: "error setting venue layout",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := venueLayoutToProto(layout)
+	response.Status = 200
+	response.Message = "venue layout saved"
+
+	return response, nil
+}
+
+// Creates the seats of a venue from a text or csv grid
+func (m *MoviedbService) ImportSeatGrid(ctx context.Context, in *moviedb.ImportSeatGridRequest) (*moviedb.VenueLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	prices := seatPricesFromProto(in.Prices)
+
+	layout, status, err := m.MovieDB.ImportSeatGrid(uint(in.Venueid), in.Data, strings.TrimPrefix(in.Format.String(), "GRID_"), prices, in.Currency, in.DryRun)
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueLayoutResponse{
+			Status:  int32(status),
+			Message: "error importing seat grid",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := venueLayoutToProto(layout)
+	response.Status = 200
+	response.Message = "seat grid imported"
+
+	if in.DryRun {
+		response.Message = "seat grid is valid"
+	}
+
+	return response, nil
+}
+
+// Writes the seats of a venue as a grid ImportSeatGrid reads back
+func (m *MoviedbService) ExportSeatGrid(ctx context.Context, in *moviedb.ExportSeatGridRequest) (*moviedb.ExportSeatGridResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	data, prices, currency, status, err := m.MovieDB.ExportSeatGrid(uint(in.Venueid), strings.TrimPrefix(in.Format.String(), "GRID_"))
+
+	if status != 200 || err != nil {
+		return &moviedb.ExportSeatGridResponse{
+			Status:  int32(status),
+			Message: "error exporting seat grid",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := &moviedb.ExportSeatGridResponse{
+		Status:   200,
+		Message:  "success",
+		Data:     data,
+		Prices:   make(map[string]int32, len(prices)),
+		Currency: currency,
+	}
+
+	for seatType, price := range prices {
+		response.Prices[seatType] = int32(price)
+	}
+
+	return response, nil
+}
+
+// Saves a seat grid, or the seats of a venue, as a layout template other venues can be given
+func (m *MoviedbService) CreateLayoutTemplate(ctx context.Context, in *moviedb.CreateLayoutTemplateRequest) (*moviedb.LayoutTemplateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	data := in.Data
+	format := strings.TrimPrefix(in.Format.String(), "GRID_")
+	prices := seatPricesFromProto(in.Prices)
+	currency := in.Currency
+
+	if in.FromVenueid != 0 {
+		var status int
+		var err error
+
+		format = SeatGridText
+		data, prices, currency, status, err = m.MovieDB.ExportSeatGrid(uint(in.FromVenueid), format)
+
+		if status != 200 || err != nil {
+			return &moviedb.LayoutTemplateResponse{
+				Status:  int32(status),
+				Message: "error reading the seats of the venue",
+				Error:   err.Error(),
+			}, nil
+		}
+	}
+
+	template, status, err := m.MovieDB.CreateLayoutTemplate(in.Name, in.Description, data, format, prices, currency)
+
+	if status != 200 || err != nil {
+		return &moviedb.LayoutTemplateResponse{
+			Status:  int32(status),
+			Message: "error creating layout template",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.LayoutTemplateResponse{
+		Status:   200,
+		Message:  "layout template created",
+		Template: layoutTemplateToProto(template),
+	}, nil
+}
+
+// Adds a new version to a layout template, venues keep the version they already have
+func (m *MoviedbService) UpdateLayoutTemplate(ctx context.Context, in *moviedb.UpdateLayoutTemplateRequest) (*moviedb.LayoutTemplateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	template, status, err := m.MovieDB.UpdateLayoutTemplate(uint(in.TemplateId), in.Data, strings.TrimPrefix(in.Format.String(), "GRID_"), seatPricesFromProto(in.Prices), in.Currency)
+
+	if status != 200 || err != nil {
+		return &moviedb.LayoutTemplateResponse{
+			Status:  int32(status),
+			Message: "error updating layout template",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.LayoutTemplateResponse{
+		Status:   200,
+		Message:  "layout template updated",
+		Template: layoutTemplateToProto(template),
+	}, nil
+}
+
+func (m *MoviedbService) GetLayoutTemplate(ctx context.Context, in *moviedb.GetLayoutTemplateRequest) (*moviedb.LayoutTemplateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	template, status, err := m.MovieDB.GetLayoutTemplate(uint(in.TemplateId))
+
+	if status != 200 || err != nil {
+		return &moviedb.LayoutTemplateResponse{
+			Status:  int32(status),
+			Message: "error getting layout template",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.LayoutTemplateResponse{
+		Status:   200,
+		Message:  "success",
+		Template: layoutTemplateToProto(template),
+	}, nil
+}
+
+// Gives a venue the seats of a version of a layout template
+func (m *MoviedbService) ApplyLayoutTemplate(ctx context.Context, in *moviedb.ApplyLayoutTemplateRequest) (*moviedb.VenueLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	layout, status, err := m.MovieDB.ApplyLayoutTemplate(uint(in.TemplateId), int(in.Version), uint(in.Venueid))
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueLayoutResponse{
+			Status:  int32(status),
+			Message: "error applying layout template",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := venueLayoutToProto(layout)
+	response.Status = 200
+	response.Message = "layout template applied"
+
+	return response, nil
+}
+
+// Copies the seat layout of one venue to another
+func (m *MoviedbService) CloneSeatLayout(ctx context.Context, in *moviedb.CloneSeatLayoutRequest) (*moviedb.VenueLayoutResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	layout, status, err := m.MovieDB.CloneSeatLayout(uint(in.FromVenueid), uint(in.ToVenueid))
+
+	if status != 200 || err != nil {
+		return &moviedb.VenueLayoutResponse{
+			Status:  int32(status),
+			Message: "error cloning seat layout",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := venueLayoutToProto(layout)
+	response.Status = 200
+	response.Message = "seat layout cloned"
+
+	return response, nil
+}
+
+func seatPricesFromProto(in map[string]int32) map[string]int {
+	prices := make(map[string]int, len(in))
+
+	for seatType, price := range in {
+		prices[strings.ToUpper(seatType)] = int(price)
+	}
+
+	return prices
+}
+
+func layoutTemplateToProto(t models.LayoutTemplate) *moviedb.LayoutTemplate {
+	template := &moviedb.LayoutTemplate{
+		Id:          int32(t.ID),
+		Name:        t.Name,
+		Description: t.Description,
+		Version:     int32(t.Version),
+		Versions:    make([]*moviedb.LayoutTemplateVersion, 0, len(t.Versions)),
+		Currency:    t.Currency,
+	}
+
+	for _, v := range t.Versions {
+		version := &moviedb.LayoutTemplateVersion{
+			Version:   int32(v.Version),
+			Data:      v.Grid,
+			Prices:    make(map[string]int32, len(v.Prices)),
+			CreatedAt: v.CreatedAt.UTC().Format(time.RFC3339),
+		}
+
+		// Prices were checked when the version was written
+		prices, _ := ParseSeatPrices(strings.Join(v.Prices, ","))
+
+		for seatType, price := range prices {
+			version.Prices[seatType] = int32(price)
+		}
+
+		template.Versions = append(template.Versions, version)
+	}
+
+	return template
+}
+
+func venueLayoutToProto(layout VenueLayout) *moviedb.VenueLayoutResponse {
+	response := &moviedb.VenueLayoutResponse{
+		Venue:          venueToProto(layout.Venue),
+		ScreenPosition: moviedb.ScreenPosition(moviedb.ScreenPosition_value["SCREEN_"+layout.ScreenPosition]),
+		CurvedRows:     layout.CurvedRows,
+		Rows:           make([]*moviedb.LayoutRow, 0, len(layout.Rows)),
+		Sections:       make([]*moviedb.SeatSection, 0, len(layout.Sections)),
+	}
+
+	for _, row := range layout.Rows {
+		cells := make([]*moviedb.LayoutCell, 0, len(row.Cells))
+
+		for _, v := range row.Cells {
+			cell := &moviedb.LayoutCell{
+				Row:     int32(v.Row),
+				Column:  int32(v.Column),
+				Kind:    moviedb.LayoutCellKind(moviedb.LayoutCellKind_value["CELL_"+v.Kind]),
+				Section: v.Section,
+			}
+
+			if v.Seat != nil {
+				cell.Seat = seatMatrixToProto(*v.Seat, layout.Venue.Currency)
+			}
+
+			cells = append(cells, cell)
+		}
+
+		response.Rows = append(response.Rows, &moviedb.LayoutRow{Row: int32(row.Row), Label: row.Label, Cells: cells})
+	}
+
+	for _, v := range layout.Sections {
+		response.Sections = append(response.Sections, &moviedb.SeatSection{
+			Name:        v.Name,
+			FirstRow:    int32(v.FirstRow),
+			LastRow:     int32(v.LastRow),
+			FirstColumn: int32(v.FirstColumn),
+			LastColumn:  int32(v.LastColumn),
+		})
+	}
+
+	return response
+}
+
+func showSeatToProto(v ShowSeat) *moviedb.ShowSeat {
+	seat := &moviedb.ShowSeat{
+		BookedSeatId: int32(v.BookedSeatID),
+		SeatMatrixId: int32(v.SeatMatrixID),
+		SeatNumber:   v.SeatNumber,
+		Row:          int32(v.Row),
+		Column:       int32(v.Column),
+		Type:         moviedb.SeatType(moviedb.SeatType_value[v.Type]),
+		Price:        int32(v.Price.Amount),
+		PriceMoney:   moneyToProto(v.Price),
+		Status:       moviedb.SeatStatus(moviedb.SeatStatus_value[v.Status]),
+		BlockReason:  v.BlockReason,
+	}
+
+	if v.HeldUntil != nil {
+		seat.HeldUntil = v.HeldUntil.Format(time.RFC3339)
+	}
+
+	return seat
+}
+
+// Takes seats off sale for a show or for every show of a venue in a time range
+func (m *MoviedbService) BlockSeats(ctx context.Context, in *moviedb.BlockSeatsRequest) (*moviedb.SeatBlocksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	block := models.SeatBlock{
+		VenueID: uint(in.Venueid),
+		Reason:  strings.TrimPrefix(in.Reason.String(), "BLOCK_"),
+		Note:    in.Note,
+	}
+
+	if in.MovieTimeSlotId != 0 {
+		movieTimeSlotID := uint(in.MovieTimeSlotId)
+		block.MovieTimeSlotID = &movieTimeSlotID
+	}
+
+	var err error
+
+	block.StartTime, err = parseOptionalTime(in.StartTime)
+
+	if err == nil {
+		block.EndTime, err = parseOptionalTime(in.EndTime)
+	}
+
+	if err != nil {
+		return &moviedb.SeatBlocksResponse{
+			Status:  400,
+			Message: "error blocking seats",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	seatMatrixIDs := make([]uint, 0, len(in.SeatMatrixIds))
+
+	for _, id := range in.SeatMatrixIds {
+		seatMatrixIDs = append(seatMatrixIDs, uint(id))
+	}
+
+	blocks, status, err := m.MovieDB.BlockSeats(block, seatMatrixIDs)
+
+	if status != 200 || err != nil {
+		return &moviedb.SeatBlocksResponse{
+			Status:  int32(status),
+			Message: "error blocking seats",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return seatBlocksToProto(blocks, "seats blocked"), nil
+}
+
+// Puts blocked seats back on sale
+func (m *MoviedbService) UnblockSeats(ctx context.Context, in *moviedb.UnblockSeatsRequest) (*moviedb.UnblockSeatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	blockIDs := make([]uint, 0, len(in.BlockIds))
+
+	for _, id := range in.BlockIds {
+		blockIDs = append(blockIDs, uint(id))
+	}
+
+	status, err := m.MovieDB.UnblockSeats(blockIDs)
+
+	if status != 200 || err != nil {
+		return &moviedb.UnblockSeatsResponse{
+			Status:  int32(status),
+			Message: "error unblocking seats",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.UnblockSeatsResponse{
+		Status:  200,
+		Message: "seats unblocked",
+	}, nil
+}
+
+func (m *MoviedbService) GetSeatBlocks(ctx context.Context, in *moviedb.GetSeatBlocksRequest) (*moviedb.SeatBlocksResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	blocks, status, err := m.MovieDB.GetSeatBlocks(uint(in.Venueid), uint(in.MovieTimeSlotId))
+
+	if status != 200 || err != nil {
+		return &moviedb.SeatBlocksResponse{
+			Status:  int32(status),
+			Message: "error getting seat blocks",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return seatBlocksToProto(blocks, "success"), nil
+}
+
+func (m *MoviedbService) AddPriceRule(ctx context.Context, in *moviedb.AddPriceRuleRequest) (*moviedb.PriceRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if in.Rule == nil {
+		return &moviedb.PriceRuleResponse{
+			Status:  400,
+			Message: "error adding price rule",
+			Error:   "price rule is required",
+		}, nil
+	}
+
+	rule := models.PriceRule{
+		Name:        in.Rule.Name,
+		Kind:        strings.TrimPrefix(in.Rule.Kind.String(), "RULE_"),
+		Amount:      int(in.Rule.GetAmount().GetAmount()),
+		Currency:    in.Rule.GetAmount().GetCurrency(),
+		SeatType:    in.Rule.SeatType,
+		MovieFormat: in.Rule.MovieFormat,
+		DaysOfWeek:  in.Rule.DaysOfWeek,
+		FromTime:    in.Rule.FromTime,
+		ToTime:      in.Rule.ToTime,
+	}
+
+	if in.Rule.Venueid != 0 {
+		venueID := uint(in.Rule.Venueid)
+		rule.VenueID = &venueID
+	}
+
+	if in.Rule.MovieTimeSlotId != 0 {
+		movieTimeSlotID := uint(in.Rule.MovieTimeSlotId)
+		rule.MovieTimeSlotID = &movieTimeSlotID
+	}
+
+	rule, status, err := m.MovieDB.AddPriceRule(rule)
+
+	if status != 200 || err != nil {
+		return &moviedb.PriceRuleResponse{
+			Status:  int32(status),
+			Message: "error adding price rule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.PriceRuleResponse{
+		Status:  200,
+		Message: "price rule added",
+		Rule:    priceRuleToProto(rule),
+	}, nil
+}
+
+// Price rules that can apply to the shows of a venue
+func (m *MoviedbService) GetPriceRules(ctx context.Context, in *moviedb.GetPriceRulesRequest) (*moviedb.GetPriceRulesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rules, status, err := m.MovieDB.GetPriceRules(uint(in.Venueid))
+
+	if status != 200 || err != nil {
+		return &moviedb.GetPriceRulesResponse{
+			Status:  int32(status),
+			Message: "error getting price rules",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := &moviedb.GetPriceRulesResponse{
+		Status:  200,
+		Message: "success",
+		Rules:   make([]*moviedb.PriceRule, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, priceRuleToProto(rule))
+	}
+
+	return response, nil
+}
+
+func (m *MoviedbService) DeletePriceRule(ctx context.Context, in *moviedb.DeletePriceRuleRequest) (*moviedb.DeletePriceRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	status, err := m.MovieDB.DeletePriceRule(uint(in.Id))
+
+	if status != 200 || err != nil {
+		return &moviedb.DeletePriceRuleResponse{
+			Status:  int32(status),
+			Message: "error deleting price rule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.DeletePriceRuleResponse{
+		Status:  200,
+		Message: "price rule deleted",
+	}, nil
+}
+
+func priceRuleToProto(v models.PriceRule) *moviedb.PriceRule {
+	rule := &moviedb.PriceRule{
+		Id:          int32(v.ID),
+		Name:        v.Name,
+		Kind:        moviedb.PriceRuleKind(moviedb.PriceRuleKind_value["RULE_"+v.Kind]),
+		Amount:      moneyToProto(Money{Amount: int64(v.Amount), Currency: v.Currency}),
+		SeatType:    v.SeatType,
+		MovieFormat: v.MovieFormat,
+		DaysOfWeek:  v.DaysOfWeek,
+		FromTime:    v.FromTime,
+		ToTime:      v.ToTime,
+	}
+
+	if v.VenueID != nil {
+		rule.Venueid = int32(*v.VenueID)
+	}
+
+	if v.MovieTimeSlotID != nil {
+		rule.MovieTimeSlotId = int32(*v.MovieTimeSlotID)
+	}
+
+	return rule
+}
+
+func (m *MoviedbService) AddTaxRule(ctx context.Context, in *moviedb.AddTaxRuleRequest) (*moviedb.TaxRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if in.Rule == nil {
+		return &moviedb.TaxRuleResponse{
+			Status:  400,
+			Message: "error adding tax rule",
+			Error:   "tax rule is required",
+		}, nil
+	}
+
+	rule, status, err := m.MovieDB.AddTaxRule(models.TaxRule{
+		Name:        in.Rule.Name,
+		Currency:    in.Rule.Currency,
+		RegionCodes: in.Rule.RegionCodes,
+		AppliesTo:   strings.TrimPrefix(in.Rule.AppliesTo.String(), "TAX_"),
+		Rate:        int(in.Rule.Rate),
+		Inclusive:   in.Rule.Inclusive,
+		MinAmount:   int(in.Rule.MinAmount),
+		MaxAmount:   int(in.Rule.MaxAmount),
+	})
+
+	if status != 200 || err != nil {
+		return &moviedb.TaxRuleResponse{
+			Status:  int32(status),
+			Message: "error adding tax rule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.TaxRuleResponse{
+		Status:  200,
+		Message: "tax rule added",
+		Rule:    taxRuleToProto(rule),
+	}, nil
+}
+
+func (m *MoviedbService) GetTaxRules(ctx context.Context, in *moviedb.GetTaxRulesRequest) (*moviedb.GetTaxRulesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	rules, status, err := m.MovieDB.GetTaxRules(in.Currency)
+
+	if status != 200 || err != nil {
+		return &moviedb.GetTaxRulesResponse{
+			Status:  int32(status),
+			Message: "error getting tax rules",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := &moviedb.GetTaxRulesResponse{
+		Status:  200,
+		Message: "success",
+		Rules:   make([]*moviedb.TaxRule, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, taxRuleToProto(rule))
+	}
+
+	return response, nil
+}
+
+func (m *MoviedbService) DeleteTaxRule(ctx context.Context, in *moviedb.DeleteTaxRuleRequest) (*moviedb.DeleteTaxRuleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	status, err := m.MovieDB.DeleteTaxRule(uint(in.Id))
+
+	if status != 200 || err != nil {
+		return &moviedb.DeleteTaxRuleResponse{
+			Status:  int32(status),
+			Message: "error deleting tax rule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.DeleteTaxRuleResponse{
+		Status:  200,
+		Message: "tax rule deleted",
+	}, nil
+}
+
+// Quote given by IsValidToCommitSeatsForBooking, e.g. to show it again on the payment page
+func (m *MoviedbService) GetBookingQuote(ctx context.Context, in *moviedb.GetBookingQuoteRequest) (*moviedb.GetBookingQuoteResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	quote, status, err := m.MovieDB.GetBookingQuote(in.QuoteId)
+
+	if status != 200 || err != nil {
+		return &moviedb.GetBookingQuoteResponse{
+			Status:  int32(status),
+			Message: "error getting quote",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.GetBookingQuoteResponse{
+		Status:  200,
+		Message: "success",
+		Quote:   quoteToProto(quote),
+	}, nil
+}
+
+func taxRuleToProto(v models.TaxRule) *moviedb.TaxRule {
+	return &moviedb.TaxRule{
+		Id:          int32(v.ID),
+		Name:        v.Name,
+		Currency:    v.Currency,
+		RegionCodes: v.RegionCodes,
+		AppliesTo:   moviedb.TaxAppliesTo(moviedb.TaxAppliesTo_value["TAX_"+v.AppliesTo]),
+		Rate:        int32(v.Rate),
+		Inclusive:   v.Inclusive,
+		MinAmount:   int64(v.MinAmount),
+		MaxAmount:   int64(v.MaxAmount),
+	}
+}
+
+func priceAdjustmentsToProto(adjustments []PriceAdjustment) []*moviedb.PriceAdjustment {
+	result := make([]*moviedb.PriceAdjustment, 0, len(adjustments))
+
+	for _, v := range adjustments {
+		result = append(result, &moviedb.PriceAdjustment{
+			PriceRuleId: int32(v.RuleID),
+			Name:        v.Name,
+			Amount:      moneyToProto(v.Amount),
+		})
+	}
+
+	return result
+}
+
+func taxAmountsToProto(taxes []TaxAmount) []*moviedb.TaxAmount {
+	result := make([]*moviedb.TaxAmount, 0, len(taxes))
+
+	for _, v := range taxes {
+		result = append(result, &moviedb.TaxAmount{
+			TaxRuleId: int32(v.TaxRuleID),
+			Name:      v.Name,
+			AppliesTo: moviedb.TaxAppliesTo(moviedb.TaxAppliesTo_value["TAX_"+v.AppliesTo]),
+			Rate:      int32(v.Rate),
+			Inclusive: v.Inclusive,
+			Amount:    moneyToProto(v.Amount),
+		})
+	}
+
+	return result
+}
+
+func quoteToProto(v Quote) *moviedb.BookingQuote {
+	quote := &moviedb.BookingQuote{
+		QuoteId:         v.QuoteID,
+		MovieTimeSlotId: int32(v.MovieTimeSlotID),
+		MovieName:       v.MovieName,
+		Currency:        v.Currency,
+		Seats:           make([]*moviedb.QuoteSeat, 0, len(v.Seats)),
+		Base:            moneyToProto(v.Base),
+		Surcharges:      moneyToProto(v.Surcharges),
+		Discounts:       moneyToProto(v.Discounts),
+		Rounding:        moneyToProto(v.Rounding),
+		ConvenienceFees: moneyToProto(v.ConvenienceFees),
+		Taxes:           taxAmountsToProto(v.Taxes),
+		Total:           moneyToProto(v.Total),
+		CreatedAt:       v.CreatedAt.Format(time.RFC3339),
+	}
+
+	for _, seat := range v.Seats {
+		quote.Seats = append(quote.Seats, &moviedb.QuoteSeat{
+			BookedSeatId:   int32(seat.BookedSeatID),
+			SeatMatrixId:   int32(seat.SeatMatrixID),
+			SeatNumber:     seat.SeatNumber,
+			Base:           moneyToProto(seat.Base),
+			BaseRuleId:     int32(seat.BaseRuleID),
+			Surcharges:     priceAdjustmentsToProto(seat.Surcharges),
+			Discounts:      priceAdjustmentsToProto(seat.Discounts),
+			Rounding:       moneyToProto(seat.Rounding),
+			Price:          moneyToProto(seat.Price),
+			ConvenienceFee: moneyToProto(seat.ConvenienceFee),
+			Taxes:          taxAmountsToProto(seat.Taxes),
+			Total:          moneyToProto(seat.Total),
+		})
+	}
+
+	return quote
+}
+
+// parseOptionalTime parses an RFC3339 time, empty is nil
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %s, expected RFC3339", value)
+	}
+
+	return &t, nil
+}
+
+func seatBlocksToProto(blocks []models.SeatBlock, message string) *moviedb.SeatBlocksResponse {
+	response := &moviedb.SeatBlocksResponse{
+		Status:  200,
+		Message: message,
+		Blocks:  make([]*moviedb.SeatBlock, 0, len(blocks)),
+	}
+
+	for _, v := range blocks {
+		block := &moviedb.SeatBlock{
+			Id:           int32(v.ID),
+			Venueid:      int32(v.VenueID),
+			SeatMatrixId: int32(v.SeatMatrixID),
+			Reason:       moviedb.SeatBlockReason(moviedb.SeatBlockReason_value["BLOCK_"+v.Reason]),
+			Note:         v.Note,
+		}
+
+		if v.MovieTimeSlotID != nil {
+			block.MovieTimeSlotId = int32(*v.MovieTimeSlotID)
+		}
+
+		if v.StartTime != nil {
+			block.StartTime = v.StartTime.UTC().Format(time.RFC3339)
+		}
+
+		if v.EndTime != nil {
+			block.EndTime = v.EndTime.UTC().Format(time.RFC3339)
+		}
+
+		response.Blocks = append(response.Blocks, block)
+	}
+
+	return response
+}
+
+// Best block of free seats for a party, optionally held straight away
+func (m *MoviedbService) SuggestSeats(ctx context.Context, in *moviedb.SuggestSeatsRequest) (*moviedb.SuggestSeatsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	suggestion, status, err := m.MovieDB.SuggestSeats(uint(in.MovieTimeSlotId), int(in.PartySize), in.SeatType, in.Hold,
+		in.IdempotentKey, time.Duration(in.HoldDurationSeconds)*time.Second)
+
+	if status != 200 || err != nil {
+		return &moviedb.SuggestSeatsResponse{
+			Status:  int32(status),
+			Message: "error suggesting seats",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	seats := make([]*moviedb.ShowSeat, 0, len(suggestion.Seats))
+
+	for _, v := range suggestion.Seats {
+		seats = append(seats, showSeatToProto(v))
+	}
+
+	response := &moviedb.SuggestSeatsResponse{
+		Status:  200,
+		Message: "success",
+		Seats:   seats,
+	}
+
+	if suggestion.Hold != nil {
+		response.Message = "suggested seats held"
+		response.HoldToken = suggestion.Hold.HoldToken
+		response.LockedUntil = suggestion.Hold.LockedUntil.Format(time.RFC3339)
+		response.ExtensionsLeft = int32(suggestion.Hold.ExtensionsLeft)
+	}
+
+	return response, nil
+}
+
+// Ranked full-text search over movies for the search bar
+func (m *MoviedbService) SearchMovies(ctx context.Context, in *moviedb.SearchMoviesRequest) (*moviedb.SearchMoviesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filters := MovieSearchFilters{
+		Language:        in.Language,
+		Genre:           in.Type,
+		MovieResolution: in.MovieResolution,
+	}
+
+	results, nextPageToken, status, err := m.MovieDB.SearchMovies(in.Query, filters, int(in.PageSize), in.PageToken)
+
+	if status != 200 || err != nil {
+		return &moviedb.SearchMoviesResponse{
+			Status:  int32(status),
+			Message: "error searching movies",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	searchResults := make([]*moviedb.MovieSearchResult, 0, len(results))
+
+	for _, v := range results {
+		searchResults = append(searchResults, &moviedb.MovieSearchResult{
+			Movie:              movieToProto(v.Movie),
+			Rank:               v.Rank,
+			TitleHighlight:     v.TitleHighlight,
+			DescriptionSnippet: v.DescriptionSnippet,
+			MatchedCast:        v.MatchedCast,
+		})
+	}
+
+	return &moviedb.SearchMoviesResponse{
+		Status:        200,
+		Message:       "success",
+		Results:       searchResults,
+		Error:         "",
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Adds a cinema, screens passed along are created with it
+func (m *MoviedbService) AddCinema(ctx context.Context, in *moviedb.Cinema) (*moviedb.CinemaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cinema := cinemaFromProto(in)
+
+	for _, screen := range in.Screens {
+		cinema.Screens = append(cinema.Screens, models.Venue{
+			Name:                 screen.Name,
+			Type:                 screen.Type.String(),
+			Address:              screen.Address,
+			Rows:                 int(screen.Rows),
+			Columns:              int(screen.Columns),
+			ScreenNumber:         int(screen.ScreenNumber),
+			MovieFormatSupported: screen.MovieFormatSupported,
+			LanguagesSupported:   screen.LanguageSupported,
+			SeatHoldMinutes:      int(screen.SeatHoldMinutes),
+			OpeningTime:          screen.OpeningTime,
+			ClosingTime:          screen.ClosingTime,
+			Currency:             strings.ToUpper(screen.Currency),
+			PriceRounding:        int(screen.PriceRounding),
+			RoundingMode:         strings.TrimPrefix(screen.RoundingMode.String(), "ROUND_"),
+		})
+	}
+
+	cinema, status, err := m.MovieDB.AddCinema(cinema)
+
+	if status != 200 || err != nil {
+		return &moviedb.CinemaResponse{
+			Status:  int32(status),
+			Message: "error adding a new cinema",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.CinemaResponse{
+		Status:  200,
+		Message: "added a new cinema",
+		Cinema:  cinemaToProto(cinema),
+	}, nil
+}
+
+func (m *MoviedbService) GetCinema(ctx context.Context, in *moviedb.CinemaRequest) (*moviedb.CinemaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cinema, status, err := m.MovieDB.GetCinema(uint(in.Cinemaid))
+
+	if status != 200 || err != nil {
+		return &moviedb.CinemaResponse{
+			Status:  int32(status),
+			Message: "error getting cinema",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.CinemaResponse{
+		Status:  200,
+		Message: "success",
+		Cinema:  cinemaToProto(cinema),
+	}, nil
+}
+
+// Lists every cinema page by page for the admin console
+func (m *MoviedbService) GetAllCinemas(ctx context.Context, in *moviedb.GetAllCinemasRequest) (*moviedb.CinemaListResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cinemas, nextPageToken, status, err := m.MovieDB.GetAllCinemas(int(in.PageSize), in.PageToken, in.RegionCode)
+
+	if status != 200 || err != nil {
+		return &moviedb.CinemaListResponse{
+			Status:  int32(status),
+			Message: "error getting cinemas",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	cinemaList := make([]*moviedb.Cinema, 0, len(cinemas))
+
+	for _, v := range cinemas {
+		cinemaList = append(cinemaList, cinemaToProto(v))
+	}
+
+	return &moviedb.CinemaListResponse{
+		Status:        200,
+		Message:       "success",
+		Cinemas:       cinemaList,
+		Error:         "",
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Updates the site details of a cinema, screens are updated through UpdateVenue
+func (m *MoviedbService) UpdateCinema(ctx context.Context, in *moviedb.Cinema) (*moviedb.CinemaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cinema, status, err := m.MovieDB.UpdateCinema(uint(in.Id), cinemaFromProto(in))
+
+	if status != 200 || err != nil {
+		return &moviedb.CinemaResponse{
+			Status:  int32(status),
+			Message: "error updating cinema",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.CinemaResponse{
+		Status:  200,
+		Message: "cinema updated",
+		Cinema:  cinemaToProto(cinema),
+	}, nil
+}
+
+func (m *MoviedbService) DeleteCinema(ctx context.Context, in *moviedb.CinemaRequest) (*moviedb.CinemaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	status, err := m.MovieDB.DeleteCinema(uint(in.Cinemaid))
+
+	if status != 200 || err != nil {
+		return &moviedb.CinemaResponse{
+			Status:  int32(status),
+			Message: "error deleting cinema",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.CinemaResponse{
+		Status:  200,
+		Message: "cinema deleted",
+	}, nil
+}
+
+// Links the venues created before cinemas existed to a cinema of their site
+func (m *MoviedbService) MigrateVenuesToCinemas(ctx context.Context, in *moviedb.MigrateVenuesToCinemasRequest) (*moviedb.MigrateVenuesToCinemasResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	created, linked, status, err := m.MovieDB.MigrateVenuesToCinemas()
+
+	if status != 200 || err != nil {
+		return &moviedb.MigrateVenuesToCinemasResponse{
+			Status:  int32(status),
+			Message: "error migrating venues to cinemas",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.MigrateVenuesToCinemasResponse{
+		Status:         200,
+		Message:        "success",
+		CinemasCreated: int32(created),
+		VenuesLinked:   int32(linked),
+	}, nil
+}
+
+// scheduleConflictToProto returns nil unless err is a ScheduleConflictError
+func scheduleConflictToProto(err error) *moviedb.ScheduleConflict {
+	var conflict *ScheduleConflictError
+
+	if !errors.As(err, &conflict) {
+		return nil
+	}
+
+	out := &moviedb.ScheduleConflict{
+		Reason: conflict.Reason,
+	}
+
+	if conflict.MovieTimeSlot != nil {
+		out.ConflictingMovieTimeSlot = movieTimeSlotToProto(*conflict.MovieTimeSlot)
+	}
+
+	return out
+}
+
+// Shows store their format by its name, e.g. 3D, older shows by the SeatType name, e.g. THREE_D
+func movieFormatToProto(format string) moviedb.MovieFormat {
+	for name, value := range moviedb.MovieFormat_value {
+		if NormalizeMovieFormat(name) == NormalizeMovieFormat(format) {
+			return moviedb.MovieFormat(value)
+		}
+	}
+
+	return moviedb.MovieFormat_FORMAT_2D
+}
+
+func movieFormatFromProto(format moviedb.MovieFormat) string {
+	return NormalizeMovieFormat(format.String())
+}
+
+// setShowtimeTimes gives the start and end of a show in UTC and in the time zone it was loaded in
+func setShowtimeTimes(out *moviedb.MovieTimeSlot, v models.MovieTimeSlot) {
+	out.StartTime = v.StartTime.UTC().Format(time.RFC3339)
+	out.EndTime = v.EndTime.UTC().Format(time.RFC3339)
+	out.LocalStartTime = v.StartTime.Format(time.RFC3339)
+	out.LocalEndTime = v.EndTime.Format(time.RFC3339)
+	out.Timezone = v.StartTime.Location().String()
+
+	// Shows that were not localized come back from the database in the local zone of the server
+	if v.StartTime.Location() == time.Local {
+		out.LocalStartTime = out.StartTime
+		out.LocalEndTime = out.EndTime
+		out.Timezone = time.UTC.String()
+	}
+}
+
+func movieTimeSlotToProto(v models.MovieTimeSlot) *moviedb.MovieTimeSlot {
+	out := &moviedb.MovieTimeSlot{
+		Id:          int32(v.ID),
+		Date:        v.Date.Format(time.DateOnly),
+		Duration:    int32(v.Duration),
+		MovieFormat: movieFormatToProto(v.MovieFormat),
+		Movieid:     int32(v.MovieID),
+		Venueid:     int32(v.VenueID),
+		Language:    v.Language,
+		Subtitles:   v.Subtitles,
+
+		Status:             v.Status,
+		CancellationReason: v.CancellationReason,
+	}
+
+	setShowtimeTimes(out, v)
+
+	if v.ShowScheduleID != nil {
+		out.ShowScheduleid = int32(*v.ShowScheduleID)
+	}
+
+	return out
+}
+
+func (m *MoviedbService) CreateShowSchedule(ctx context.Context, in *moviedb.ShowScheduleRequest) (*moviedb.ShowScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	schedule, err := showScheduleFromProto(in.ShowSchedule)
+
+	if err != nil {
+		return &moviedb.ShowScheduleResponse{
+			Status:  400,
+			Message: "error parsing show schedule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	result, status, err := m.MovieDB.CreateShowSchedule(schedule, ShowScheduleOptions{
+		DryRun:        in.DryRun,
+		SkipConflicts: in.SkipConflicts,
+	})
+
+	if status != 200 || err != nil {
+		response := showScheduleResultToProto(result)
+		response.Status = int32(status)
+		response.Message = "error creating show schedule"
+		response.Error = err.Error()
+		return response, nil
+	}
+
+	response := showScheduleResultToProto(result)
+	response.Status = 200
+	response.Message = "show schedule created"
+
+	if in.DryRun {
+		response.Message = "show schedule checked, nothing was saved"
+	}
+
+	return response, nil
+}
+
+func (m *MoviedbService) UpdateShowSchedule(ctx context.Context, in *moviedb.ShowScheduleRequest) (*moviedb.ShowScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	schedule, err := showScheduleFromProto(in.ShowSchedule)
+
+	if err != nil {
+		return &moviedb.ShowScheduleResponse{
+			Status:  400,
+			Message: "error parsing show schedule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	result, status, err := m.MovieDB.UpdateShowSchedule(schedule.ID, schedule, ShowScheduleOptions{
+		DryRun:        in.DryRun,
+		SkipConflicts: in.SkipConflicts,
+	})
+
+	if status != 200 || err != nil {
+		response := showScheduleResultToProto(result)
+		response.Status = int32(status)
+		response.Message = "error updating show schedule"
+		response.Error = err.Error()
+		return response, nil
+	}
+
+	response := showScheduleResultToProto(result)
+	response.Status = 200
+	response.Message = "show schedule updated"
+
+	if in.DryRun {
+		response.Message = "show schedule checked, nothing was saved"
+	}
+
+	return response, nil
+}
+
+func (m *MoviedbService) CancelShowSchedule(ctx context.Context, in *moviedb.CancelShowScheduleRequest) (*moviedb.ShowScheduleResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	result, status, err := m.MovieDB.CancelShowSchedule(uint(in.ShowScheduleid))
+
+	if status != 200 || err != nil {
+		return &moviedb.ShowScheduleResponse{
+			Status:  int32(status),
+			Message: "error cancelling show schedule",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	response := showScheduleResultToProto(result)
+	response.Status = 200
+	response.Message = "show schedule cancelled"
+
+	return response, nil
+}
+
+// Dates are optional so an update can leave them out
+func showScheduleFromProto(in *moviedb.ShowSchedule) (models.ShowSchedule, error) {
+	if in == nil {
+		return models.ShowSchedule{}, errors.New("show schedule is required")
+	}
+
+	schedule := models.ShowSchedule{
+		MovieID:    uint(in.Movieid),
+		VenueID:    uint(in.Venueid),
+		DaysOfWeek: in.DaysOfWeek,
+		StartTimes: in.StartTimes,
+		Duration:   int(in.Duration),
+		Language:   in.Language,
+		Subtitles:  in.Subtitles,
+	}
+
+	schedule.ID = uint(in.Id)
+
+	// FORMAT_2D is the zero value, so an update only changes the format when another one is given
+	if in.MovieFormat != moviedb.MovieFormat_FORMAT_2D || in.Id == 0 {
+		schedule.MovieFormat = movieFormatFromProto(in.MovieFormat)
+	}
+
+	if in.StartDate != "" {
+		d, err := time.Parse(time.DateOnly, in.StartDate)
+
+		if err != nil {
+			return schedule, fmt.Errorf("error parsing start date: %v", err)
+		}
+
+		schedule.StartDate = d
+	}
+
+	if in.EndDate != "" {
+		d, err := time.Parse(time.DateOnly, in.EndDate)
+
+		if err != nil {
+			return schedule, fmt.Errorf("error parsing end date: %v", err)
+		}
+
+		schedule.EndDate = d
+	}
+
+	return schedule, nil
+}
+
+func showScheduleToProto(v models.ShowSchedule) *moviedb.ShowSchedule {
+	return &moviedb.ShowSchedule{
+		Id:          int32(v.ID),
+		Movieid:     int32(v.MovieID),
+		Venueid:     int32(v.VenueID),
+		MovieFormat: movieFormatToProto(v.MovieFormat),
+		StartDate:   v.StartDate.Format(time.DateOnly),
+		EndDate:     v.EndDate.Format(time.DateOnly),
+		DaysOfWeek:  v.DaysOfWeek,
+		StartTimes:  v.StartTimes,
+		Duration:    int32(v.Duration),
+		Status:      v.Status,
+		Language:    v.Language,
+		Subtitles:   v.Subtitles,
+	}
+}
+
+func showScheduleResultToProto(result ShowScheduleResult) *moviedb.ShowScheduleResponse {
+	response := &moviedb.ShowScheduleResponse{}
+
+	if result.Schedule.ID != 0 {
+		response.ShowSchedule = showScheduleToProto(result.Schedule)
+	}
+
+	for _, v := range result.Created {
+		response.Created = append(response.Created, movieTimeSlotToProto(v))
+	}
+
+	for _, v := range result.Updated {
+		response.Updated = append(response.Updated, movieTimeSlotToProto(v))
+	}
+
+	for _, v := range result.Cancelled {
+		response.Cancelled = append(response.Cancelled, movieTimeSlotToProto(v))
+	}
+
+	for _, v := range result.Conflicts {
+		response.Conflicts = append(response.Conflicts, &moviedb.ScheduledShowConflict{
+			MovieTimeSlot: movieTimeSlotToProto(v.MovieTimeSlot),
+			Conflict:      scheduleConflictToProto(v.Conflict),
+		})
+	}
+
+	return response
+}
+
+// Previews or commits a programme of shows for the screens, see MovieDB.PlanProgramme
+func (m *MoviedbService) PlanProgramme(ctx context.Context, in *moviedb.PlanProgrammeRequest) (*moviedb.PlanProgrammeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	weekStart, err := time.Parse(time.DateOnly, in.WeekStart)
+
+	if err != nil {
+		return &moviedb.PlanProgrammeResponse{
+			Status:  400,
+			Message: "error parsing week start",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	venueIDs := make([]uint, 0, len(in.Venueids))
+
+	for _, id := range in.Venueids {
+		venueIDs = append(venueIDs, uint(id))
+	}
+
+	movies := make([]PlannedMovie, 0, len(in.Movies))
+
+	for _, movie := range in.Movies {
+		planned := PlannedMovie{
+			TargetShows: int(movie.TargetShows),
+			Priority:    int(movie.Priority),
+			MovieFormat: movie.MovieFormat,
+		}
+
+		planned.Movie.ID = uint(movie.Movieid)
+		movies = append(movies, planned)
+	}
+
+	plan, status, err := m.MovieDB.PlanProgramme(weekStart, int(in.Days), venueIDs, movies, in.Commit)
+
+	if status != 200 || err != nil {
+		return &moviedb.PlanProgrammeResponse{
+			Status:   int32(status),
+			Message:  "error planning programme",
+			Error:    err.Error(),
+			Conflict: scheduleConflictToProto(err),
+		}, nil
+	}
+
+	response := &moviedb.PlanProgrammeResponse{
+		Status:  200,
+		Message: "programme planned, nothing was saved",
+	}
+
+	if in.Commit {
+		response.Message = "programme saved"
+	}
+
+	for _, show := range plan.MovieTimeSlots {
+		response.MovieTimeSlots = append(response.MovieTimeSlots, movieTimeSlotToProto(show))
+	}
+
+	for _, movie := range movies {
+		response.Movies = append(response.Movies, &moviedb.PlannedMovieShows{
+			Movieid:      int32(movie.Movie.ID),
+			PlannedShows: int32(plan.PlannedShows[movie.Movie.ID]),
+			MissingShows: int32(plan.MissingShows[movie.Movie.ID]),
+		})
+	}
+
+	for _, id := range venueIDs {
+		response.Screens = append(response.Screens, &moviedb.ScreenUse{
+			Venueid: int32(id),
+			Use:     plan.ScreenUse[id],
+		})
+	}
+
+	return response, nil
+}
+
+func (m *MoviedbService) SetShowtimeStatus(ctx context.Context, in *moviedb.SetShowtimeStatusRequest) (*moviedb.MovieTimeSlotUpdateResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	movieTimeSlot, status, err := m.MovieDB.SetShowtimeStatus(uint(in.MovieTimeSlotId), in.Status)
+
+	if status != 200 || err != nil {
+		return &moviedb.MovieTimeSlotUpdateResponse{
+			Status:  int32(status),
+			Message: "error changing the status of the movie time slot",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &moviedb.MovieTimeSlotUpdateResponse{
+		Status:        200,
+		Message:       "movie time slot status changed",
+		MovieTimeSlot: movieTimeSlotToProto(movieTimeSlot),
+	}, nil
+}
+
+// Cancels a show, refunds its tickets and mails the customers, see MovieDB.CancelShowtime
+func (m *MoviedbService) CancelShowtime(ctx context.Context, in *moviedb.CancelShowtimeRequest) (*moviedb.CancelShowtimeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	result, status, err := m.MovieDB.CancelShowtime(uint(in.MovieTimeSlotId), in.Reason)
+
+	if status != 200 || err != nil {
+		return &moviedb.CancelShowtimeResponse{
+			Status:  int32(status),
+			Message: "error cancelling show",
+			Error:   err.Error(),
+		}, nil
+	}
+
+	refundTicketIDs := make([]int32, 0, len(result.RefundTickets))
+
+	for _, ticket := range result.RefundTickets {
+		refundTicketIDs = append(refundTicketIDs, int32(ticket.ID))
+	}
+
+	return &moviedb.CancelShowtimeResponse{
+		Status:            200,
+		Message:           "show cancelled",
+		MovieTimeSlot:     movieTimeSlotToProto(result.MovieTimeSlot),
+		RefundTicketids:   refundTicketIDs,
+		ReleasedSeats:     int32(len(result.ReleasedSeats)),
+		NotifiedCustomers: int32(result.Notified),
+	}, nil
+}
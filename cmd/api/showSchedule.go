@@ -0,0 +1,563 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Statuses of a show schedule
+const (
+	ShowScheduleStatusActive    = "ACTIVE"
+	ShowScheduleStatusCancelled = "CANCELLED"
+)
+
+// MaxShowScheduleDays bounds how far a single schedule can reach
+const MaxShowScheduleDays = 366
+
+/*
+ShowScheduleOptions control how a schedule is written
+
+	DryRun: work out the shows and conflicts without writing anything
+	SkipConflicts: write the shows that fit and leave out the conflicting ones, by default nothing is written when a show conflicts
+*/
+type ShowScheduleOptions struct {
+	DryRun        bool
+	SkipConflicts bool
+}
+
+// ShowConflict is a generated show that could not be written and why
+type ShowConflict struct {
+	MovieTimeSlot models.MovieTimeSlot
+	Conflict      *ScheduleConflictError
+}
+
+// ShowScheduleResult is what a schedule change did, or would do for a dry run
+type ShowScheduleResult struct {
+	Schedule  models.ShowSchedule
+	Created   []models.MovieTimeSlot
+	Updated   []models.MovieTimeSlot
+	Cancelled []models.MovieTimeSlot
+	Conflicts []ShowConflict
+}
+
+// ValidateShowSchedule checks the dates, days and start times of a schedule
+func ValidateShowSchedule(schedule models.ShowSchedule) error {
+	if schedule.EndDate.Before(schedule.StartDate) {
+		return errors.New("end date has to be on or after the start date")
+	}
+
+	if schedule.EndDate.Sub(schedule.StartDate) >= MaxShowScheduleDays*24*time.Hour {
+		return fmt.Errorf("a schedule can span at most %d days", MaxShowScheduleDays)
+	}
+
+	if len(schedule.DaysOfWeek) == 0 {
+		return errors.New("at least one day of the week is required")
+	}
+
+	for _, day := range schedule.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return fmt.Errorf("invalid day of the week %d, 0 is Sunday and 6 is Saturday", day)
+		}
+	}
+
+	if len(schedule.StartTimes) == 0 {
+		return errors.New("at least one start time is required")
+	}
+
+	for _, startTime := range schedule.StartTimes {
+		if _, err := time.Parse("15:04", startTime); err != nil {
+			return fmt.Errorf("invalid start time %s, expected HH:MM", startTime)
+		}
+	}
+
+	if schedule.Duration <= 0 {
+		return errors.New("duration is required")
+	}
+
+	return nil
+}
+
+/*
+ExpandShowSchedule lists the shows of a schedule ordered by start time
+
+	schedule: the schedule, dates are calendar days and start times are wall clock times
+	loc: time zone of the cinema the start times are in
+*/
+func ExpandShowSchedule(schedule models.ShowSchedule, loc *time.Location) ([]models.MovieTimeSlot, error) {
+	if err := ValidateShowSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	days := make(map[time.Weekday]bool, len(schedule.DaysOfWeek))
+
+	for _, day := range schedule.DaysOfWeek {
+		days[time.Weekday(day)] = true
+	}
+
+	var shows []models.MovieTimeSlot
+
+	first := time.Date(schedule.StartDate.Year(), schedule.StartDate.Month(), schedule.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(schedule.EndDate.Year(), schedule.EndDate.Month(), schedule.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		if !days[date.Weekday()] {
+			continue
+		}
+
+		for _, startTime := range schedule.StartTimes {
+			clock, _ := time.Parse("15:04", startTime)
+
+			start := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+
+			shows = append(shows, models.MovieTimeSlot{
+				StartTime:   start.UTC(),
+				EndTime:     start.Add(time.Duration(schedule.Duration) * time.Minute).UTC(),
+				Duration:    schedule.Duration,
+				MovieID:     schedule.MovieID,
+				Date:        date,
+				MovieFormat: schedule.MovieFormat,
+				VenueID:     schedule.VenueID,
+				Language:    schedule.Language,
+				Subtitles:   schedule.Subtitles,
+			})
+		}
+	}
+
+	sort.SliceStable(shows, func(i, j int) bool {
+		return shows[i].StartTime.Before(shows[j].StartTime)
+	})
+
+	return shows, nil
+}
+
+// futureShows expands a schedule and drops the shows that already started
+func futureShows(db *gorm.DB, schedule models.ShowSchedule, now time.Time) ([]models.MovieTimeSlot, error) {
+	loc, err := venueTimezone(db, schedule.VenueID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	shows, err := ExpandShowSchedule(schedule, loc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	future := make([]models.MovieTimeSlot, 0, len(shows))
+
+	for _, show := range shows {
+		if show.StartTime.After(now) {
+			show.ShowScheduleID = &schedule.ID
+			future = append(future, show)
+		}
+	}
+
+	return future, nil
+}
+
+// removeScheduledShow deletes a show without booked or held seats, keeping it for history
+func removeScheduledShow(tx *gorm.DB, slot models.MovieTimeSlot) error {
+	var seats []models.BookedSeats
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("movie_time_slot_id = ?", slot.ID).Find(&seats)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	booked := 0
+
+	for _, seat := range seats {
+		if seat.IsBooked {
+			booked++
+		}
+	}
+
+	if booked > 0 {
+		return &ScheduleConflictError{
+			Reason:        ScheduleConflictHasBookings,
+			Message:       fmt.Sprintf("show has %d booked or held seats", booked),
+			MovieTimeSlot: &slot,
+		}
+	}
+
+	if err := tx.Where("movie_time_slot_id = ?", slot.ID).Delete(&models.BookedSeats{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Delete(&slot).Error
+}
+
+// addScheduledShows adds the shows through the same checks as AddMovieTimeSlot, conflicting shows are collected in result
+func (m *MovieDB) addScheduledShows(tx *gorm.DB, shows []models.MovieTimeSlot, result *ShowScheduleResult) (int, error) {
+	for _, show := range shows {
+		created, status, err := m.addMovieTimeSlot(tx, show)
+
+		var conflict *ScheduleConflictError
+
+		if errors.As(err, &conflict) {
+			result.Conflicts = append(result.Conflicts, ShowConflict{MovieTimeSlot: show, Conflict: conflict})
+			continue
+		}
+
+		if status != 200 || err != nil {
+			return status, err
+		}
+
+		result.Created = append(result.Created, created)
+	}
+
+	return 200, nil
+}
+
+// finishShowSchedule commits tx unless it is a dry run or a conflict has to stop the change
+func finishShowSchedule(tx *gorm.DB, result ShowScheduleResult, options ShowScheduleOptions) (ShowScheduleResult, int, error) {
+	if len(result.Conflicts) > 0 && !options.SkipConflicts {
+		tx.Rollback()
+		return result, 409, fmt.Errorf("%d shows conflict with the schedule of the venue", len(result.Conflicts))
+	}
+
+	if options.DryRun {
+		tx.Rollback()
+		return result, 200, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return result, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return result, 200, nil
+}
+
+/*
+CreateShowSchedule adds a schedule and all of its future shows with their seats in one transaction
+
+	schedule: a Duration of 0 uses the runtime of the movie
+	options: dry run and conflict handling
+
+When shows conflict the result lists them, nothing is written unless options.SkipConflicts is set
+*/
+func (m *MovieDB) CreateShowSchedule(schedule models.ShowSchedule, options ShowScheduleOptions) (ShowScheduleResult, int, error) {
+	result := ShowScheduleResult{}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if schedule.Duration == 0 {
+		var movie models.Movie
+
+		if err := tx.First(&movie, schedule.MovieID).Error; err != nil {
+			tx.Rollback()
+			return result, 404, fmt.Errorf("movie %d not found", schedule.MovieID)
+		}
+
+		schedule.Duration = movie.Duration
+	}
+
+	schedule.Status = ShowScheduleStatusActive
+
+	if err := ValidateShowSchedule(schedule); err != nil {
+		tx.Rollback()
+		return result, 400, err
+	}
+
+	if err := tx.Create(&schedule).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	result.Schedule = schedule
+
+	shows, err := futureShows(tx, schedule, time.Now())
+
+	if err != nil {
+		tx.Rollback()
+		return result, 400, err
+	}
+
+	if status, err := m.addScheduledShows(tx, shows, &result); err != nil {
+		tx.Rollback()
+		return result, status, err
+	}
+
+	return finishShowSchedule(tx, result, options)
+}
+
+func lockShowSchedule(tx *gorm.DB, scheduleID uint) (models.ShowSchedule, int, error) {
+	var schedule models.ShowSchedule
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&schedule, scheduleID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return schedule, 404, errors.New("show schedule not found")
+	}
+
+	if result.Error != nil {
+		return schedule, 500, result.Error
+	}
+
+	if schedule.Status == ShowScheduleStatusCancelled {
+		return schedule, 409, errors.New("show schedule is cancelled")
+	}
+
+	return schedule, 200, nil
+}
+
+/*
+UpdateShowSchedule changes a schedule and brings its future shows in line
+
+	scheduleID: the schedule to change
+	updated: new dates, days, start times, duration or format, zero fields are left as they are. Movie and venue cannot change
+	options: dry run and conflict handling
+
+Future shows no longer in the schedule are removed, kept shows get the new duration and format and missing shows are added.
+Shows that already started are never touched, a show with booked or held seats is reported as a conflict instead of being removed
+*/
+func (m *MovieDB) UpdateShowSchedule(scheduleID uint, updated models.ShowSchedule, options ShowScheduleOptions) (ShowScheduleResult, int, error) {
+	result := ShowScheduleResult{}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	schedule, status, err := lockShowSchedule(tx, scheduleID)
+
+	if err != nil {
+		tx.Rollback()
+		return result, status, err
+	}
+
+	if (updated.MovieID != 0 && updated.MovieID != schedule.MovieID) || (updated.VenueID != 0 && updated.VenueID != schedule.VenueID) {
+		tx.Rollback()
+		return result, 400, errors.New("movie and venue of a schedule cannot change, cancel it and create a new one")
+	}
+
+	if !updated.StartDate.IsZero() {
+		schedule.StartDate = updated.StartDate
+	}
+
+	if !updated.EndDate.IsZero() {
+		schedule.EndDate = updated.EndDate
+	}
+
+	if len(updated.DaysOfWeek) > 0 {
+		schedule.DaysOfWeek = updated.DaysOfWeek
+	}
+
+	if len(updated.StartTimes) > 0 {
+		schedule.StartTimes = updated.StartTimes
+	}
+
+	if updated.Duration != 0 {
+		schedule.Duration = updated.Duration
+	}
+
+	if updated.MovieFormat != "" {
+		schedule.MovieFormat = updated.MovieFormat
+	}
+
+	if updated.Language != "" {
+		schedule.Language = updated.Language
+	}
+
+	if updated.Subtitles != nil {
+		schedule.Subtitles = updated.Subtitles
+	}
+
+	if err := ValidateShowSchedule(schedule); err != nil {
+		tx.Rollback()
+		return result, 400, err
+	}
+
+	now := time.Now()
+
+	shows, err := futureShows(tx, schedule, now)
+
+	if err != nil {
+		tx.Rollback()
+		return result, 400, err
+	}
+
+	var existing []models.MovieTimeSlot
+
+	if err := tx.Where("show_schedule_id = ? AND start_time > ?", schedule.ID, now).Order("start_time ASC").Find(&existing).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	wanted := make(map[int64]bool, len(shows))
+
+	for _, show := range shows {
+		wanted[show.StartTime.Unix()] = true
+	}
+
+	// Shows are removed first so a show moved by a few minutes does not clash with its old slot
+
+	kept := make(map[int64]models.MovieTimeSlot)
+
+	for _, show := range existing {
+		if wanted[show.StartTime.Unix()] {
+			kept[show.StartTime.Unix()] = show
+			continue
+		}
+
+		// A cancelled show is kept as it is for history
+
+		if show.Status == ShowtimeCancelled {
+			continue
+		}
+
+		err := removeScheduledShow(tx, show)
+
+		var conflict *ScheduleConflictError
+
+		if errors.As(err, &conflict) {
+			result.Conflicts = append(result.Conflicts, ShowConflict{MovieTimeSlot: show, Conflict: conflict})
+			continue
+		}
+
+		if err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+
+		result.Cancelled = append(result.Cancelled, show)
+	}
+
+	missing := make([]models.MovieTimeSlot, 0)
+
+	for _, show := range shows {
+		current, ok := kept[show.StartTime.Unix()]
+
+		if !ok {
+			missing = append(missing, show)
+			continue
+		}
+
+		if current.Status == ShowtimeCancelled || (current.Duration == show.Duration && current.MovieFormat == show.MovieFormat &&
+			(show.Language == "" || current.Language == show.Language) && slices.Equal(current.Subtitles, show.Subtitles)) {
+			continue
+		}
+
+		current.EndTime = show.EndTime
+		current.Duration = show.Duration
+		current.MovieFormat = show.MovieFormat
+		current.Subtitles = show.Subtitles
+
+		if show.Language != "" {
+			current.Language = show.Language
+		}
+
+		status, err := m.checkSchedule(tx, &current)
+
+		var conflict *ScheduleConflictError
+
+		if errors.As(err, &conflict) {
+			result.Conflicts = append(result.Conflicts, ShowConflict{MovieTimeSlot: current, Conflict: conflict})
+			continue
+		}
+
+		if err != nil {
+			tx.Rollback()
+			return result, status, err
+		}
+
+		if err := tx.Save(&current).Error; err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+
+		result.Updated = append(result.Updated, current)
+	}
+
+	if status, err := m.addScheduledShows(tx, missing, &result); err != nil {
+		tx.Rollback()
+		return result, status, err
+	}
+
+	if err := tx.Save(&schedule).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	result.Schedule = schedule
+
+	return finishShowSchedule(tx, result, options)
+}
+
+/*
+CancelShowSchedule stops a schedule and removes its future shows
+
+Shows with booked or held seats are kept and listed as conflicts, they have to be cancelled on their own
+*/
+func (m *MovieDB) CancelShowSchedule(scheduleID uint) (ShowScheduleResult, int, error) {
+	result := ShowScheduleResult{}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	schedule, status, err := lockShowSchedule(tx, scheduleID)
+
+	if err != nil {
+		tx.Rollback()
+		return result, status, err
+	}
+
+	var existing []models.MovieTimeSlot
+
+	if err := tx.Where("show_schedule_id = ? AND status <> ? AND start_time > ?", schedule.ID, ShowtimeCancelled, time.Now()).Order("start_time ASC").Find(&existing).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	for _, show := range existing {
+		err := removeScheduledShow(tx, show)
+
+		var conflict *ScheduleConflictError
+
+		if errors.As(err, &conflict) {
+			result.Conflicts = append(result.Conflicts, ShowConflict{MovieTimeSlot: show, Conflict: conflict})
+			continue
+		}
+
+		if err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+
+		result.Cancelled = append(result.Cancelled, show)
+	}
+
+	schedule.Status = ShowScheduleStatusCancelled
+
+	if err := tx.Save(&schedule).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	result.Schedule = schedule
+
+	return finishShowSchedule(tx, result, ShowScheduleOptions{SkipConflicts: true})
+}
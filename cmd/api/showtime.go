@@ -0,0 +1,408 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Lifecycle of a show, only a show ON_SALE can have its seats locked
+const (
+	ShowtimeScheduled = "SCHEDULED" // Announced, sales have not opened yet
+	ShowtimeOnSale    = "ON_SALE"
+	ShowtimeSoldOut   = "SOLD_OUT"
+	ShowtimeCancelled = "CANCELLED"
+	ShowtimeCompleted = "COMPLETED"
+)
+
+// TicketRefundPending marks a ticket of a cancelled show the payment service still has to refund
+const TicketRefundPending = "PENDING"
+
+var showtimeTransitions = map[string][]string{
+	ShowtimeScheduled: {ShowtimeOnSale, ShowtimeCancelled, ShowtimeCompleted},
+	ShowtimeOnSale:    {ShowtimeScheduled, ShowtimeSoldOut, ShowtimeCancelled, ShowtimeCompleted},
+	ShowtimeSoldOut:   {ShowtimeCancelled, ShowtimeCompleted},
+}
+
+// CanChangeShowtimeStatus tells if a show can move from one status to another, cancelled and completed shows are final
+func CanChangeShowtimeStatus(from string, to string) bool {
+	for _, status := range showtimeTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateNewShowtimeStatus checks the status a show is created with, empty puts it on sale
+func validateNewShowtimeStatus(slot *models.MovieTimeSlot) error {
+	switch slot.Status {
+	case "":
+		slot.Status = ShowtimeOnSale
+	case ShowtimeScheduled, ShowtimeOnSale:
+	default:
+		return fmt.Errorf("a show can only be created %s or %s", ShowtimeScheduled, ShowtimeOnSale)
+	}
+
+	return nil
+}
+
+/*
+SetShowtimeStatus opens or pauses the sales of a show
+
+	movieTimeSlotID: the show
+	status: SCHEDULED or ON_SALE, a show is cancelled with CancelShowtime and sold out and completed shows are tracked by the service
+*/
+func (m *MovieDB) SetShowtimeStatus(movieTimeSlotID uint, status string) (models.MovieTimeSlot, int, error) {
+	if status != ShowtimeScheduled && status != ShowtimeOnSale {
+		return models.MovieTimeSlot{}, 400, fmt.Errorf("status can only be set to %s or %s", ShowtimeScheduled, ShowtimeOnSale)
+	}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	slot, code, err := lockShowtime(tx, movieTimeSlotID)
+
+	if err != nil {
+		tx.Rollback()
+		return slot, code, err
+	}
+
+	if slot.Status == status {
+		tx.Rollback()
+		return slot, 200, nil
+	}
+
+	if !CanChangeShowtimeStatus(slot.Status, status) {
+		tx.Rollback()
+		return slot, 409, fmt.Errorf("show cannot go from %s to %s", slot.Status, status)
+	}
+
+	slot.Status = status
+
+	if err := tx.Model(&slot).Update("status", status).Error; err != nil {
+		tx.Rollback()
+		return slot, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return slot, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return slot, 200, nil
+}
+
+func lockShowtime(tx *gorm.DB, movieTimeSlotID uint) (models.MovieTimeSlot, int, error) {
+	var slot models.MovieTimeSlot
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&slot, movieTimeSlotID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return slot, 404, errors.New("movie time slot not found")
+	}
+
+	if result.Error != nil {
+		return slot, 500, result.Error
+	}
+
+	return slot, 200, nil
+}
+
+// checkOnSale refuses to sell seats of a show that is not on sale
+func checkOnSale(tx *gorm.DB, movieTimeSlotID uint) (int, error) {
+	var slot models.MovieTimeSlot
+
+	result := tx.Select("id", "status").First(&slot, movieTimeSlotID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return 404, errors.New("movie time slot not found")
+	}
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	if slot.Status != ShowtimeOnSale {
+		return 409, fmt.Errorf("show is %s, seats cannot be sold", strings.ToLower(strings.ReplaceAll(slot.Status, "_", " ")))
+	}
+
+	return 200, nil
+}
+
+// refreshSoldOut marks an on sale show sold out once every seat is sold, the show is locked so two last sales cannot miss each other
+func refreshSoldOut(tx *gorm.DB, movieTimeSlotID uint) error {
+	slot, _, err := lockShowtime(tx, movieTimeSlotID)
+
+	if err != nil {
+		return err
+	}
+
+	unsold, err := unsoldSeats(tx, slot)
+
+	if err != nil || unsold > 0 {
+		return err
+	}
+
+	return tx.Model(&models.MovieTimeSlot{}).
+		Where("id = ? AND status = ?", movieTimeSlotID, ShowtimeOnSale).
+		Update("status", ShowtimeSoldOut).Error
+}
+
+// unsoldSeats counts the seats of a show that are not sold yet, held seats count as unsold and blocked seats do not
+func unsoldSeats(tx *gorm.DB, slot models.MovieTimeSlot) (int64, error) {
+	var unsold int64
+
+	err := tx.Model(&models.BookedSeats{}).
+		Where("movie_time_slot_id = ? AND (is_booked = false OR locked_until IS NOT NULL)", slot.ID).
+		Where("seat_matrix_id NOT IN (?)", seatBlocksCovering(tx, slot).Select("seat_matrix_id")).
+		Count(&unsold).Error
+
+	return unsold, err
+}
+
+// CompleteEndedShowtimes marks the shows that ended as completed and returns how many there were
+func (m *MovieDB) CompleteEndedShowtimes() (int64, int, error) {
+	result := m.DB.Conn.Model(&models.MovieTimeSlot{}).
+		Where("end_time < ? AND status IN ?", time.Now(), []string{ShowtimeScheduled, ShowtimeOnSale, ShowtimeSoldOut}).
+		Update("status", ShowtimeCompleted)
+
+	if result.Error != nil {
+		return 0, 500, result.Error
+	}
+
+	return result.RowsAffected, 200, nil
+}
+
+// CancelShowtimeResult is what cancelling a show touched
+type CancelShowtimeResult struct {
+	MovieTimeSlot models.MovieTimeSlot
+	RefundTickets []models.Ticket      // Tickets marked for refund
+	ReleasedSeats []models.BookedSeats // Seats whose checkout was still in progress
+	Notified      int                  // Cancellation mails queued
+}
+
+/*
+CancelShowtime cancels a show, keeping it and its seats for history
+
+	movieTimeSlotID: the show to cancel
+	reason: told to the customers, e.g. technical fault
+
+Sales stop straight away, checkouts in progress lose their seats and every ticket of the show is marked for refund.
+Ticket holders are mailed after the commit, a failure to queue a mail is only logged
+*/
+func (m *MovieDB) CancelShowtime(movieTimeSlotID uint, reason string) (CancelShowtimeResult, int, error) {
+	result := CancelShowtimeResult{}
+
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Seats are locked before the show like CreateTicket does, so a last sale and a cancellation cannot deadlock
+
+	var seats []models.BookedSeats
+
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("movie_time_slot_id = ?", movieTimeSlotID).Order("id").Find(&seats).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	slot, status, err := lockShowtime(tx, movieTimeSlotID)
+
+	if err != nil {
+		tx.Rollback()
+		return result, status, err
+	}
+
+	if slot.Status == ShowtimeCancelled || slot.Status == ShowtimeCompleted {
+		tx.Rollback()
+		return result, 409, fmt.Errorf("show is already %s", strings.ToLower(slot.Status))
+	}
+
+	held := make([]uint, 0)
+	sold := make(pq.Int32Array, 0)
+	emails := make(map[int32]string)
+
+	for _, seat := range seats {
+		if !seat.IsBooked {
+			continue
+		}
+
+		if seat.LockedUntil != nil {
+			held = append(held, seat.ID)
+			result.ReleasedSeats = append(result.ReleasedSeats, seat)
+			continue
+		}
+
+		sold = append(sold, int32(seat.ID))
+
+		if seat.Email != nil {
+			emails[int32(seat.ID)] = *seat.Email
+		}
+	}
+
+	if len(held) > 0 {
+		err := tx.Model(&models.BookedSeats{}).Where("id IN ?", held).Updates(map[string]any{
+			"is_booked":       false,
+			"locked_until":    nil,
+			"locked_by":       nil,
+			"hold_token":      nil,
+			"hold_extensions": 0,
+			"email":           nil,
+			"phone_number":    "",
+		}).Error
+
+		if err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+	}
+
+	if len(sold) > 0 {
+		if err := tx.Where("booked_seats_id && ?", sold).Order("id").Find(&result.RefundTickets).Error; err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+	}
+
+	if len(result.RefundTickets) > 0 {
+		ids := make([]uint, 0, len(result.RefundTickets))
+
+		for i := range result.RefundTickets {
+			ids = append(ids, result.RefundTickets[i].ID)
+			result.RefundTickets[i].RefundStatus = TicketRefundPending
+		}
+
+		if err := tx.Model(&models.Ticket{}).Where("id IN ?", ids).Update("refund_status", TicketRefundPending).Error; err != nil {
+			tx.Rollback()
+			return result, 500, err
+		}
+	}
+
+	now := time.Now()
+
+	slot.Status = ShowtimeCancelled
+	slot.CancelledAt = &now
+	slot.CancellationReason = reason
+
+	if err := tx.Model(&slot).Updates(map[string]any{
+		"status":              slot.Status,
+		"cancelled_at":        slot.CancelledAt,
+		"cancellation_reason": slot.CancellationReason,
+	}).Error; err != nil {
+		tx.Rollback()
+		return result, 500, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return result, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	result.MovieTimeSlot = slot
+
+	m.publishSeatsReleased(result.ReleasedSeats, "SHOW_CANCELLED")
+	result.Notified = m.sendCancellationMails(slot, seats, result.RefundTickets, emails)
+
+	return result, 200, nil
+}
+
+// sendCancellationMails queues one mail per customer listing all of their seats, it returns how many were queued
+func (m *MovieDB) sendCancellationMails(slot models.MovieTimeSlot, seats []models.BookedSeats, tickets []models.Ticket, emails map[int32]string) int {
+	if m.Producer == nil || len(tickets) == 0 {
+		return 0
+	}
+
+	seatNumbers := make(map[int32]string, len(seats))
+
+	for _, seat := range seats {
+		seatNumbers[int32(seat.ID)] = seat.SeatNumber
+	}
+
+	customerSeats := make(map[string][]string)
+	order := make([]string, 0)
+
+	for _, ticket := range tickets {
+		for _, seatID := range ticket.BookedSeatsID {
+			email, ok := emails[seatID]
+
+			if !ok {
+				continue
+			}
+
+			if _, seen := customerSeats[email]; !seen {
+				order = append(order, email)
+			}
+
+			customerSeats[email] = append(customerSeats[email], seatNumbers[seatID])
+		}
+	}
+
+	var movie models.Movie
+	var venue models.Venue
+
+	m.DB.Conn.Select("id", "title").First(&movie, slot.MovieID)
+	m.DB.Conn.Select("id", "name").First(&venue, slot.VenueID)
+
+	loc, err := venueTimezone(m.DB.Conn, slot.VenueID)
+
+	if err != nil {
+		loc = time.UTC
+	}
+
+	sent := 0
+
+	for _, email := range order {
+		mail := CancellationMail(email, movie.Title, venue.Name, slot.StartTime.In(loc), customerSeats[email], slot.CancellationReason)
+
+		if err := m.Producer.Send_Mail_Producer(mail); err != nil {
+			log.Error("error queueing cancellation mail for movie time slot ", slot.ID, ": ", err)
+			continue
+		}
+
+		sent++
+	}
+
+	return sent
+}
+
+// CancellationMail is the mail telling a customer their show was cancelled, it is kept on one line as the mail service only accepts printable ASCII
+func CancellationMail(to string, movieTitle string, venueName string, startTime time.Time, seatNumbers []string, reason string) helper.SendMailStruct {
+	when := startTime.Format("Mon, 02 Jan 2006 15:04 MST")
+	seatList := strings.Join(seatNumbers, ", ")
+
+	text := fmt.Sprintf("The %s show of %s at %s has been cancelled. Your seats %s will be refunded to the original payment method.", when, movieTitle, venueName, seatList)
+	body := fmt.Sprintf("<p>The %s show of <b>%s</b> at %s has been cancelled.</p><p>Your seats %s will be refunded to the original payment method.</p>",
+		html.EscapeString(when), html.EscapeString(movieTitle), html.EscapeString(venueName), html.EscapeString(seatList))
+
+	if reason != "" {
+		text += " Reason: " + reason
+		body += "<p>Reason: " + html.EscapeString(reason) + "</p>"
+	}
+
+	return helper.SendMailStruct{
+		To:       to,
+		Name:     venueName,
+		Subject:  fmt.Sprintf("Your show of %s has been cancelled", movieTitle),
+		Text:     text,
+		Html:     body,
+		Category: "Show Cancellation",
+	}
+}
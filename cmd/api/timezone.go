@@ -0,0 +1,107 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+// releaseDateSQL is the release day of a movie, release dates are calendar days stored at midnight UTC
+const releaseDateSQL = "(movies.release_date AT TIME ZONE 'UTC')::date"
+
+// localDateSQL is the calendar day a timestamp column falls on in the time zone named by timezoneColumn,
+// so a show starting at 00:30 in Mumbai is on that day even though it is still the day before in UTC
+func localDateSQL(column string, timezoneColumn string) string {
+	return fmt.Sprintf("(%s AT TIME ZONE %s)::date", column, timezoneColumn)
+}
+
+// localTodaySQL is the current calendar day in the time zone named by timezoneColumn
+func localTodaySQL(timezoneColumn string) string {
+	return localDateSQL("now()", timezoneColumn)
+}
+
+// LoadTimezone loads an IANA time zone, empty is UTC
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %s", name)
+	}
+
+	return loc, nil
+}
+
+// parseDateRange parses two "YYYY-MM-DD" days, the end day is included
+func parseDateRange(startDate string, endDate string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.DateOnly, startDate)
+
+	if err != nil {
+		return start, start, fmt.Errorf("invalid start date %s, expected YYYY-MM-DD", startDate)
+	}
+
+	end, err := time.Parse(time.DateOnly, endDate)
+
+	if err != nil {
+		return start, end, fmt.Errorf("invalid end date %s, expected YYYY-MM-DD", endDate)
+	}
+
+	if end.Before(start) {
+		return start, end, fmt.Errorf("end date %s is before the start date %s", endDate, startDate)
+	}
+
+	return start, end, nil
+}
+
+// localizeShowtimes moves the start and end time of every show to the time zone of its venue,
+// the instants stay the same so they can still be given out in UTC
+func (m *MovieDB) localizeShowtimes(slots []models.MovieTimeSlot) error {
+	if len(slots) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(slots))
+	seen := make(map[uint]bool, len(slots))
+
+	for _, slot := range slots {
+		if !seen[slot.VenueID] {
+			seen[slot.VenueID] = true
+			ids = append(ids, slot.VenueID)
+		}
+	}
+
+	var venues []models.Venue
+
+	if err := m.DB.Conn.Select("id", "timezone").Where("id IN ?", ids).Find(&venues).Error; err != nil {
+		return err
+	}
+
+	locations := make(map[uint]*time.Location, len(venues))
+
+	for _, venue := range venues {
+		loc, err := LoadTimezone(venue.Timezone)
+
+		if err != nil {
+			return err
+		}
+
+		locations[venue.ID] = loc
+	}
+
+	for i := range slots {
+		loc, ok := locations[slots[i].VenueID]
+
+		if !ok {
+			loc = time.UTC
+		}
+
+		slots[i].StartTime = slots[i].StartTime.In(loc)
+		slots[i].EndTime = slots[i].EndTime.In(loc)
+	}
+
+	return nil
+}
@@ -0,0 +1,439 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Kinds of the cells of a seat map, every cell that is neither a seat nor a LayoutCell is EMPTY
+const (
+	LayoutCellSeat       = "SEAT"
+	LayoutCellAisle      = "AISLE"
+	LayoutCellStairs     = "STAIRS"
+	LayoutCellPillar     = "PILLAR"
+	LayoutCellWheelchair = "WHEELCHAIR"
+	LayoutCellEmpty      = "EMPTY"
+)
+
+// Sides of the seat map the screen can be on
+const (
+	ScreenPositionTop    = "TOP"
+	ScreenPositionBottom = "BOTTOM"
+)
+
+var layoutCellKinds = map[string]bool{
+	LayoutCellAisle:      true,
+	LayoutCellStairs:     true,
+	LayoutCellPillar:     true,
+	LayoutCellWheelchair: true,
+	LayoutCellEmpty:      true,
+}
+
+// GridCell is one cell of the seat map, Seat is set for a SEAT and Section when the cell lies in one
+type GridCell struct {
+	Row     int
+	Column  int
+	Kind    string
+	Seat    *models.SeatMatrix
+	Section string
+}
+
+// GridRow is a row of the seat map with every one of its cells from column 1 on
+type GridRow struct {
+	Row   int
+	Label string
+	Cells []GridCell
+}
+
+// VenueLayout is the full seat map of a venue, ready to be drawn
+type VenueLayout struct {
+	Venue          models.Venue
+	ScreenPosition string
+	CurvedRows     bool
+	Rows           []GridRow
+	Sections       []models.SeatSection
+}
+
+// RowLabel is the label of a row, the given label or A to Z, then AA, AB and so on
+func RowLabel(row int, labels []string) string {
+	if row <= len(labels) && labels[row-1] != "" {
+		return labels[row-1]
+	}
+
+	label := ""
+
+	for n := row; n > 0; n = (n - 1) / 26 {
+		label = string(rune('A'+(n-1)%26)) + label
+	}
+
+	return label
+}
+
+func insideVenue(venue models.Venue, row int, column int) bool {
+	return row >= 1 && row <= venue.Rows && column >= 1 && column <= venue.Columns
+}
+
+// ValidateSeatPositions checks that every seat lies inside the Rows x Columns grid of the venue and that no two seats share a cell
+func ValidateSeatPositions(venue models.Venue, seats []models.SeatMatrix) error {
+	taken := make(map[[2]int]string, len(seats))
+
+	for _, seat := range seats {
+		if !insideVenue(venue, seat.Row, seat.Column) {
+			return fmt.Errorf("seat %s at row %d column %d is outside the %d x %d seat map of venue %d",
+				seat.SeatNumber, seat.Row, seat.Column, venue.Rows, venue.Columns, venue.ID)
+		}
+
+		cell := [2]int{seat.Row, seat.Column}
+
+		if other, ok := taken[cell]; ok {
+			return fmt.Errorf("seats %s and %s are both at row %d column %d", other, seat.SeatNumber, seat.Row, seat.Column)
+		}
+
+		taken[cell] = seat.SeatNumber
+	}
+
+	return nil
+}
+
+/*
+ValidateVenueLayout checks a seat map before it is saved
+
+	venue: the venue with its screen position, row labels, layout cells and sections
+	seats: the seats of the venue, they have to fit the grid and cannot share a cell with a layout cell
+*/
+func ValidateVenueLayout(venue models.Venue, seats []models.SeatMatrix) error {
+	if err := ValidateSeatPositions(venue, seats); err != nil {
+		return err
+	}
+
+	if venue.ScreenPosition != "" && venue.ScreenPosition != ScreenPositionTop && venue.ScreenPosition != ScreenPositionBottom {
+		return fmt.Errorf("screen position has to be %s or %s", ScreenPositionTop, ScreenPositionBottom)
+	}
+
+	if len(venue.RowLabels) > venue.Rows {
+		return fmt.Errorf("%d row labels given for %d rows", len(venue.RowLabels), venue.Rows)
+	}
+
+	labels := make(map[string]bool, venue.Rows)
+
+	for row := 1; row <= venue.Rows; row++ {
+		label := RowLabel(row, venue.RowLabels)
+
+		if labels[label] {
+			return fmt.Errorf("row label %s is used twice", label)
+		}
+
+		labels[label] = true
+	}
+
+	seatCells := make(map[[2]int]string, len(seats))
+
+	for _, seat := range seats {
+		seatCells[[2]int{seat.Row, seat.Column}] = seat.SeatNumber
+	}
+
+	cells := make(map[[2]int]bool, len(venue.LayoutCells))
+
+	for _, cell := range venue.LayoutCells {
+		if !layoutCellKinds[cell.Kind] {
+			return fmt.Errorf("unknown layout cell kind %s", cell.Kind)
+		}
+
+		if !insideVenue(venue, cell.Row, cell.Column) {
+			return fmt.Errorf("%s at row %d column %d is outside the %d x %d seat map", strings.ToLower(cell.Kind), cell.Row, cell.Column, venue.Rows, venue.Columns)
+		}
+
+		position := [2]int{cell.Row, cell.Column}
+
+		if seat, ok := seatCells[position]; ok {
+			return fmt.Errorf("%s at row %d column %d is on seat %s", strings.ToLower(cell.Kind), cell.Row, cell.Column, seat)
+		}
+
+		if cells[position] {
+			return fmt.Errorf("row %d column %d is given twice", cell.Row, cell.Column)
+		}
+
+		cells[position] = true
+	}
+
+	for _, section := range venue.Sections {
+		if section.Name == "" {
+			return errors.New("section name is required")
+		}
+
+		if section.FirstRow > section.LastRow || section.FirstColumn > section.LastColumn ||
+			!insideVenue(venue, section.FirstRow, section.FirstColumn) || !insideVenue(venue, section.LastRow, section.LastColumn) {
+			return fmt.Errorf("section %s is not a rectangle inside the %d x %d seat map", section.Name, venue.Rows, venue.Columns)
+		}
+	}
+
+	return nil
+}
+
+// BuildVenueLayout lays out the seats, layout cells and sections of a venue on its full grid
+func BuildVenueLayout(venue models.Venue, seats []models.SeatMatrix) VenueLayout {
+	layout := VenueLayout{
+		Venue:          venue,
+		ScreenPosition: venue.ScreenPosition,
+		CurvedRows:     venue.CurvedRows,
+		Rows:           make([]GridRow, 0, venue.Rows),
+		Sections:       venue.Sections,
+	}
+
+	if layout.ScreenPosition == "" {
+		layout.ScreenPosition = ScreenPositionTop
+	}
+
+	for row := 1; row <= venue.Rows; row++ {
+		gridRow := GridRow{Row: row, Label: RowLabel(row, venue.RowLabels), Cells: make([]GridCell, 0, venue.Columns)}
+
+		for column := 1; column <= venue.Columns; column++ {
+			gridRow.Cells = append(gridRow.Cells, GridCell{Row: row, Column: column, Kind: LayoutCellEmpty})
+		}
+
+		layout.Rows = append(layout.Rows, gridRow)
+	}
+
+	cell := func(row int, column int) *GridCell {
+		if !insideVenue(venue, row, column) {
+			return nil
+		}
+
+		return &layout.Rows[row-1].Cells[column-1]
+	}
+
+	for _, layoutCell := range venue.LayoutCells {
+		if c := cell(layoutCell.Row, layoutCell.Column); c != nil {
+			c.Kind = layoutCell.Kind
+		}
+	}
+
+	for i := range seats {
+		if c := cell(seats[i].Row, seats[i].Column); c != nil {
+			c.Kind = LayoutCellSeat
+			c.Seat = &seats[i]
+		}
+	}
+
+	// A later section wins where two of them overlap
+
+	for _, section := range venue.Sections {
+		for row := section.FirstRow; row <= section.LastRow; row++ {
+			for column := section.FirstColumn; column <= section.LastColumn; column++ {
+				if c := cell(row, column); c != nil {
+					c.Section = section.Name
+				}
+			}
+		}
+	}
+
+	return layout
+}
+
+// GetVenueLayout returns the full seat map of a venue with its seats, aisles and other cells, row labels and sections
+func (m *MovieDB) GetVenueLayout(venueID uint) (VenueLayout, int, error) {
+	var venue models.Venue
+
+	result := m.DB.Conn.Preload("LayoutCells").Preload("Sections", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return VenueLayout{}, 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		return VenueLayout{}, 500, result.Error
+	}
+
+	var seats []models.SeatMatrix
+
+	result = m.DB.Conn.Where("venue_id = ?", venueID).Order("\"row\" ASC").Order("\"column\" ASC").Find(&seats)
+
+	if result.Error != nil {
+		return VenueLayout{}, 500, result.Error
+	}
+
+	return BuildVenueLayout(venue, seats), 200, nil
+}
+
+/*
+SetVenueLayout replaces the non-seat cells, sections, row labels and screen position of a venue
+
+	layout: ScreenPosition, CurvedRows, RowLabels, LayoutCells and Sections are used, the seats stay as they are
+*/
+func (m *MovieDB) SetVenueLayout(venueID uint, layout models.Venue) (VenueLayout, int, error) {
+	tx := m.DB.Conn.Begin()
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var venue models.Venue
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return VenueLayout{}, 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, result.Error
+	}
+
+	var seats []models.SeatMatrix
+
+	if err := tx.Where("venue_id = ?", venueID).Find(&seats).Error; err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	venue.ScreenPosition = layout.ScreenPosition
+	venue.CurvedRows = layout.CurvedRows
+	venue.RowLabels = layout.RowLabels
+	venue.LayoutCells = layout.LayoutCells
+	venue.Sections = layout.Sections
+
+	if venue.ScreenPosition == "" {
+		venue.ScreenPosition = ScreenPositionTop
+	}
+
+	if err := ValidateVenueLayout(venue, seats); err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 400, err
+	}
+
+	err := tx.Model(&models.Venue{}).Where("id = ?", venueID).Updates(map[string]any{
+		"screen_position": venue.ScreenPosition,
+		"curved_rows":     venue.CurvedRows,
+		"row_labels":      venue.RowLabels,
+	}).Error
+
+	if err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if err := tx.Unscoped().Where("venue_id = ?", venueID).Delete(&models.LayoutCell{}).Error; err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	if err := tx.Unscoped().Where("venue_id = ?", venueID).Delete(&models.SeatSection{}).Error; err != nil {
+		tx.Rollback()
+		return VenueLayout{}, 500, err
+	}
+
+	for i := range venue.LayoutCells {
+		venue.LayoutCells[i].ID = 0
+		venue.LayoutCells[i].VenueID = venueID
+	}
+
+	for i := range venue.Sections {
+		venue.Sections[i].ID = 0
+		venue.Sections[i].VenueID = venueID
+	}
+
+	if len(venue.LayoutCells) > 0 {
+		if err := tx.Create(&venue.LayoutCells).Error; err != nil {
+			tx.Rollback()
+			return VenueLayout{}, 500, err
+		}
+	}
+
+	if len(venue.Sections) > 0 {
+		if err := tx.Create(&venue.Sections).Error; err != nil {
+			tx.Rollback()
+			return VenueLayout{}, 500, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return VenueLayout{}, 500, fmt.Errorf("commit error: %v", err)
+	}
+
+	return BuildVenueLayout(venue, seats), 200, nil
+}
+
+// checkSeatPositions validates seats about to be added to or moved within a venue against the seats and cells it already has
+func checkSeatPositions(db *gorm.DB, venueID uint, seats []models.SeatMatrix) (int, error) {
+	var venue models.Venue
+
+	result := db.Preload("LayoutCells").First(&venue, venueID)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return 404, fmt.Errorf("venue %d not found", venueID)
+	}
+
+	if result.Error != nil {
+		return 500, result.Error
+	}
+
+	var existing []models.SeatMatrix
+
+	if err := db.Where("venue_id = ?", venueID).Find(&existing).Error; err != nil {
+		return 500, err
+	}
+
+	moved := make(map[uint]bool, len(seats))
+
+	for _, seat := range seats {
+		if seat.ID != 0 {
+			moved[seat.ID] = true
+		}
+	}
+
+	all := append([]models.SeatMatrix{}, seats...)
+
+	for _, seat := range existing {
+		if !moved[seat.ID] {
+			all = append(all, seat)
+		}
+	}
+
+	if err := ValidateVenueLayout(venue, all); err != nil {
+		return 400, err
+	}
+
+	return 200, nil
+}
+
+// checkVenueResize makes sure the seats, cells and sections of a venue still fit when its grid is resized, 0 keeps a size
+func checkVenueResize(db *gorm.DB, venue models.Venue, rows int, columns int) (int, error) {
+	if err := db.Model(&venue).Association("LayoutCells").Find(&venue.LayoutCells); err != nil {
+		return 500, err
+	}
+
+	if err := db.Model(&venue).Association("Sections").Find(&venue.Sections); err != nil {
+		return 500, err
+	}
+
+	var seats []models.SeatMatrix
+
+	if err := db.Where("venue_id = ?", venue.ID).Find(&seats).Error; err != nil {
+		return 500, err
+	}
+
+	if rows != 0 {
+		venue.Rows = rows
+	}
+
+	if columns != 0 {
+		venue.Columns = columns
+	}
+
+	if err := ValidateVenueLayout(venue, seats); err != nil {
+		return 400, err
+	}
+
+	return 200, nil
+}
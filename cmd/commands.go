@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+)
+
+// commands run instead of the server when their name is the first argument
+var commands = map[string]func(args []string) error{
+	"import-seats": importSeatsCommand,
+	"export-seats": exportSeatsCommand,
+}
+
+func newCommandMovieDB() (*api.MovieDB, error) {
+	DB, err := helper.ConnectToDB()
+
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to database: %v", err)
+	}
+
+	moviedbObj := api.NewMovieDB()
+	moviedbObj.DB.Conn = DB
+
+	return moviedbObj, nil
+}
+
+/*
+importSeatsCommand creates the seats of a venue from a grid file
+
+	moviedb import-seats -venue 3 -file screen3.txt -prices VIP=45000,NORMAL=25000 [-currency INR] [-format csv] [-dry-run]
+*/
+func importSeatsCommand(args []string) error {
+	flags := flag.NewFlagSet("import-seats", flag.ContinueOnError)
+
+	venueID := flags.Uint("venue", 0, "ID of the venue")
+	file := flags.String("file", "-", "grid file, - reads standard input")
+	format := flags.String("format", api.SeatGridText, "TEXT or CSV")
+	prices := flags.String("prices", "", "price by seat type in minor units, e.g. VIP=45000,NORMAL=25000 for 450 and 250 rupees")
+	currency := flags.String("currency", "", "currency of the prices, empty for the currency of the venue")
+	dryRun := flags.Bool("dry-run", false, "validate the grid without writing it")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *venueID == 0 {
+		return fmt.Errorf("-venue is required")
+	}
+
+	seatPrices, err := api.ParseSeatPrices(*prices)
+
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+
+	if *file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(*file)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	m, err := newCommandMovieDB()
+
+	if err != nil {
+		return err
+	}
+
+	layout, _, err := m.ImportSeatGrid(*venueID, string(data), *format, seatPrices, *currency, *dryRun)
+
+	if err != nil {
+		return err
+	}
+
+	seats := 0
+
+	for _, row := range layout.Rows {
+		for _, cell := range row.Cells {
+			if cell.Seat != nil {
+				seats++
+			}
+		}
+	}
+
+	if *dryRun {
+		fmt.Printf("grid is valid, %d seats in %d rows and %d columns\n", seats, layout.Venue.Rows, layout.Venue.Columns)
+		return nil
+	}
+
+	fmt.Printf("imported %d seats in %d rows and %d columns\n", seats, layout.Venue.Rows, layout.Venue.Columns)
+
+	return nil
+}
+
+/*
+exportSeatsCommand writes the seats of a venue as a grid to standard output, the prices go to standard error
+
+	moviedb export-seats -venue 3 [-format csv] > screen3.txt
+*/
+func exportSeatsCommand(args []string) error {
+	flags := flag.NewFlagSet("export-seats", flag.ContinueOnError)
+
+	venueID := flags.Uint("venue", 0, "ID of the venue")
+	format := flags.String("format", api.SeatGridText, "TEXT or CSV")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *venueID == 0 {
+		return fmt.Errorf("-venue is required")
+	}
+
+	m, err := newCommandMovieDB()
+
+	if err != nil {
+		return err
+	}
+
+	data, prices, currency, _, err := m.ExportSeatGrid(*venueID, *format)
+
+	if err != nil {
+		return err
+	}
+
+	pairs := make([]string, 0, len(prices))
+
+	for seatType, price := range prices {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", seatType, price))
+	}
+
+	sort.Strings(pairs)
+
+	fmt.Print(data)
+	fmt.Fprintf(os.Stderr, "prices: %s\ncurrency: %s\n", strings.Join(pairs, ","), currency)
+
+	return nil
+}
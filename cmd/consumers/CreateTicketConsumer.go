@@ -92,7 +92,7 @@ func (c *Consumer) Send_Mail_Consumer() error {
 				}
 			}
 
-			fmt.Printf(string(d.Body))
+			fmt.Printf("%s\n", d.Body)
 
 			// Attempt to send mail
 			if err := helper.SendMail(msg); err != nil {
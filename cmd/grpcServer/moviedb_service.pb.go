@@ -7,7 +7,6 @@
 package moviedb
 
 import (
-	empty "github.com/golang/protobuf/ptypes/empty"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -77,6 +76,112 @@ func (SeatType) EnumDescriptor() ([]byte, []int) {
 	return file_moviedb_service_proto_rawDescGZIP(), []int{0}
 }
 
+// Format of a show, values 0 to 2 match the SeatType values shows used before
+type MovieFormat int32
+
+const (
+	MovieFormat_FORMAT_2D    MovieFormat = 0
+	MovieFormat_FORMAT_3D    MovieFormat = 1
+	MovieFormat_FORMAT_4DX   MovieFormat = 2
+	MovieFormat_FORMAT_IMAX  MovieFormat = 3
+	MovieFormat_FORMAT_DOLBY MovieFormat = 4
+)
+
+// Enum value maps for MovieFormat.
+var (
+	MovieFormat_name = map[int32]string{
+		0: "FORMAT_2D",
+		1: "FORMAT_3D",
+		2: "FORMAT_4DX",
+		3: "FORMAT_IMAX",
+		4: "FORMAT_DOLBY",
+	}
+	MovieFormat_value = map[string]int32{
+		"FORMAT_2D":    0,
+		"FORMAT_3D":    1,
+		"FORMAT_4DX":   2,
+		"FORMAT_IMAX":  3,
+		"FORMAT_DOLBY": 4,
+	}
+)
+
+func (x MovieFormat) Enum() *MovieFormat {
+	p := new(MovieFormat)
+	*p = x
+	return p
+}
+
+func (x MovieFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovieFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MovieFormat) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[1]
+}
+
+func (x MovieFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovieFormat.Descriptor instead.
+func (MovieFormat) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{1}
+}
+
+// How a venue rounds the prices it charges to a multiple of its price_rounding
+type RoundingMode int32
+
+const (
+	RoundingMode_ROUND_HALF_UP RoundingMode = 0
+	RoundingMode_ROUND_UP      RoundingMode = 1
+	RoundingMode_ROUND_DOWN    RoundingMode = 2
+)
+
+// Enum value maps for RoundingMode.
+var (
+	RoundingMode_name = map[int32]string{
+		0: "ROUND_HALF_UP",
+		1: "ROUND_UP",
+		2: "ROUND_DOWN",
+	}
+	RoundingMode_value = map[string]int32{
+		"ROUND_HALF_UP": 0,
+		"ROUND_UP":      1,
+		"ROUND_DOWN":    2,
+	}
+)
+
+func (x RoundingMode) Enum() *RoundingMode {
+	p := new(RoundingMode)
+	*p = x
+	return p
+}
+
+func (x RoundingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoundingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[2].Descriptor()
+}
+
+func (RoundingMode) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[2]
+}
+
+func (x RoundingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoundingMode.Descriptor instead.
+func (RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{2}
+}
+
 type CastAndCrewType int32
 
 const (
@@ -176,11 +281,11 @@ func (x CastAndCrewType) String() string {
 }
 
 func (CastAndCrewType) Descriptor() protoreflect.EnumDescriptor {
-	return file_moviedb_service_proto_enumTypes[1].Descriptor()
+	return file_moviedb_service_proto_enumTypes[3].Descriptor()
 }
 
 func (CastAndCrewType) Type() protoreflect.EnumType {
-	return &file_moviedb_service_proto_enumTypes[1]
+	return &file_moviedb_service_proto_enumTypes[3]
 }
 
 func (x CastAndCrewType) Number() protoreflect.EnumNumber {
@@ -189,7 +294,7 @@ func (x CastAndCrewType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CastAndCrewType.Descriptor instead.
 func (CastAndCrewType) EnumDescriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{1}
+	return file_moviedb_service_proto_rawDescGZIP(), []int{3}
 }
 
 type VenueType int32
@@ -228,11 +333,11 @@ func (x VenueType) String() string {
 }
 
 func (VenueType) Descriptor() protoreflect.EnumDescriptor {
-	return file_moviedb_service_proto_enumTypes[2].Descriptor()
+	return file_moviedb_service_proto_enumTypes[4].Descriptor()
 }
 
 func (VenueType) Type() protoreflect.EnumType {
-	return &file_moviedb_service_proto_enumTypes[2]
+	return &file_moviedb_service_proto_enumTypes[4]
 }
 
 func (x VenueType) Number() protoreflect.EnumNumber {
@@ -241,7 +346,56 @@ func (x VenueType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VenueType.Descriptor instead.
 func (VenueType) EnumDescriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{2}
+	return file_moviedb_service_proto_rawDescGZIP(), []int{4}
+}
+
+type DistanceUnit int32
+
+const (
+	DistanceUnit_KILOMETERS DistanceUnit = 0
+	DistanceUnit_MILES      DistanceUnit = 1
+	DistanceUnit_METERS     DistanceUnit = 2
+)
+
+// Enum value maps for DistanceUnit.
+var (
+	DistanceUnit_name = map[int32]string{
+		0: "KILOMETERS",
+		1: "MILES",
+		2: "METERS",
+	}
+	DistanceUnit_value = map[string]int32{
+		"KILOMETERS": 0,
+		"MILES":      1,
+		"METERS":     2,
+	}
+)
+
+func (x DistanceUnit) Enum() *DistanceUnit {
+	p := new(DistanceUnit)
+	*p = x
+	return p
+}
+
+func (x DistanceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DistanceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[5].Descriptor()
+}
+
+func (DistanceUnit) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[5]
+}
+
+func (x DistanceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DistanceUnit.Descriptor instead.
+func (DistanceUnit) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{5}
 }
 
 type SortBy int32
@@ -274,11 +428,11 @@ func (x SortBy) String() string {
 }
 
 func (SortBy) Descriptor() protoreflect.EnumDescriptor {
-	return file_moviedb_service_proto_enumTypes[3].Descriptor()
+	return file_moviedb_service_proto_enumTypes[6].Descriptor()
 }
 
 func (SortBy) Type() protoreflect.EnumType {
-	return &file_moviedb_service_proto_enumTypes[3]
+	return &file_moviedb_service_proto_enumTypes[6]
 }
 
 func (x SortBy) Number() protoreflect.EnumNumber {
@@ -287,7 +441,7 @@ func (x SortBy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortBy.Descriptor instead.
 func (SortBy) EnumDescriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{3}
+	return file_moviedb_service_proto_rawDescGZIP(), []int{6}
 }
 
 type FilterBy int32
@@ -320,11 +474,11 @@ func (x FilterBy) String() string {
 }
 
 func (FilterBy) Descriptor() protoreflect.EnumDescriptor {
-	return file_moviedb_service_proto_enumTypes[4].Descriptor()
+	return file_moviedb_service_proto_enumTypes[7].Descriptor()
 }
 
 func (FilterBy) Type() protoreflect.EnumType {
-	return &file_moviedb_service_proto_enumTypes[4]
+	return &file_moviedb_service_proto_enumTypes[7]
 }
 
 func (x FilterBy) Number() protoreflect.EnumNumber {
@@ -333,179 +487,6695 @@ func (x FilterBy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FilterBy.Descriptor instead.
 func (FilterBy) EnumDescriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{4}
-}
-
-type SeatMatrix struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	SeatNumber string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	// Deprecated: Marked as deprecated in moviedb_service.proto.
-	IsBooked      bool     `protobuf:"varint,2,opt,name=is_booked,json=isBooked,proto3" json:"is_booked,omitempty"`
-	Price         int32    `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Row           int32    `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
-	Column        int32    `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
-	Type          SeatType `protobuf:"varint,6,opt,name=type,proto3,enum=moviedb_service.SeatType" json:"type,omitempty"`
-	Id            int32    `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SeatMatrix) Reset() {
-	*x = SeatMatrix{}
-	mi := &file_moviedb_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return file_moviedb_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SeatMatrix) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+// What a seat matrix change does when a seat it touches is sold or held for an upcoming show
+type SeatChangeMode int32
 
-func (*SeatMatrix) ProtoMessage() {}
+const (
+	// Refuse the change
+	SeatChangeMode_SEAT_CHANGE_REJECT SeatChangeMode = 0
+	// Change the seat for the shows where it is unsold, shows that sold it keep it as it was
+	SeatChangeMode_SEAT_CHANGE_UNSOLD_SHOWS SeatChangeMode = 1
+)
 
-func (x *SeatMatrix) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Enum value maps for SeatChangeMode.
+var (
+	SeatChangeMode_name = map[int32]string{
+		0: "SEAT_CHANGE_REJECT",
+		1: "SEAT_CHANGE_UNSOLD_SHOWS",
 	}
-	return mi.MessageOf(x)
+	SeatChangeMode_value = map[string]int32{
+		"SEAT_CHANGE_REJECT":       0,
+		"SEAT_CHANGE_UNSOLD_SHOWS": 1,
+	}
+)
+
+func (x SeatChangeMode) Enum() *SeatChangeMode {
+	p := new(SeatChangeMode)
+	*p = x
+	return p
 }
 
-// Deprecated: Use SeatMatrix.ProtoReflect.Descriptor instead.
-func (*SeatMatrix) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{0}
+func (x SeatChangeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SeatMatrix) GetSeatNumber() string {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return ""
+func (SeatChangeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[8].Descriptor()
 }
 
-// Deprecated: Marked as deprecated in moviedb_service.proto.
-func (x *SeatMatrix) GetIsBooked() bool {
-	if x != nil {
-		return x.IsBooked
-	}
-	return false
+func (SeatChangeMode) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[8]
 }
 
-func (x *SeatMatrix) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x SeatChangeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *SeatMatrix) GetRow() int32 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
+// Deprecated: Use SeatChangeMode.Descriptor instead.
+func (SeatChangeMode) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SeatMatrix) GetColumn() int32 {
-	if x != nil {
-		return x.Column
+type PriceRuleKind int32
+
+const (
+	// Replaces the price of the seat, the most specific matching rule wins
+	PriceRuleKind_RULE_PRICE PriceRuleKind = 0
+	// Added to the price of the seat, negative for a discount
+	PriceRuleKind_RULE_SURCHARGE PriceRuleKind = 1
+)
+
+// Enum value maps for PriceRuleKind.
+var (
+	PriceRuleKind_name = map[int32]string{
+		0: "RULE_PRICE",
+		1: "RULE_SURCHARGE",
 	}
-	return 0
+	PriceRuleKind_value = map[string]int32{
+		"RULE_PRICE":     0,
+		"RULE_SURCHARGE": 1,
+	}
+)
+
+func (x PriceRuleKind) Enum() *PriceRuleKind {
+	p := new(PriceRuleKind)
+	*p = x
+	return p
 }
 
-func (x *SeatMatrix) GetType() SeatType {
-	if x != nil {
-		return x.Type
-	}
-	return SeatType_TWO_D
+func (x PriceRuleKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *SeatMatrix) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (PriceRuleKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[9].Descriptor()
 }
 
-type AddSeatMatrixInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	Seats         []*SeatMatrix          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (PriceRuleKind) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[9]
 }
 
-func (x *AddSeatMatrixInput) Reset() {
-	*x = AddSeatMatrixInput{}
-	mi := &file_moviedb_service_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x PriceRuleKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AddSeatMatrixInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use PriceRuleKind.Descriptor instead.
+func (PriceRuleKind) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (*AddSeatMatrixInput) ProtoMessage() {}
+type TaxAppliesTo int32
 
-func (x *AddSeatMatrixInput) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// Charged on the price of the ticket
+	TaxAppliesTo_TAX_TICKET TaxAppliesTo = 0
+	// Charged on the convenience fee
+	TaxAppliesTo_TAX_FEE TaxAppliesTo = 1
+)
+
+// Enum value maps for TaxAppliesTo.
+var (
+	TaxAppliesTo_name = map[int32]string{
+		0: "TAX_TICKET",
+		1: "TAX_FEE",
 	}
-	return mi.MessageOf(x)
-}
+	TaxAppliesTo_value = map[string]int32{
+		"TAX_TICKET": 0,
+		"TAX_FEE":    1,
+	}
+)
 
-// Deprecated: Use AddSeatMatrixInput.ProtoReflect.Descriptor instead.
-func (*AddSeatMatrixInput) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{1}
+func (x TaxAppliesTo) Enum() *TaxAppliesTo {
+	p := new(TaxAppliesTo)
+	*p = x
+	return p
 }
 
-func (x *AddSeatMatrixInput) GetVenueid() int32 {
-	if x != nil {
-		return x.Venueid
-	}
-	return 0
+func (x TaxAppliesTo) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AddSeatMatrixInput) GetSeats() []*SeatMatrix {
-	if x != nil {
-		return x.Seats
-	}
-	return nil
+func (TaxAppliesTo) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[10].Descriptor()
 }
 
-type AddSeatMatrixResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (TaxAppliesTo) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[10]
 }
 
-func (x *AddSeatMatrixResponse) Reset() {
-	*x = AddSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x TaxAppliesTo) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *AddSeatMatrixResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use TaxAppliesTo.Descriptor instead.
+func (TaxAppliesTo) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (*AddSeatMatrixResponse) ProtoMessage() {}
+type SeatStatus int32
 
-func (x *AddSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[2]
+const (
+	SeatStatus_FREE    SeatStatus = 0
+	SeatStatus_HELD    SeatStatus = 1
+	SeatStatus_BOOKED  SeatStatus = 2
+	SeatStatus_BLOCKED SeatStatus = 3
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "FREE",
+		1: "HELD",
+		2: "BOOKED",
+		3: "BLOCKED",
+	}
+	SeatStatus_value = map[string]int32{
+		"FREE":    0,
+		"HELD":    1,
+		"BOOKED":  2,
+		"BLOCKED": 3,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[11].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[11]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{11}
+}
+
+type SeatBlockReason int32
+
+const (
+	SeatBlockReason_BLOCK_BROKEN SeatBlockReason = 0
+	// House seats and VIP holds kept back by the cinema
+	SeatBlockReason_BLOCK_HOUSE      SeatBlockReason = 1
+	SeatBlockReason_BLOCK_DISTANCING SeatBlockReason = 2
+	SeatBlockReason_BLOCK_CAMERA     SeatBlockReason = 3
+)
+
+// Enum value maps for SeatBlockReason.
+var (
+	SeatBlockReason_name = map[int32]string{
+		0: "BLOCK_BROKEN",
+		1: "BLOCK_HOUSE",
+		2: "BLOCK_DISTANCING",
+		3: "BLOCK_CAMERA",
+	}
+	SeatBlockReason_value = map[string]int32{
+		"BLOCK_BROKEN":     0,
+		"BLOCK_HOUSE":      1,
+		"BLOCK_DISTANCING": 2,
+		"BLOCK_CAMERA":     3,
+	}
+)
+
+func (x SeatBlockReason) Enum() *SeatBlockReason {
+	p := new(SeatBlockReason)
+	*p = x
+	return p
+}
+
+func (x SeatBlockReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatBlockReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[12].Descriptor()
+}
+
+func (SeatBlockReason) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[12]
+}
+
+func (x SeatBlockReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatBlockReason.Descriptor instead.
+func (SeatBlockReason) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{12}
+}
+
+type LayoutCellKind int32
+
+const (
+	LayoutCellKind_CELL_EMPTY      LayoutCellKind = 0
+	LayoutCellKind_CELL_SEAT       LayoutCellKind = 1
+	LayoutCellKind_CELL_AISLE      LayoutCellKind = 2
+	LayoutCellKind_CELL_STAIRS     LayoutCellKind = 3
+	LayoutCellKind_CELL_PILLAR     LayoutCellKind = 4
+	LayoutCellKind_CELL_WHEELCHAIR LayoutCellKind = 5
+)
+
+// Enum value maps for LayoutCellKind.
+var (
+	LayoutCellKind_name = map[int32]string{
+		0: "CELL_EMPTY",
+		1: "CELL_SEAT",
+		2: "CELL_AISLE",
+		3: "CELL_STAIRS",
+		4: "CELL_PILLAR",
+		5: "CELL_WHEELCHAIR",
+	}
+	LayoutCellKind_value = map[string]int32{
+		"CELL_EMPTY":      0,
+		"CELL_SEAT":       1,
+		"CELL_AISLE":      2,
+		"CELL_STAIRS":     3,
+		"CELL_PILLAR":     4,
+		"CELL_WHEELCHAIR": 5,
+	}
+)
+
+func (x LayoutCellKind) Enum() *LayoutCellKind {
+	p := new(LayoutCellKind)
+	*p = x
+	return p
+}
+
+func (x LayoutCellKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LayoutCellKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[13].Descriptor()
+}
+
+func (LayoutCellKind) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[13]
+}
+
+func (x LayoutCellKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LayoutCellKind.Descriptor instead.
+func (LayoutCellKind) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{13}
+}
+
+type ScreenPosition int32
+
+const (
+	ScreenPosition_SCREEN_TOP    ScreenPosition = 0
+	ScreenPosition_SCREEN_BOTTOM ScreenPosition = 1
+)
+
+// Enum value maps for ScreenPosition.
+var (
+	ScreenPosition_name = map[int32]string{
+		0: "SCREEN_TOP",
+		1: "SCREEN_BOTTOM",
+	}
+	ScreenPosition_value = map[string]int32{
+		"SCREEN_TOP":    0,
+		"SCREEN_BOTTOM": 1,
+	}
+)
+
+func (x ScreenPosition) Enum() *ScreenPosition {
+	p := new(ScreenPosition)
+	*p = x
+	return p
+}
+
+func (x ScreenPosition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreenPosition) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[14].Descriptor()
+}
+
+func (ScreenPosition) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[14]
+}
+
+func (x ScreenPosition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreenPosition.Descriptor instead.
+func (ScreenPosition) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{14}
+}
+
+type SeatGridFormat int32
+
+const (
+	SeatGridFormat_GRID_TEXT SeatGridFormat = 0
+	SeatGridFormat_GRID_CSV  SeatGridFormat = 1
+)
+
+// Enum value maps for SeatGridFormat.
+var (
+	SeatGridFormat_name = map[int32]string{
+		0: "GRID_TEXT",
+		1: "GRID_CSV",
+	}
+	SeatGridFormat_value = map[string]int32{
+		"GRID_TEXT": 0,
+		"GRID_CSV":  1,
+	}
+)
+
+func (x SeatGridFormat) Enum() *SeatGridFormat {
+	p := new(SeatGridFormat)
+	*p = x
+	return p
+}
+
+func (x SeatGridFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatGridFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_moviedb_service_proto_enumTypes[15].Descriptor()
+}
+
+func (SeatGridFormat) Type() protoreflect.EnumType {
+	return &file_moviedb_service_proto_enumTypes[15]
+}
+
+func (x SeatGridFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatGridFormat.Descriptor instead.
+func (SeatGridFormat) EnumDescriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{15}
+}
+
+// An amount in the minor units of its currency, e.g. 25050 INR is 250.50 rupees and 250 JPY is 250 yen
+type Money struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Amount int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 code, e.g. INR
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_moviedb_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type SeatMatrix struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SeatNumber string                 `protobuf:"bytes,1,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	IsBooked bool `protobuf:"varint,2,opt,name=is_booked,json=isBooked,proto3" json:"is_booked,omitempty"`
+	// Minor units of the currency of the venue, use price_money
+	//
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Price  int32    `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Row    int32    `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32    `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	Type   SeatType `protobuf:"varint,6,opt,name=type,proto3,enum=moviedb_service.SeatType" json:"type,omitempty"`
+	Id     int32    `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	// Takes the place of price when set, its currency has to be the one of the venue or empty
+	PriceMoney    *Money `protobuf:"bytes,8,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMatrix) Reset() {
+	*x = SeatMatrix{}
+	mi := &file_moviedb_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMatrix) ProtoMessage() {}
+
+func (x *SeatMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMatrix.ProtoReflect.Descriptor instead.
+func (*SeatMatrix) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeatMatrix) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *SeatMatrix) GetIsBooked() bool {
+	if x != nil {
+		return x.IsBooked
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *SeatMatrix) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SeatMatrix) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatMatrix) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *SeatMatrix) GetType() SeatType {
+	if x != nil {
+		return x.Type
+	}
+	return SeatType_TWO_D
+}
+
+func (x *SeatMatrix) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SeatMatrix) GetPriceMoney() *Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type AddSeatMatrixInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Seats         []*SeatMatrix          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSeatMatrixInput) Reset() {
+	*x = AddSeatMatrixInput{}
+	mi := &file_moviedb_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSeatMatrixInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSeatMatrixInput) ProtoMessage() {}
+
+func (x *AddSeatMatrixInput) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSeatMatrixInput.ProtoReflect.Descriptor instead.
+func (*AddSeatMatrixInput) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddSeatMatrixInput) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *AddSeatMatrixInput) GetSeats() []*SeatMatrix {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type AddSeatMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSeatMatrixResponse) Reset() {
+	*x = AddSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSeatMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSeatMatrixResponse) ProtoMessage() {}
+
+func (x *AddSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*AddSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddSeatMatrixResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AddSeatMatrixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddSeatMatrixResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CastAndCrew struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CastAndCrewType        `protobuf:"varint,2,opt,name=type,proto3,enum=moviedb_service.CastAndCrewType" json:"type,omitempty"`
+	CharacterName string                 `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	Photourl      string                 `protobuf:"bytes,4,opt,name=photourl,proto3" json:"photourl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CastAndCrew) Reset() {
+	*x = CastAndCrew{}
+	mi := &file_moviedb_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CastAndCrew) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastAndCrew) ProtoMessage() {}
+
+func (x *CastAndCrew) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastAndCrew.ProtoReflect.Descriptor instead.
+func (*CastAndCrew) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CastAndCrew) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CastAndCrew) GetType() CastAndCrewType {
+	if x != nil {
+		return x.Type
+	}
+	return CastAndCrewType_ACTOR
+}
+
+func (x *CastAndCrew) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *CastAndCrew) GetPhotourl() string {
+	if x != nil {
+		return x.Photourl
+	}
+	return ""
+}
+
+type MovieTimeSlot struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StartTime   string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration    int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Date        string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	MovieFormat MovieFormat            `protobuf:"varint,5,opt,name=movie_format,json=movieFormat,proto3,enum=moviedb_service.MovieFormat" json:"movie_format,omitempty"`
+	Movieid     int32                  `protobuf:"varint,6,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	Venueid     int32                  `protobuf:"varint,7,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Id          int32                  `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+	// Set when the show was generated by a show schedule
+	ShowScheduleid int32 `protobuf:"varint,9,opt,name=show_scheduleid,json=showScheduleid,proto3" json:"show_scheduleid,omitempty"`
+	// SCHEDULED, ON_SALE, SOLD_OUT, CANCELLED or COMPLETED, a new show is ON_SALE unless created SCHEDULED
+	Status             string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	CancellationReason string `protobuf:"bytes,11,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	// start_time and end_time are UTC, these are the same instants in the time zone of the venue
+	LocalStartTime string `protobuf:"bytes,12,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string `protobuf:"bytes,13,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	Timezone       string `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Audio language, one of the languages of the movie
+	Language      string   `protobuf:"bytes,15,opt,name=language,proto3" json:"language,omitempty"`
+	Subtitles     []string `protobuf:"bytes,16,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieTimeSlot) Reset() {
+	*x = MovieTimeSlot{}
+	mi := &file_moviedb_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieTimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieTimeSlot) ProtoMessage() {}
+
+func (x *MovieTimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieTimeSlot.ProtoReflect.Descriptor instead.
+func (*MovieTimeSlot) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MovieTimeSlot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MovieTimeSlot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetMovieFormat() MovieFormat {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return MovieFormat_FORMAT_2D
+}
+
+func (x *MovieTimeSlot) GetMovieid() int32 {
+	if x != nil {
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *MovieTimeSlot) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *MovieTimeSlot) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MovieTimeSlot) GetShowScheduleid() int32 {
+	if x != nil {
+		return x.ShowScheduleid
+	}
+	return 0
+}
+
+func (x *MovieTimeSlot) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *MovieTimeSlot) GetSubtitles() []string {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+type Movie struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Duration        int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Language        []string               `protobuf:"bytes,4,rep,name=language,proto3" json:"language,omitempty"`
+	Type            []string               `protobuf:"bytes,5,rep,name=type,proto3" json:"type,omitempty"`
+	CastCrew        []*CastAndCrew         `protobuf:"bytes,6,rep,name=cast_crew,json=castCrew,proto3" json:"cast_crew,omitempty"`
+	PosterUrl       string                 `protobuf:"bytes,7,opt,name=poster_url,json=posterUrl,proto3" json:"poster_url,omitempty"`
+	TrailerUrl      string                 `protobuf:"bytes,8,opt,name=trailer_url,json=trailerUrl,proto3" json:"trailer_url,omitempty"`
+	ReleaseDate     string                 `protobuf:"bytes,9,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	MovieResolution []string               `protobuf:"bytes,10,rep,name=movie_resolution,json=movieResolution,proto3" json:"movie_resolution,omitempty"`
+	Venues          []*Venue               `protobuf:"bytes,11,rep,name=venues,proto3" json:"venues,omitempty"`
+	// string movieid = 12;
+	Votes   int64 `protobuf:"varint,13,opt,name=votes,proto3" json:"votes,omitempty"`
+	Ranking int32 `protobuf:"varint,14,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	Id      int32 `protobuf:"varint,15,opt,name=id,proto3" json:"id,omitempty"`
+	// Set by location based lookups, distance to the closest venue showing the movie
+	DistanceKm     float64 `protobuf:"fixed64,16,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	NearestVenueid int32   `protobuf:"varint,17,opt,name=nearest_venueid,json=nearestVenueid,proto3" json:"nearest_venueid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Movie) Reset() {
+	*x = Movie{}
+	mi := &file_moviedb_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Movie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Movie) ProtoMessage() {}
+
+func (x *Movie) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
+func (*Movie) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Movie) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Movie) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Movie) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Movie) GetLanguage() []string {
+	if x != nil {
+		return x.Language
+	}
+	return nil
+}
+
+func (x *Movie) GetType() []string {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Movie) GetCastCrew() []*CastAndCrew {
+	if x != nil {
+		return x.CastCrew
+	}
+	return nil
+}
+
+func (x *Movie) GetPosterUrl() string {
+	if x != nil {
+		return x.PosterUrl
+	}
+	return ""
+}
+
+func (x *Movie) GetTrailerUrl() string {
+	if x != nil {
+		return x.TrailerUrl
+	}
+	return ""
+}
+
+func (x *Movie) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *Movie) GetMovieResolution() []string {
+	if x != nil {
+		return x.MovieResolution
+	}
+	return nil
+}
+
+func (x *Movie) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *Movie) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Movie) GetRanking() int32 {
+	if x != nil {
+		return x.Ranking
+	}
+	return 0
+}
+
+func (x *Movie) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Movie) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Movie) GetNearestVenueid() int32 {
+	if x != nil {
+		return x.NearestVenueid
+	}
+	return 0
+}
+
+type Venue struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address              string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type                 VenueType              `protobuf:"varint,3,opt,name=type,proto3,enum=moviedb_service.VenueType" json:"type,omitempty"`
+	Rows                 int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns              int32                  `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
+	Longitude            float32                `protobuf:"fixed32,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude             float32                `protobuf:"fixed32,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	ScreenNumber         int32                  `protobuf:"varint,8,opt,name=screen_number,json=screenNumber,proto3" json:"screen_number,omitempty"`
+	Seats                []*SeatMatrix          `protobuf:"bytes,9,rep,name=seats,proto3" json:"seats,omitempty"`
+	MovieTimeSlots       []*MovieTimeSlot       `protobuf:"bytes,10,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
+	Movies               []*Movie               `protobuf:"bytes,11,rep,name=movies,proto3" json:"movies,omitempty"`
+	Id                   int32                  `protobuf:"varint,12,opt,name=id,proto3" json:"id,omitempty"`
+	MovieFormatSupported []string               `protobuf:"bytes,13,rep,name=movie_format_supported,json=movieFormatSupported,proto3" json:"movie_format_supported,omitempty"`
+	LanguageSupported    []string               `protobuf:"bytes,14,rep,name=language_supported,json=languageSupported,proto3" json:"language_supported,omitempty"`
+	// How long seats are held during checkout, 0 uses the server default
+	SeatHoldMinutes int32 `protobuf:"varint,15,opt,name=seat_hold_minutes,json=seatHoldMinutes,proto3" json:"seat_hold_minutes,omitempty"`
+	// Set by location based lookups, distance from the customer
+	DistanceKm float64 `protobuf:"fixed64,16,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// City or region the venue belongs to, e.g. MUM
+	RegionCode string `protobuf:"bytes,17,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	// Cinema the screen belongs to, 0 for a venue not linked to a cinema
+	Cinemaid int32 `protobuf:"varint,18,opt,name=cinemaid,proto3" json:"cinemaid,omitempty"`
+	// Opening hours as HH:MM in the time zone of the cinema, a closing time at or before
+	// the opening time is on the next day. Empty uses 09:00 to 00:00
+	OpeningTime string `protobuf:"bytes,19,opt,name=opening_time,json=openingTime,proto3" json:"opening_time,omitempty"`
+	ClosingTime string `protobuf:"bytes,20,opt,name=closing_time,json=closingTime,proto3" json:"closing_time,omitempty"`
+	// IANA time zone, dates of shows are local dates in it. A screen of a cinema uses the zone of the cinema
+	Timezone string `protobuf:"bytes,21,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Layout template the seats were last copied from and its version, 0 when they were entered by hand
+	LayoutTemplateId int32 `protobuf:"varint,22,opt,name=layout_template_id,json=layoutTemplateId,proto3" json:"layout_template_id,omitempty"`
+	LayoutVersion    int32 `protobuf:"varint,23,opt,name=layout_version,json=layoutVersion,proto3" json:"layout_version,omitempty"`
+	// ISO 4217, prices of the seats and price rules of the venue are in it. Empty is INR, it cannot change once the venue has seats
+	Currency string `protobuf:"bytes,24,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Prices charged are a multiple of it in minor units, e.g. 100 for whole rupees. 0 does not round
+	PriceRounding int32        `protobuf:"varint,25,opt,name=price_rounding,json=priceRounding,proto3" json:"price_rounding,omitempty"`
+	RoundingMode  RoundingMode `protobuf:"varint,26,opt,name=rounding_mode,json=roundingMode,proto3,enum=moviedb_service.RoundingMode" json:"rounding_mode,omitempty"`
+	// Charged per ticket on top of its price, in the currency of the venue
+	ConvenienceFee *Money `protobuf:"bytes,27,opt,name=convenience_fee,json=convenienceFee,proto3" json:"convenience_fee,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_moviedb_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Venue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Venue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Venue) GetType() VenueType {
+	if x != nil {
+		return x.Type
+	}
+	return VenueType_MOVIE
+}
+
+func (x *Venue) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Venue) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *Venue) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Venue) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Venue) GetScreenNumber() int32 {
+	if x != nil {
+		return x.ScreenNumber
+	}
+	return 0
+}
+
+func (x *Venue) GetSeats() []*SeatMatrix {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *Venue) GetMovieTimeSlots() []*MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlots
+	}
+	return nil
+}
+
+func (x *Venue) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *Venue) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Venue) GetMovieFormatSupported() []string {
+	if x != nil {
+		return x.MovieFormatSupported
+	}
+	return nil
+}
+
+func (x *Venue) GetLanguageSupported() []string {
+	if x != nil {
+		return x.LanguageSupported
+	}
+	return nil
+}
+
+func (x *Venue) GetSeatHoldMinutes() int32 {
+	if x != nil {
+		return x.SeatHoldMinutes
+	}
+	return 0
+}
+
+func (x *Venue) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *Venue) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *Venue) GetCinemaid() int32 {
+	if x != nil {
+		return x.Cinemaid
+	}
+	return 0
+}
+
+func (x *Venue) GetOpeningTime() string {
+	if x != nil {
+		return x.OpeningTime
+	}
+	return ""
+}
+
+func (x *Venue) GetClosingTime() string {
+	if x != nil {
+		return x.ClosingTime
+	}
+	return ""
+}
+
+func (x *Venue) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Venue) GetLayoutTemplateId() int32 {
+	if x != nil {
+		return x.LayoutTemplateId
+	}
+	return 0
+}
+
+func (x *Venue) GetLayoutVersion() int32 {
+	if x != nil {
+		return x.LayoutVersion
+	}
+	return 0
+}
+
+func (x *Venue) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Venue) GetPriceRounding() int32 {
+	if x != nil {
+		return x.PriceRounding
+	}
+	return 0
+}
+
+func (x *Venue) GetRoundingMode() RoundingMode {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return RoundingMode_ROUND_HALF_UP
+}
+
+func (x *Venue) GetConvenienceFee() *Money {
+	if x != nil {
+		return x.ConvenienceFee
+	}
+	return nil
+}
+
+type Cinema struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address    string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	City       string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	RegionCode string                 `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	Latitude   float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude  float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA time zone, e.g. Asia/Kolkata. Defaults to UTC
+	Timezone      string   `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Amenities     []string `protobuf:"bytes,9,rep,name=amenities,proto3" json:"amenities,omitempty"`
+	PhoneNumber   string   `protobuf:"bytes,10,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string   `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
+	Website       string   `protobuf:"bytes,12,opt,name=website,proto3" json:"website,omitempty"`
+	Screens       []*Venue `protobuf:"bytes,13,rep,name=screens,proto3" json:"screens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cinema) Reset() {
+	*x = Cinema{}
+	mi := &file_moviedb_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cinema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cinema) ProtoMessage() {}
+
+func (x *Cinema) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cinema.ProtoReflect.Descriptor instead.
+func (*Cinema) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Cinema) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cinema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cinema) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Cinema) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Cinema) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *Cinema) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Cinema) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Cinema) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Cinema) GetAmenities() []string {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *Cinema) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Cinema) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Cinema) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Cinema) GetScreens() []*Venue {
+	if x != nil {
+		return x.Screens
+	}
+	return nil
+}
+
+type CinemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cinemaid      int32                  `protobuf:"varint,1,opt,name=cinemaid,proto3" json:"cinemaid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CinemaRequest) Reset() {
+	*x = CinemaRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaRequest) ProtoMessage() {}
+
+func (x *CinemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaRequest.ProtoReflect.Descriptor instead.
+func (*CinemaRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CinemaRequest) GetCinemaid() int32 {
+	if x != nil {
+		return x.Cinemaid
+	}
+	return 0
+}
+
+type CinemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cinema        *Cinema                `protobuf:"bytes,3,opt,name=cinema,proto3" json:"cinema,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CinemaResponse) Reset() {
+	*x = CinemaResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaResponse) ProtoMessage() {}
+
+func (x *CinemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaResponse.ProtoReflect.Descriptor instead.
+func (*CinemaResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CinemaResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CinemaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CinemaResponse) GetCinema() *Cinema {
+	if x != nil {
+		return x.Cinema
+	}
+	return nil
+}
+
+func (x *CinemaResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetAllCinemasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filter
+	RegionCode    string `protobuf:"bytes,3,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCinemasRequest) Reset() {
+	*x = GetAllCinemasRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCinemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCinemasRequest) ProtoMessage() {}
+
+func (x *GetAllCinemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCinemasRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCinemasRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAllCinemasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllCinemasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllCinemasRequest) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+type CinemaListResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cinemas []*Cinema              `protobuf:"bytes,3,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	Error   string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CinemaListResponse) Reset() {
+	*x = CinemaListResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaListResponse) ProtoMessage() {}
+
+func (x *CinemaListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaListResponse.ProtoReflect.Descriptor instead.
+func (*CinemaListResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CinemaListResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CinemaListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CinemaListResponse) GetCinemas() []*Cinema {
+	if x != nil {
+		return x.Cinemas
+	}
+	return nil
+}
+
+func (x *CinemaListResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CinemaListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MigrateVenuesToCinemasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateVenuesToCinemasRequest) Reset() {
+	*x = MigrateVenuesToCinemasRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateVenuesToCinemasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateVenuesToCinemasRequest) ProtoMessage() {}
+
+func (x *MigrateVenuesToCinemasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateVenuesToCinemasRequest.ProtoReflect.Descriptor instead.
+func (*MigrateVenuesToCinemasRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{13}
+}
+
+type MigrateVenuesToCinemasResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CinemasCreated int32                  `protobuf:"varint,3,opt,name=cinemas_created,json=cinemasCreated,proto3" json:"cinemas_created,omitempty"`
+	VenuesLinked   int32                  `protobuf:"varint,4,opt,name=venues_linked,json=venuesLinked,proto3" json:"venues_linked,omitempty"`
+	Error          string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MigrateVenuesToCinemasResponse) Reset() {
+	*x = MigrateVenuesToCinemasResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateVenuesToCinemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateVenuesToCinemasResponse) ProtoMessage() {}
+
+func (x *MigrateVenuesToCinemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateVenuesToCinemasResponse.ProtoReflect.Descriptor instead.
+func (*MigrateVenuesToCinemasResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MigrateVenuesToCinemasResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MigrateVenuesToCinemasResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MigrateVenuesToCinemasResponse) GetCinemasCreated() int32 {
+	if x != nil {
+		return x.CinemasCreated
+	}
+	return 0
+}
+
+func (x *MigrateVenuesToCinemasResponse) GetVenuesLinked() int32 {
+	if x != nil {
+		return x.VenuesLinked
+	}
+	return 0
+}
+
+func (x *MigrateVenuesToCinemasResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ShowSchedule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Movieid     int32                  `protobuf:"varint,2,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	Venueid     int32                  `protobuf:"varint,3,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	MovieFormat MovieFormat            `protobuf:"varint,4,opt,name=movie_format,json=movieFormat,proto3,enum=moviedb_service.MovieFormat" json:"movie_format,omitempty"`
+	// Calendar days, YYYY-MM-DD
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 0 is Sunday and 6 is Saturday
+	DaysOfWeek []int32 `protobuf:"varint,7,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	// HH:MM in the time zone of the cinema
+	StartTimes []string `protobuf:"bytes,8,rep,name=start_times,json=startTimes,proto3" json:"start_times,omitempty"`
+	// Minutes, 0 uses the runtime of the movie
+	Duration int32 `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	// ACTIVE or CANCELLED
+	Status        string   `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Language      string   `protobuf:"bytes,11,opt,name=language,proto3" json:"language,omitempty"`
+	Subtitles     []string `protobuf:"bytes,12,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowSchedule) Reset() {
+	*x = ShowSchedule{}
+	mi := &file_moviedb_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowSchedule) ProtoMessage() {}
+
+func (x *ShowSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowSchedule.ProtoReflect.Descriptor instead.
+func (*ShowSchedule) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ShowSchedule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShowSchedule) GetMovieid() int32 {
+	if x != nil {
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *ShowSchedule) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *ShowSchedule) GetMovieFormat() MovieFormat {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return MovieFormat_FORMAT_2D
+}
+
+func (x *ShowSchedule) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ShowSchedule) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ShowSchedule) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *ShowSchedule) GetStartTimes() []string {
+	if x != nil {
+		return x.StartTimes
+	}
+	return nil
+}
+
+func (x *ShowSchedule) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ShowSchedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShowSchedule) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ShowSchedule) GetSubtitles() []string {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+type ShowScheduleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ShowSchedule *ShowSchedule          `protobuf:"bytes,1,opt,name=show_schedule,json=showSchedule,proto3" json:"show_schedule,omitempty"`
+	// Work out the shows and conflicts without writing anything
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Write the shows that fit and leave out the conflicting ones
+	SkipConflicts bool `protobuf:"varint,3,opt,name=skip_conflicts,json=skipConflicts,proto3" json:"skip_conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowScheduleRequest) Reset() {
+	*x = ShowScheduleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowScheduleRequest) ProtoMessage() {}
+
+func (x *ShowScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ShowScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShowScheduleRequest) GetShowSchedule() *ShowSchedule {
+	if x != nil {
+		return x.ShowSchedule
+	}
+	return nil
+}
+
+func (x *ShowScheduleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ShowScheduleRequest) GetSkipConflicts() bool {
+	if x != nil {
+		return x.SkipConflicts
+	}
+	return false
+}
+
+type CancelShowScheduleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ShowScheduleid int32                  `protobuf:"varint,1,opt,name=show_scheduleid,json=showScheduleid,proto3" json:"show_scheduleid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelShowScheduleRequest) Reset() {
+	*x = CancelShowScheduleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShowScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShowScheduleRequest) ProtoMessage() {}
+
+func (x *CancelShowScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShowScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelShowScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelShowScheduleRequest) GetShowScheduleid() int32 {
+	if x != nil {
+		return x.ShowScheduleid
+	}
+	return 0
+}
+
+type ScheduledShowConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlot *MovieTimeSlot         `protobuf:"bytes,1,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
+	Conflict      *ScheduleConflict      `protobuf:"bytes,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledShowConflict) Reset() {
+	*x = ScheduledShowConflict{}
+	mi := &file_moviedb_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledShowConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledShowConflict) ProtoMessage() {}
+
+func (x *ScheduledShowConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledShowConflict.ProtoReflect.Descriptor instead.
+func (*ScheduledShowConflict) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScheduledShowConflict) GetMovieTimeSlot() *MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlot
+	}
+	return nil
+}
+
+func (x *ScheduledShowConflict) GetConflict() *ScheduleConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return nil
+}
+
+type ShowScheduleResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ShowSchedule  *ShowSchedule            `protobuf:"bytes,3,opt,name=show_schedule,json=showSchedule,proto3" json:"show_schedule,omitempty"`
+	Created       []*MovieTimeSlot         `protobuf:"bytes,4,rep,name=created,proto3" json:"created,omitempty"`
+	Updated       []*MovieTimeSlot         `protobuf:"bytes,5,rep,name=updated,proto3" json:"updated,omitempty"`
+	Cancelled     []*MovieTimeSlot         `protobuf:"bytes,6,rep,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Conflicts     []*ScheduledShowConflict `protobuf:"bytes,7,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Error         string                   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowScheduleResponse) Reset() {
+	*x = ShowScheduleResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowScheduleResponse) ProtoMessage() {}
+
+func (x *ShowScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ShowScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ShowScheduleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ShowScheduleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ShowScheduleResponse) GetShowSchedule() *ShowSchedule {
+	if x != nil {
+		return x.ShowSchedule
+	}
+	return nil
+}
+
+func (x *ShowScheduleResponse) GetCreated() []*MovieTimeSlot {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ShowScheduleResponse) GetUpdated() []*MovieTimeSlot {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *ShowScheduleResponse) GetCancelled() []*MovieTimeSlot {
+	if x != nil {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *ShowScheduleResponse) GetConflicts() []*ScheduledShowConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ShowScheduleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PlannedMovie struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Movieid int32                  `protobuf:"varint,1,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	// Shows wanted over the whole plan, 0 fills the screen time the other movies leave
+	TargetShows int32 `protobuf:"varint,2,opt,name=target_shows,json=targetShows,proto3" json:"target_shows,omitempty"`
+	// Higher priorities are programmed first
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Empty uses the first resolution of the movie the screen supports
+	MovieFormat   string `protobuf:"bytes,4,opt,name=movie_format,json=movieFormat,proto3" json:"movie_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedMovie) Reset() {
+	*x = PlannedMovie{}
+	mi := &file_moviedb_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedMovie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedMovie) ProtoMessage() {}
+
+func (x *PlannedMovie) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedMovie.ProtoReflect.Descriptor instead.
+func (*PlannedMovie) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlannedMovie) GetMovieid() int32 {
+	if x != nil {
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *PlannedMovie) GetTargetShows() int32 {
+	if x != nil {
+		return x.TargetShows
+	}
+	return 0
+}
+
+func (x *PlannedMovie) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PlannedMovie) GetMovieFormat() string {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return ""
+}
+
+type PlanProgrammeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First day of the plan, YYYY-MM-DD
+	WeekStart string `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// Days to plan, 0 plans a week
+	Days     int32           `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Venueids []int32         `protobuf:"varint,3,rep,packed,name=venueids,proto3" json:"venueids,omitempty"`
+	Movies   []*PlannedMovie `protobuf:"bytes,4,rep,name=movies,proto3" json:"movies,omitempty"`
+	// Write the plan, by default it is only previewed
+	Commit        bool `protobuf:"varint,5,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanProgrammeRequest) Reset() {
+	*x = PlanProgrammeRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanProgrammeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgrammeRequest) ProtoMessage() {}
+
+func (x *PlanProgrammeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgrammeRequest.ProtoReflect.Descriptor instead.
+func (*PlanProgrammeRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlanProgrammeRequest) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *PlanProgrammeRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *PlanProgrammeRequest) GetVenueids() []int32 {
+	if x != nil {
+		return x.Venueids
+	}
+	return nil
+}
+
+func (x *PlanProgrammeRequest) GetMovies() []*PlannedMovie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *PlanProgrammeRequest) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+type PlannedMovieShows struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Movieid      int32                  `protobuf:"varint,1,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	PlannedShows int32                  `protobuf:"varint,2,opt,name=planned_shows,json=plannedShows,proto3" json:"planned_shows,omitempty"`
+	// Target shows that did not fit
+	MissingShows  int32 `protobuf:"varint,3,opt,name=missing_shows,json=missingShows,proto3" json:"missing_shows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedMovieShows) Reset() {
+	*x = PlannedMovieShows{}
+	mi := &file_moviedb_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedMovieShows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedMovieShows) ProtoMessage() {}
+
+func (x *PlannedMovieShows) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedMovieShows.ProtoReflect.Descriptor instead.
+func (*PlannedMovieShows) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PlannedMovieShows) GetMovieid() int32 {
+	if x != nil {
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *PlannedMovieShows) GetPlannedShows() int32 {
+	if x != nil {
+		return x.PlannedShows
+	}
+	return 0
+}
+
+func (x *PlannedMovieShows) GetMissingShows() int32 {
+	if x != nil {
+		return x.MissingShows
+	}
+	return 0
+}
+
+type ScreenUse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Venueid int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// Share of the opening hours taken by shows, 0 to 1
+	Use           float64 `protobuf:"fixed64,2,opt,name=use,proto3" json:"use,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenUse) Reset() {
+	*x = ScreenUse{}
+	mi := &file_moviedb_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenUse) ProtoMessage() {}
+
+func (x *ScreenUse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenUse.ProtoReflect.Descriptor instead.
+func (*ScreenUse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScreenUse) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *ScreenUse) GetUse() float64 {
+	if x != nil {
+		return x.Use
+	}
+	return 0
+}
+
+type PlanProgrammeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlots []*MovieTimeSlot       `protobuf:"bytes,3,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
+	Movies         []*PlannedMovieShows   `protobuf:"bytes,4,rep,name=movies,proto3" json:"movies,omitempty"`
+	Screens        []*ScreenUse           `protobuf:"bytes,5,rep,name=screens,proto3" json:"screens,omitempty"`
+	// Set when a committed show clashes with one added since the plan was made
+	Conflict      *ScheduleConflict `protobuf:"bytes,6,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	Error         string            `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanProgrammeResponse) Reset() {
+	*x = PlanProgrammeResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanProgrammeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanProgrammeResponse) ProtoMessage() {}
+
+func (x *PlanProgrammeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanProgrammeResponse.ProtoReflect.Descriptor instead.
+func (*PlanProgrammeResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PlanProgrammeResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PlanProgrammeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlanProgrammeResponse) GetMovieTimeSlots() []*MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlots
+	}
+	return nil
+}
+
+func (x *PlanProgrammeResponse) GetMovies() []*PlannedMovieShows {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+func (x *PlanProgrammeResponse) GetScreens() []*ScreenUse {
+	if x != nil {
+		return x.Screens
+	}
+	return nil
+}
+
+func (x *PlanProgrammeResponse) GetConflict() *ScheduleConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return nil
+}
+
+func (x *PlanProgrammeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MovieList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieList) Reset() {
+	*x = MovieList{}
+	mi := &file_moviedb_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieList) ProtoMessage() {}
+
+func (x *MovieList) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieList.ProtoReflect.Descriptor instead.
+func (*MovieList) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MovieList) GetMovies() []*Movie {
+	if x != nil {
+		return x.Movies
+	}
+	return nil
+}
+
+type MovieRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Movieid       string                 `protobuf:"bytes,2,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	Venueid       string                 `protobuf:"bytes,3,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieRequest) Reset() {
+	*x = MovieRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieRequest) ProtoMessage() {}
+
+func (x *MovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieRequest.ProtoReflect.Descriptor instead.
+func (*MovieRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MovieRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MovieRequest) GetMovieid() string {
+	if x != nil {
+		return x.Movieid
+	}
+	return ""
+}
+
+func (x *MovieRequest) GetVenueid() string {
+	if x != nil {
+		return x.Venueid
+	}
+	return ""
+}
+
+type MovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Movie         *Movie                 `protobuf:"bytes,3,opt,name=movie,proto3" json:"movie,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieResponse) Reset() {
+	*x = MovieResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieResponse) ProtoMessage() {}
+
+func (x *MovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieResponse.ProtoReflect.Descriptor instead.
+func (*MovieResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MovieResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MovieResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MovieResponse) GetMovie() *Movie {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *MovieResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MovieListResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Status    int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message   string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieList *MovieList             `protobuf:"bytes,3,opt,name=movie_list,json=movieList,proto3" json:"movie_list,omitempty"`
+	Error     string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieListResponse) Reset() {
+	*x = MovieListResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieListResponse) ProtoMessage() {}
+
+func (x *MovieListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieListResponse.ProtoReflect.Descriptor instead.
+func (*MovieListResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MovieListResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MovieListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MovieListResponse) GetMovieList() *MovieList {
+	if x != nil {
+		return x.MovieList
+	}
+	return nil
+}
+
+func (x *MovieListResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MovieListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetAllMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filters
+	Language      string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Type          string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllMoviesRequest) Reset() {
+	*x = GetAllMoviesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllMoviesRequest) ProtoMessage() {}
+
+func (x *GetAllMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllMoviesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAllMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllMoviesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllMoviesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetAllMoviesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetAllVenuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to 20, at most 100
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional filter on the VenueType name, e.g. MOVIE
+	VenueType     string `protobuf:"bytes,3,opt,name=venue_type,json=venueType,proto3" json:"venue_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllVenuesRequest) Reset() {
+	*x = GetAllVenuesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllVenuesRequest) ProtoMessage() {}
+
+func (x *GetAllVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllVenuesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAllVenuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllVenuesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllVenuesRequest) GetVenueType() string {
+	if x != nil {
+		return x.VenueType
+	}
+	return ""
+}
+
+type VenueListResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Venues  []*Venue               `protobuf:"bytes,3,rep,name=venues,proto3" json:"venues,omitempty"`
+	Error   string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueListResponse) Reset() {
+	*x = VenueListResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueListResponse) ProtoMessage() {}
+
+func (x *VenueListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueListResponse.ProtoReflect.Descriptor instead.
+func (*VenueListResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VenueListResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VenueListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VenueListResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *VenueListResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VenueListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Venue         *Venue                 `protobuf:"bytes,3,opt,name=Venue,proto3" json:"Venue,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueResponse) Reset() {
+	*x = VenueResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueResponse) ProtoMessage() {}
+
+func (x *VenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueResponse.ProtoReflect.Descriptor instead.
+func (*VenueResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VenueResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VenueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VenueResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *VenueResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetUpcomingMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// YYYY-MM-DD, empty is today in timezone
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// IANA time zone of the customer, empty is UTC
+	Timezone      string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingMovieRequest) Reset() {
+	*x = GetUpcomingMovieRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingMovieRequest) ProtoMessage() {}
+
+func (x *GetUpcomingMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingMovieRequest.ProtoReflect.Descriptor instead.
+func (*GetUpcomingMovieRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUpcomingMovieRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUpcomingMovieRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetUpcomingMovieResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieList     []*Movie               `protobuf:"bytes,3,rep,name=movie_list,json=movieList,proto3" json:"movie_list,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingMovieResponse) Reset() {
+	*x = GetUpcomingMovieResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingMovieResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingMovieResponse) ProtoMessage() {}
+
+func (x *GetUpcomingMovieResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingMovieResponse.ProtoReflect.Descriptor instead.
+func (*GetUpcomingMovieResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUpcomingMovieResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetUpcomingMovieResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUpcomingMovieResponse) GetMovieList() []*Movie {
+	if x != nil {
+		return x.MovieList
+	}
+	return nil
+}
+
+func (x *GetUpcomingMovieResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Location struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Latitude  float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Optional, 0 uses the server default. At most 500km
+	Radius     float64      `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	RadiusUnit DistanceUnit `protobuf:"varint,4,opt,name=radius_unit,json=radiusUnit,proto3,enum=moviedb_service.DistanceUnit" json:"radius_unit,omitempty"`
+	// Alternative to coordinates, e.g. MUM. When both are set venues have to match both
+	RegionCode    string `protobuf:"bytes,5,opt,name=region_code,json=regionCode,proto3" json:"region_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_moviedb_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Location) GetRadiusUnit() DistanceUnit {
+	if x != nil {
+		return x.RadiusUnit
+	}
+	return DistanceUnit_KILOMETERS
+}
+
+func (x *Location) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+type GetNowPlayingMovieRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whole degrees only, use location
+	//
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	OldLongitude int64 `protobuf:"varint,1,opt,name=old_longitude,json=oldLongitude,proto3" json:"old_longitude,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	OldLatitude   int64     `protobuf:"varint,2,opt,name=old_latitude,json=oldLatitude,proto3" json:"old_latitude,omitempty"`
+	Location      *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNowPlayingMovieRequest) Reset() {
+	*x = GetNowPlayingMovieRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNowPlayingMovieRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNowPlayingMovieRequest) ProtoMessage() {}
+
+func (x *GetNowPlayingMovieRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNowPlayingMovieRequest.ProtoReflect.Descriptor instead.
+func (*GetNowPlayingMovieRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{36}
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetNowPlayingMovieRequest) GetOldLongitude() int64 {
+	if x != nil {
+		return x.OldLongitude
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetNowPlayingMovieRequest) GetOldLatitude() int64 {
+	if x != nil {
+		return x.OldLatitude
+	}
+	return 0
+}
+
+func (x *GetNowPlayingMovieRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieID       int32                  `protobuf:"varint,1,opt,name=movieID,proto3" json:"movieID,omitempty"`
+	UserID        int32                  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	ReviewID      int32                  `protobuf:"varint,6,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	CreatedAt     int32                  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ReviewerName  string                 `protobuf:"bytes,8,opt,name=reviewerName,proto3" json:"reviewerName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_moviedb_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Review) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *Review) GetUserID() int32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Review) GetReviewID() int32 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *Review) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Review) GetReviewerName() string {
+	if x != nil {
+		return x.ReviewerName
+	}
+	return ""
+}
+
+type ReviewUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int32                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReviewID      int32                  `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	MovieID       int32                  `protobuf:"varint,3,opt,name=movieID,proto3" json:"movieID,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Rating        int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewUpdateRequest) Reset() {
+	*x = ReviewUpdateRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewUpdateRequest) ProtoMessage() {}
+
+func (x *ReviewUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ReviewUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewUpdateRequest) GetUserID() int32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ReviewUpdateRequest) GetReviewID() int32 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *ReviewUpdateRequest) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *ReviewUpdateRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewUpdateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReviewUpdateRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type ReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Review        *Review                `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewResponse) Reset() {
+	*x = ReviewResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResponse) ProtoMessage() {}
+
+func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReviewResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *ReviewResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        int32                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReviewID      int32                  `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
+	MovieID       int32                  `protobuf:"varint,3,opt,name=movieID,proto3" json:"movieID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReviewRequest) GetUserID() int32 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetReviewID() int32 {
+	if x != nil {
+		return x.ReviewID
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+type ReviewList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewList) Reset() {
+	*x = ReviewList{}
+	mi := &file_moviedb_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewList) ProtoMessage() {}
+
+func (x *ReviewList) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewList.ProtoReflect.Descriptor instead.
+func (*ReviewList) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReviewList) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type ReviewListResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Status           int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReviewList       *ReviewList            `protobuf:"bytes,3,opt,name=review_list,json=reviewList,proto3" json:"review_list,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	TotalReviewCount int32                  `protobuf:"varint,5,opt,name=totalReviewCount,proto3" json:"totalReviewCount,omitempty"`
+	TotalVotes       int32                  `protobuf:"varint,6,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewListResponse) Reset() {
+	*x = ReviewListResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewListResponse) ProtoMessage() {}
+
+func (x *ReviewListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewListResponse.ProtoReflect.Descriptor instead.
+func (*ReviewListResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReviewListResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewListResponse) GetReviewList() *ReviewList {
+	if x != nil {
+		return x.ReviewList
+	}
+	return nil
+}
+
+func (x *ReviewListResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ReviewListResponse) GetTotalReviewCount() int32 {
+	if x != nil {
+		return x.TotalReviewCount
+	}
+	return 0
+}
+
+func (x *ReviewListResponse) GetTotalVotes() int32 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+type GetAllMovieReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovieID       int32                  `protobuf:"varint,1,opt,name=movieID,proto3" json:"movieID,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortBy        SortBy                 `protobuf:"varint,5,opt,name=sortBy,proto3,enum=moviedb_service.SortBy" json:"sortBy,omitempty"`
+	FilterBy      FilterBy               `protobuf:"varint,6,opt,name=filterBy,proto3,enum=moviedb_service.FilterBy" json:"filterBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllMovieReviewsRequest) Reset() {
+	*x = GetAllMovieReviewsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllMovieReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllMovieReviewsRequest) ProtoMessage() {}
+
+func (x *GetAllMovieReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllMovieReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllMovieReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAllMovieReviewsRequest) GetMovieID() int32 {
+	if x != nil {
+		return x.MovieID
+	}
+	return 0
+}
+
+func (x *GetAllMovieReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAllMovieReviewsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAllMovieReviewsRequest) GetSortBy() SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortBy_ASCENDING
+}
+
+func (x *GetAllMovieReviewsRequest) GetFilterBy() FilterBy {
+	if x != nil {
+		return x.FilterBy
+	}
+	return FilterBy_RATING
+}
+
+type GetMovieTimeSlotRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Movieid   string                 `protobuf:"bytes,1,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	StartDate string                 `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate   string                 `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	OldLongitude int64 `protobuf:"varint,5,opt,name=old_longitude,json=oldLongitude,proto3" json:"old_longitude,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	OldLatitude int64 `protobuf:"varint,6,opt,name=old_latitude,json=oldLatitude,proto3" json:"old_latitude,omitempty"`
+	// Single precision, use location
+	//
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Longitude float32 `protobuf:"fixed32,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Latitude float32   `protobuf:"fixed32,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Location *Location `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	// Optional, only shows in this audio language
+	Language string `protobuf:"bytes,10,opt,name=language,proto3" json:"language,omitempty"`
+	// Optional, only shows in this format, e.g. IMAX or FORMAT_IMAX
+	MovieFormat   string `protobuf:"bytes,11,opt,name=movie_format,json=movieFormat,proto3" json:"movie_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieTimeSlotRequest) Reset() {
+	*x = GetMovieTimeSlotRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieTimeSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieTimeSlotRequest) ProtoMessage() {}
+
+func (x *GetMovieTimeSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieTimeSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieTimeSlotRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetMovieTimeSlotRequest) GetMovieid() string {
+	if x != nil {
+		return x.Movieid
+	}
+	return ""
+}
+
+func (x *GetMovieTimeSlotRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetMovieTimeSlotRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetMovieTimeSlotRequest) GetOldLongitude() int64 {
+	if x != nil {
+		return x.OldLongitude
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetMovieTimeSlotRequest) GetOldLatitude() int64 {
+	if x != nil {
+		return x.OldLatitude
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetMovieTimeSlotRequest) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *GetMovieTimeSlotRequest) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetMovieTimeSlotRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetMovieTimeSlotRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetMovieTimeSlotRequest) GetMovieFormat() string {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return ""
+}
+
+type ScreenShowtimes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Venue          *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	MovieTimeSlots []*MovieTimeSlot       `protobuf:"bytes,2,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScreenShowtimes) Reset() {
+	*x = ScreenShowtimes{}
+	mi := &file_moviedb_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenShowtimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenShowtimes) ProtoMessage() {}
+
+func (x *ScreenShowtimes) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenShowtimes.ProtoReflect.Descriptor instead.
+func (*ScreenShowtimes) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScreenShowtimes) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *ScreenShowtimes) GetMovieTimeSlots() []*MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlots
+	}
+	return nil
+}
+
+type CinemaShowtimes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is 0 for a venue not linked to a cinema
+	Cinema        *Cinema            `protobuf:"bytes,1,opt,name=cinema,proto3" json:"cinema,omitempty"`
+	DistanceKm    float64            `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	Screens       []*ScreenShowtimes `protobuf:"bytes,3,rep,name=screens,proto3" json:"screens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CinemaShowtimes) Reset() {
+	*x = CinemaShowtimes{}
+	mi := &file_moviedb_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CinemaShowtimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CinemaShowtimes) ProtoMessage() {}
+
+func (x *CinemaShowtimes) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CinemaShowtimes.ProtoReflect.Descriptor instead.
+func (*CinemaShowtimes) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CinemaShowtimes) GetCinema() *Cinema {
+	if x != nil {
+		return x.Cinema
+	}
+	return nil
+}
+
+func (x *CinemaShowtimes) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *CinemaShowtimes) GetScreens() []*ScreenShowtimes {
+	if x != nil {
+		return x.Screens
+	}
+	return nil
+}
+
+type GetMovieTimeSlotResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlots []*MovieTimeSlot       `protobuf:"bytes,3,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Venues         []*Venue               `protobuf:"bytes,5,rep,name=venues,proto3" json:"venues,omitempty"`
+	// Same shows grouped by cinema then screen, closest cinema first
+	Cinemas       []*CinemaShowtimes `protobuf:"bytes,6,rep,name=cinemas,proto3" json:"cinemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieTimeSlotResponse) Reset() {
+	*x = GetMovieTimeSlotResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieTimeSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieTimeSlotResponse) ProtoMessage() {}
+
+func (x *GetMovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieTimeSlotResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieTimeSlotResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetMovieTimeSlotResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetMovieTimeSlotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMovieTimeSlotResponse) GetMovieTimeSlots() []*MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlots
+	}
+	return nil
+}
+
+func (x *GetMovieTimeSlotResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetMovieTimeSlotResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *GetMovieTimeSlotResponse) GetCinemas() []*CinemaShowtimes {
+	if x != nil {
+		return x.Cinemas
+	}
+	return nil
+}
+
+type ScheduleConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OVERLAP, TURNAROUND, INVALID_TIMES, DURATION_MISMATCH, SHORTER_THAN_RUNTIME, FORMAT_NOT_SUPPORTED,
+	// VENUE_NOT_FOUND, MOVIE_NOT_FOUND, VENUE_CHANGE or HAS_BOOKINGS
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set when the show clashes with another show of the venue
+	ConflictingMovieTimeSlot *MovieTimeSlot `protobuf:"bytes,2,opt,name=conflicting_movie_time_slot,json=conflictingMovieTimeSlot,proto3" json:"conflicting_movie_time_slot,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ScheduleConflict) Reset() {
+	*x = ScheduleConflict{}
+	mi := &file_moviedb_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflict) ProtoMessage() {}
+
+func (x *ScheduleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflict.ProtoReflect.Descriptor instead.
+func (*ScheduleConflict) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ScheduleConflict) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetConflictingMovieTimeSlot() *MovieTimeSlot {
+	if x != nil {
+		return x.ConflictingMovieTimeSlot
+	}
+	return nil
+}
+
+type MovieTimeSlotResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error   string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when the show breaks a scheduling rule
+	Conflict      *ScheduleConflict `protobuf:"bytes,4,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieTimeSlotResponse) Reset() {
+	*x = MovieTimeSlotResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieTimeSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieTimeSlotResponse) ProtoMessage() {}
+
+func (x *MovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieTimeSlotResponse.ProtoReflect.Descriptor instead.
+func (*MovieTimeSlotResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MovieTimeSlotResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotResponse) GetConflict() *ScheduleConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return nil
+}
+
+type MovieTimeSlotUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlot *MovieTimeSlot         `protobuf:"bytes,3,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when the show breaks a scheduling rule
+	Conflict      *ScheduleConflict `protobuf:"bytes,5,opt,name=conflict,proto3" json:"conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieTimeSlotUpdateResponse) Reset() {
+	*x = MovieTimeSlotUpdateResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieTimeSlotUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieTimeSlotUpdateResponse) ProtoMessage() {}
+
+func (x *MovieTimeSlotUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieTimeSlotUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MovieTimeSlotUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MovieTimeSlotUpdateResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotUpdateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdateResponse) GetMovieTimeSlot() *MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlot
+	}
+	return nil
+}
+
+func (x *MovieTimeSlotUpdateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdateResponse) GetConflict() *ScheduleConflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return nil
+}
+
+type MovieTimeSlotUpdate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartTime       string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Duration        int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Date            string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	MovieFormat     MovieFormat            `protobuf:"varint,5,opt,name=movie_format,json=movieFormat,proto3,enum=moviedb_service.MovieFormat" json:"movie_format,omitempty"`
+	Movieid         int32                  `protobuf:"varint,6,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	Venueid         int32                  `protobuf:"varint,7,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	MovieTimeSlotId int32                  `protobuf:"varint,8,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	Language        string                 `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	Subtitles       []string               `protobuf:"bytes,10,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MovieTimeSlotUpdate) Reset() {
+	*x = MovieTimeSlotUpdate{}
+	mi := &file_moviedb_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieTimeSlotUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieTimeSlotUpdate) ProtoMessage() {}
+
+func (x *MovieTimeSlotUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieTimeSlotUpdate.ProtoReflect.Descriptor instead.
+func (*MovieTimeSlotUpdate) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MovieTimeSlotUpdate) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdate) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdate) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotUpdate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdate) GetMovieFormat() MovieFormat {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return MovieFormat_FORMAT_2D
+}
+
+func (x *MovieTimeSlotUpdate) GetMovieid() int32 {
+	if x != nil {
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotUpdate) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotUpdate) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *MovieTimeSlotUpdate) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *MovieTimeSlotUpdate) GetSubtitles() []string {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+type MovieTimeSlotDelete struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MovieTimeSlotDelete) Reset() {
+	*x = MovieTimeSlotDelete{}
+	mi := &file_moviedb_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieTimeSlotDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieTimeSlotDelete) ProtoMessage() {}
+
+func (x *MovieTimeSlotDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieTimeSlotDelete.ProtoReflect.Descriptor instead.
+func (*MovieTimeSlotDelete) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MovieTimeSlotDelete) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+type SetShowtimeStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	// SCHEDULED to pause sales or ON_SALE to open them
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShowtimeStatusRequest) Reset() {
+	*x = SetShowtimeStatusRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShowtimeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShowtimeStatusRequest) ProtoMessage() {}
+
+func (x *SetShowtimeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShowtimeStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetShowtimeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetShowtimeStatusRequest) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *SetShowtimeStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CancelShowtimeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	// Told to the customers in the cancellation mail
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShowtimeRequest) Reset() {
+	*x = CancelShowtimeRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShowtimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShowtimeRequest) ProtoMessage() {}
+
+func (x *CancelShowtimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShowtimeRequest.ProtoReflect.Descriptor instead.
+func (*CancelShowtimeRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CancelShowtimeRequest) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *CancelShowtimeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelShowtimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlot *MovieTimeSlot         `protobuf:"bytes,3,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
+	// Tickets marked for refund
+	RefundTicketids []int32 `protobuf:"varint,4,rep,packed,name=refund_ticketids,json=refundTicketids,proto3" json:"refund_ticketids,omitempty"`
+	// Seats taken back from checkouts in progress
+	ReleasedSeats int32 `protobuf:"varint,5,opt,name=released_seats,json=releasedSeats,proto3" json:"released_seats,omitempty"`
+	// Cancellation mails queued
+	NotifiedCustomers int32  `protobuf:"varint,6,opt,name=notified_customers,json=notifiedCustomers,proto3" json:"notified_customers,omitempty"`
+	Error             string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelShowtimeResponse) Reset() {
+	*x = CancelShowtimeResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShowtimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShowtimeResponse) ProtoMessage() {}
+
+func (x *CancelShowtimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShowtimeResponse.ProtoReflect.Descriptor instead.
+func (*CancelShowtimeResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CancelShowtimeResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CancelShowtimeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelShowtimeResponse) GetMovieTimeSlot() *MovieTimeSlot {
+	if x != nil {
+		return x.MovieTimeSlot
+	}
+	return nil
+}
+
+func (x *CancelShowtimeResponse) GetRefundTicketids() []int32 {
+	if x != nil {
+		return x.RefundTicketids
+	}
+	return nil
+}
+
+func (x *CancelShowtimeResponse) GetReleasedSeats() int32 {
+	if x != nil {
+		return x.ReleasedSeats
+	}
+	return 0
+}
+
+func (x *CancelShowtimeResponse) GetNotifiedCustomers() int32 {
+	if x != nil {
+		return x.NotifiedCustomers
+	}
+	return 0
+}
+
+func (x *CancelShowtimeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetSeatMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMatrixRequest) Reset() {
+	*x = GetSeatMatrixRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMatrixRequest) ProtoMessage() {}
+
+func (x *GetSeatMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMatrixRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetSeatMatrixRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+type GetSeatMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Seats         []*SeatMatrix          `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMatrixResponse) Reset() {
+	*x = GetSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMatrixResponse) ProtoMessage() {}
+
+func (x *GetSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetSeatMatrixResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetSeatMatrixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSeatMatrixResponse) GetSeats() []*SeatMatrix {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GetSeatMatrixResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateSeatMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Seats         []*SeatMatrix          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	Mode          SeatChangeMode         `protobuf:"varint,3,opt,name=mode,proto3,enum=moviedb_service.SeatChangeMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeatMatrixRequest) Reset() {
+	*x = UpdateSeatMatrixRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeatMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeatMatrixRequest) ProtoMessage() {}
+
+func (x *UpdateSeatMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeatMatrixRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeatMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateSeatMatrixRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *UpdateSeatMatrixRequest) GetSeats() []*SeatMatrix {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *UpdateSeatMatrixRequest) GetMode() SeatChangeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SeatChangeMode_SEAT_CHANGE_REJECT
+}
+
+type UpdateSeatMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSeatMatrixResponse) Reset() {
+	*x = UpdateSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSeatMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeatMatrixResponse) ProtoMessage() {}
+
+func (x *UpdateSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateSeatMatrixResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateSeatMatrixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateSeatMatrixResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteSeatMatrixRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Venueid int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Seats         []*SeatMatrix  `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	SeatMatrixId  int32          `protobuf:"varint,3,opt,name=seat_matrix_id,json=seatMatrixId,proto3" json:"seat_matrix_id,omitempty"`
+	Mode          SeatChangeMode `protobuf:"varint,4,opt,name=mode,proto3,enum=moviedb_service.SeatChangeMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeatMatrixRequest) Reset() {
+	*x = DeleteSeatMatrixRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeatMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeatMatrixRequest) ProtoMessage() {}
+
+func (x *DeleteSeatMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeatMatrixRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeatMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteSeatMatrixRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *DeleteSeatMatrixRequest) GetSeats() []*SeatMatrix {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *DeleteSeatMatrixRequest) GetSeatMatrixId() int32 {
+	if x != nil {
+		return x.SeatMatrixId
+	}
+	return 0
+}
+
+func (x *DeleteSeatMatrixRequest) GetMode() SeatChangeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SeatChangeMode_SEAT_CHANGE_REJECT
+}
+
+type DeleteSeatMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeatMatrixResponse) Reset() {
+	*x = DeleteSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeatMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeatMatrixResponse) ProtoMessage() {}
+
+func (x *DeleteSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteSeatMatrixResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeleteSeatMatrixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteSeatMatrixResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteEntireSeatMatrixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Mode          SeatChangeMode         `protobuf:"varint,2,opt,name=mode,proto3,enum=moviedb_service.SeatChangeMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntireSeatMatrixRequest) Reset() {
+	*x = DeleteEntireSeatMatrixRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntireSeatMatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntireSeatMatrixRequest) ProtoMessage() {}
+
+func (x *DeleteEntireSeatMatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntireSeatMatrixRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntireSeatMatrixRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteEntireSeatMatrixRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *DeleteEntireSeatMatrixRequest) GetMode() SeatChangeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SeatChangeMode_SEAT_CHANGE_REJECT
+}
+
+type DeleteEntireSeatMatrixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntireSeatMatrixResponse) Reset() {
+	*x = DeleteEntireSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntireSeatMatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntireSeatMatrixResponse) ProtoMessage() {}
+
+func (x *DeleteEntireSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntireSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntireSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteEntireSeatMatrixResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeleteEntireSeatMatrixResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteEntireSeatMatrixResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Shows take their seats from the seat matrix when they are created, this brings the upcoming shows of a venue in line with later changes
+type ApplySeatMatrixToShowsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Venueid int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// Count the changes without making them
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySeatMatrixToShowsRequest) Reset() {
+	*x = ApplySeatMatrixToShowsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySeatMatrixToShowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySeatMatrixToShowsRequest) ProtoMessage() {}
+
+func (x *ApplySeatMatrixToShowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySeatMatrixToShowsRequest.ProtoReflect.Descriptor instead.
+func (*ApplySeatMatrixToShowsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApplySeatMatrixToShowsRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *ApplySeatMatrixToShowsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ShowSeatChanges struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	// Seats of the venue the show did not have yet
+	Added int32 `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	// Unsold seats that are no longer part of the venue
+	Removed int32 `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	// Unsold seats that took the new number of their seat
+	Renumbered int32 `protobuf:"varint,4,opt,name=renumbered,proto3" json:"renumbered,omitempty"`
+	// Sold or held seats that are no longer part of the venue, they stay until the show is over
+	Kept          int32 `protobuf:"varint,5,opt,name=kept,proto3" json:"kept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowSeatChanges) Reset() {
+	*x = ShowSeatChanges{}
+	mi := &file_moviedb_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowSeatChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowSeatChanges) ProtoMessage() {}
+
+func (x *ShowSeatChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowSeatChanges.ProtoReflect.Descriptor instead.
+func (*ShowSeatChanges) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ShowSeatChanges) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *ShowSeatChanges) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ShowSeatChanges) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *ShowSeatChanges) GetRenumbered() int32 {
+	if x != nil {
+		return x.Renumbered
+	}
+	return 0
+}
+
+func (x *ShowSeatChanges) GetKept() int32 {
+	if x != nil {
+		return x.Kept
+	}
+	return 0
+}
+
+type ApplySeatMatrixToShowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Shows         []*ShowSeatChanges     `protobuf:"bytes,3,rep,name=shows,proto3" json:"shows,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySeatMatrixToShowsResponse) Reset() {
+	*x = ApplySeatMatrixToShowsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySeatMatrixToShowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySeatMatrixToShowsResponse) ProtoMessage() {}
+
+func (x *ApplySeatMatrixToShowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySeatMatrixToShowsResponse.ProtoReflect.Descriptor instead.
+func (*ApplySeatMatrixToShowsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ApplySeatMatrixToShowsResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ApplySeatMatrixToShowsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplySeatMatrixToShowsResponse) GetShows() []*ShowSeatChanges {
+	if x != nil {
+		return x.Shows
+	}
+	return nil
+}
+
+func (x *ApplySeatMatrixToShowsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Empty conditions match every show
+type PriceRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind  PriceRuleKind          `protobuf:"varint,3,opt,name=kind,proto3,enum=moviedb_service.PriceRuleKind" json:"kind,omitempty"`
+	// In the currency of the venue for a rule of a venue or a show, a rule for every venue needs a currency and only
+	// applies to the venues charging in it
+	Amount          *Money `protobuf:"bytes,12,opt,name=amount,proto3" json:"amount,omitempty"`
+	Venueid         int32  `protobuf:"varint,5,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	MovieTimeSlotId int32  `protobuf:"varint,6,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	// e.g. VIP, empty for every seat type
+	SeatType string `protobuf:"bytes,7,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
+	// e.g. IMAX, empty for every format
+	MovieFormat string `protobuf:"bytes,8,opt,name=movie_format,json=movieFormat,proto3" json:"movie_format,omitempty"`
+	// 0 is Sunday, in the time zone of the venue
+	DaysOfWeek []int32 `protobuf:"varint,9,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	// HH:MM, shows starting at or after from_time and before to_time in the time zone of the venue
+	FromTime      string `protobuf:"bytes,10,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime        string `protobuf:"bytes,11,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRule) Reset() {
+	*x = PriceRule{}
+	mi := &file_moviedb_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRule) ProtoMessage() {}
+
+func (x *PriceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRule.ProtoReflect.Descriptor instead.
+func (*PriceRule) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PriceRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceRule) GetKind() PriceRuleKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PriceRuleKind_RULE_PRICE
+}
+
+func (x *PriceRule) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PriceRule) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *PriceRule) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *PriceRule) GetSeatType() string {
+	if x != nil {
+		return x.SeatType
+	}
+	return ""
+}
+
+func (x *PriceRule) GetMovieFormat() string {
+	if x != nil {
+		return x.MovieFormat
+	}
+	return ""
+}
+
+func (x *PriceRule) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *PriceRule) GetFromTime() string {
+	if x != nil {
+		return x.FromTime
+	}
+	return ""
+}
+
+func (x *PriceRule) GetToTime() string {
+	if x != nil {
+		return x.ToTime
+	}
+	return ""
+}
+
+type AddPriceRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *PriceRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPriceRuleRequest) Reset() {
+	*x = AddPriceRuleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPriceRuleRequest) ProtoMessage() {}
+
+func (x *AddPriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddPriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddPriceRuleRequest) GetRule() *PriceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type PriceRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule          *PriceRule             `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRuleResponse) Reset() {
+	*x = PriceRuleResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRuleResponse) ProtoMessage() {}
+
+func (x *PriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*PriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PriceRuleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PriceRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PriceRuleResponse) GetRule() *PriceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *PriceRuleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetPriceRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 returns only the rules for every venue
+	Venueid       int32 `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceRulesRequest) Reset() {
+	*x = GetPriceRulesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceRulesRequest) ProtoMessage() {}
+
+func (x *GetPriceRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceRulesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetPriceRulesRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+type GetPriceRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules         []*PriceRule           `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceRulesResponse) Reset() {
+	*x = GetPriceRulesResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceRulesResponse) ProtoMessage() {}
+
+func (x *GetPriceRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceRulesResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetPriceRulesResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetPriceRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPriceRulesResponse) GetRules() []*PriceRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetPriceRulesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeletePriceRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceRuleRequest) Reset() {
+	*x = DeletePriceRuleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleRequest) ProtoMessage() {}
+
+func (x *DeletePriceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeletePriceRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePriceRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceRuleResponse) Reset() {
+	*x = DeletePriceRuleResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceRuleResponse) ProtoMessage() {}
+
+func (x *DeletePriceRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceRuleResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeletePriceRuleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeletePriceRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeletePriceRuleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A tax such as GST or VAT, it applies to the venues charging in its currency
+type TaxRule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Currency string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Regions of the venues it applies to, empty for every region
+	RegionCodes []string     `protobuf:"bytes,4,rep,name=region_codes,json=regionCodes,proto3" json:"region_codes,omitempty"`
+	AppliesTo   TaxAppliesTo `protobuf:"varint,5,opt,name=applies_to,json=appliesTo,proto3,enum=moviedb_service.TaxAppliesTo" json:"applies_to,omitempty"`
+	// In basis points, 1800 is 18%
+	Rate int32 `protobuf:"varint,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Already part of the price, shown in the quote but not added to its total
+	Inclusive bool `protobuf:"varint,7,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	// Applies to amounts above min_amount and at or below max_amount in minor units, 0 max_amount for no limit
+	MinAmount     int64 `protobuf:"varint,8,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount     int64 `protobuf:"varint,9,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRule) Reset() {
+	*x = TaxRule{}
+	mi := &file_moviedb_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRule) ProtoMessage() {}
+
+func (x *TaxRule) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRule.ProtoReflect.Descriptor instead.
+func (*TaxRule) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TaxRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaxRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TaxRule) GetRegionCodes() []string {
+	if x != nil {
+		return x.RegionCodes
+	}
+	return nil
+}
+
+func (x *TaxRule) GetAppliesTo() TaxAppliesTo {
+	if x != nil {
+		return x.AppliesTo
+	}
+	return TaxAppliesTo_TAX_TICKET
+}
+
+func (x *TaxRule) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxRule) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *TaxRule) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *TaxRule) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+type AddTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *TaxRule               `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaxRuleRequest) Reset() {
+	*x = AddTaxRuleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaxRuleRequest) ProtoMessage() {}
+
+func (x *AddTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddTaxRuleRequest) GetRule() *TaxRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type TaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rule          *TaxRule               `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRuleResponse) Reset() {
+	*x = TaxRuleResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRuleResponse) ProtoMessage() {}
+
+func (x *TaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*TaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TaxRuleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TaxRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TaxRuleResponse) GetRule() *TaxRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *TaxRuleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetTaxRulesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty returns every rule
+	Currency      string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxRulesRequest) Reset() {
+	*x = GetTaxRulesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxRulesRequest) ProtoMessage() {}
+
+func (x *GetTaxRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxRulesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTaxRulesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetTaxRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Rules         []*TaxRule             `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxRulesResponse) Reset() {
+	*x = GetTaxRulesResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxRulesResponse) ProtoMessage() {}
+
+func (x *GetTaxRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxRulesResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetTaxRulesResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetTaxRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTaxRulesResponse) GetRules() []*TaxRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetTaxRulesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleRequest) Reset() {
+	*x = DeleteTaxRuleRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteTaxRuleRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleResponse) Reset() {
+	*x = DeleteTaxRuleResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleResponse) ProtoMessage() {}
+
+func (x *DeleteTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteTaxRuleResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DeleteTaxRuleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteTaxRuleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PriceAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceRuleId   int32                  `protobuf:"varint,1,opt,name=price_rule_id,json=priceRuleId,proto3" json:"price_rule_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	mi := &file_moviedb_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PriceAdjustment) GetPriceRuleId() int32 {
+	if x != nil {
+		return x.PriceRuleId
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type TaxAmount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     int32                  `protobuf:"varint,1,opt,name=tax_rule_id,json=taxRuleId,proto3" json:"tax_rule_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AppliesTo     TaxAppliesTo           `protobuf:"varint,3,opt,name=applies_to,json=appliesTo,proto3,enum=moviedb_service.TaxAppliesTo" json:"applies_to,omitempty"`
+	Rate          int32                  `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Inclusive     bool                   `protobuf:"varint,5,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxAmount) Reset() {
+	*x = TaxAmount{}
+	mi := &file_moviedb_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxAmount) ProtoMessage() {}
+
+func (x *TaxAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxAmount.ProtoReflect.Descriptor instead.
+func (*TaxAmount) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TaxAmount) GetTaxRuleId() int32 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+func (x *TaxAmount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxAmount) GetAppliesTo() TaxAppliesTo {
+	if x != nil {
+		return x.AppliesTo
+	}
+	return TaxAppliesTo_TAX_TICKET
+}
+
+func (x *TaxAmount) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *TaxAmount) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *TaxAmount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Breakdown of one ticket, price is base with the surcharges, discounts and rounding added
+type QuoteSeat struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookedSeatId   int32                  `protobuf:"varint,1,opt,name=booked_seat_id,json=bookedSeatId,proto3" json:"booked_seat_id,omitempty"`
+	SeatMatrixId   int32                  `protobuf:"varint,2,opt,name=seat_matrix_id,json=seatMatrixId,proto3" json:"seat_matrix_id,omitempty"`
+	SeatNumber     string                 `protobuf:"bytes,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Base           *Money                 `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	BaseRuleId     int32                  `protobuf:"varint,5,opt,name=base_rule_id,json=baseRuleId,proto3" json:"base_rule_id,omitempty"`
+	Surcharges     []*PriceAdjustment     `protobuf:"bytes,6,rep,name=surcharges,proto3" json:"surcharges,omitempty"`
+	Discounts      []*PriceAdjustment     `protobuf:"bytes,7,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Rounding       *Money                 `protobuf:"bytes,8,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	Price          *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	ConvenienceFee *Money                 `protobuf:"bytes,10,opt,name=convenience_fee,json=convenienceFee,proto3" json:"convenience_fee,omitempty"`
+	Taxes          []*TaxAmount           `protobuf:"bytes,11,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	// Price, convenience fee and the taxes that are not inclusive
+	Total         *Money `protobuf:"bytes,12,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteSeat) Reset() {
+	*x = QuoteSeat{}
+	mi := &file_moviedb_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteSeat) ProtoMessage() {}
+
+func (x *QuoteSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteSeat.ProtoReflect.Descriptor instead.
+func (*QuoteSeat) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *QuoteSeat) GetBookedSeatId() int32 {
+	if x != nil {
+		return x.BookedSeatId
+	}
+	return 0
+}
+
+func (x *QuoteSeat) GetSeatMatrixId() int32 {
+	if x != nil {
+		return x.SeatMatrixId
+	}
+	return 0
+}
+
+func (x *QuoteSeat) GetSeatNumber() string {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *QuoteSeat) GetBase() *Money {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetBaseRuleId() int32 {
+	if x != nil {
+		return x.BaseRuleId
+	}
+	return 0
+}
+
+func (x *QuoteSeat) GetSurcharges() []*PriceAdjustment {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetDiscounts() []*PriceAdjustment {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetRounding() *Money {
+	if x != nil {
+		return x.Rounding
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetConvenienceFee() *Money {
+	if x != nil {
+		return x.ConvenienceFee
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetTaxes() []*TaxAmount {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *QuoteSeat) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// Breakdown of a booking, CreateTicket charges its total
+type BookingQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId         string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	MovieTimeSlotId int32                  `protobuf:"varint,2,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	MovieName       string                 `protobuf:"bytes,3,opt,name=movie_name,json=movieName,proto3" json:"movie_name,omitempty"`
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Seats           []*QuoteSeat           `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	Base            *Money                 `protobuf:"bytes,6,opt,name=base,proto3" json:"base,omitempty"`
+	Surcharges      *Money                 `protobuf:"bytes,7,opt,name=surcharges,proto3" json:"surcharges,omitempty"`
+	Discounts       *Money                 `protobuf:"bytes,8,opt,name=discounts,proto3" json:"discounts,omitempty"`
+	Rounding        *Money                 `protobuf:"bytes,9,opt,name=rounding,proto3" json:"rounding,omitempty"`
+	ConvenienceFees *Money                 `protobuf:"bytes,10,opt,name=convenience_fees,json=convenienceFees,proto3" json:"convenience_fees,omitempty"`
+	// Summed by tax rule
+	Taxes []*TaxAmount `protobuf:"bytes,11,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	Total *Money       `protobuf:"bytes,12,opt,name=total,proto3" json:"total,omitempty"`
+	// RFC 3339
+	CreatedAt     string `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingQuote) Reset() {
+	*x = BookingQuote{}
+	mi := &file_moviedb_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingQuote) ProtoMessage() {}
+
+func (x *BookingQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,57 +7186,290 @@ func (x *AddSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*AddSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BookingQuote.ProtoReflect.Descriptor instead.
+func (*BookingQuote) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *AddSeatMatrixResponse) GetStatus() int32 {
+func (x *BookingQuote) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *BookingQuote) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *BookingQuote) GetMovieName() string {
+	if x != nil {
+		return x.MovieName
+	}
+	return ""
+}
+
+func (x *BookingQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BookingQuote) GetSeats() []*QuoteSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetBase() *Money {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetSurcharges() *Money {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetDiscounts() *Money {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetRounding() *Money {
+	if x != nil {
+		return x.Rounding
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetConvenienceFees() *Money {
+	if x != nil {
+		return x.ConvenienceFees
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetTaxes() []*TaxAmount {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *BookingQuote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetBookingQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingQuoteRequest) Reset() {
+	*x = GetBookingQuoteRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingQuoteRequest) ProtoMessage() {}
+
+func (x *GetBookingQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetBookingQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type GetBookingQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Quote         *BookingQuote          `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingQuoteResponse) Reset() {
+	*x = GetBookingQuoteResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingQuoteResponse) ProtoMessage() {}
+
+func (x *GetBookingQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetBookingQuoteResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *AddSeatMatrixResponse) GetMessage() string {
+func (x *GetBookingQuoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBookingQuoteResponse) GetQuote() *BookingQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *GetBookingQuoteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddSingleSeatMatrixInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Seat          *SeatMatrix            `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSingleSeatMatrixInput) Reset() {
+	*x = AddSingleSeatMatrixInput{}
+	mi := &file_moviedb_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSingleSeatMatrixInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSingleSeatMatrixInput) ProtoMessage() {}
+
+func (x *AddSingleSeatMatrixInput) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSingleSeatMatrixInput.ProtoReflect.Descriptor instead.
+func (*AddSingleSeatMatrixInput) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AddSingleSeatMatrixInput) GetVenueid() int32 {
 	if x != nil {
-		return x.Message
+		return x.Venueid
 	}
-	return ""
+	return 0
 }
 
-func (x *AddSeatMatrixResponse) GetError() string {
+func (x *AddSingleSeatMatrixInput) GetSeat() *SeatMatrix {
 	if x != nil {
-		return x.Error
+		return x.Seat
 	}
-	return ""
+	return nil
 }
 
-type CastAndCrew struct {
+type AddSingleSeatMatrixResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          CastAndCrewType        `protobuf:"varint,2,opt,name=type,proto3,enum=moviedb_service.CastAndCrewType" json:"type,omitempty"`
-	CharacterName string                 `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
-	Photourl      string                 `protobuf:"bytes,4,opt,name=photourl,proto3" json:"photourl,omitempty"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CastAndCrew) Reset() {
-	*x = CastAndCrew{}
-	mi := &file_moviedb_service_proto_msgTypes[3]
+func (x *AddSingleSeatMatrixResponse) Reset() {
+	*x = AddSingleSeatMatrixResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CastAndCrew) String() string {
+func (x *AddSingleSeatMatrixResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CastAndCrew) ProtoMessage() {}
+func (*AddSingleSeatMatrixResponse) ProtoMessage() {}
 
-func (x *CastAndCrew) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[3]
+func (x *AddSingleSeatMatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,67 +7480,64 @@ func (x *CastAndCrew) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CastAndCrew.ProtoReflect.Descriptor instead.
-func (*CastAndCrew) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *CastAndCrew) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use AddSingleSeatMatrixResponse.ProtoReflect.Descriptor instead.
+func (*AddSingleSeatMatrixResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *CastAndCrew) GetType() CastAndCrewType {
+func (x *AddSingleSeatMatrixResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
-	return CastAndCrewType_ACTOR
+	return 0
 }
 
-func (x *CastAndCrew) GetCharacterName() string {
+func (x *AddSingleSeatMatrixResponse) GetMessage() string {
 	if x != nil {
-		return x.CharacterName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CastAndCrew) GetPhotourl() string {
+func (x *AddSingleSeatMatrixResponse) GetError() string {
 	if x != nil {
-		return x.Photourl
+		return x.Error
 	}
 	return ""
 }
 
-type MovieTimeSlot struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	MovieFormat   SeatType               `protobuf:"varint,5,opt,name=movie_format,json=movieFormat,proto3,enum=moviedb_service.SeatType" json:"movie_format,omitempty"`
-	Movieid       int32                  `protobuf:"varint,6,opt,name=movieid,proto3" json:"movieid,omitempty"`
-	Venueid       int32                  `protobuf:"varint,7,opt,name=venueid,proto3" json:"venueid,omitempty"`
+type BookedSeats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatNumber      string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	MovieTimeSlotID int32                  `protobuf:"varint,3,opt,name=movieTimeSlotID,proto3" json:"movieTimeSlotID,omitempty"`
+	SeatMatrixID    int32                  `protobuf:"varint,4,opt,name=seatMatrixID,proto3" json:"seatMatrixID,omitempty"`
+	IsBooked        bool                   `protobuf:"varint,5,opt,name=is_booked,json=isBooked,proto3" json:"is_booked,omitempty"`
+	// Minor units of the currency of the venue, use price_money
+	//
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Price         int32  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	MovieName     string `protobuf:"bytes,9,opt,name=movieName,proto3" json:"movieName,omitempty"`
+	PriceMoney    *Money `protobuf:"bytes,10,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieTimeSlot) Reset() {
-	*x = MovieTimeSlot{}
-	mi := &file_moviedb_service_proto_msgTypes[4]
+func (x *BookedSeats) Reset() {
+	*x = BookedSeats{}
+	mi := &file_moviedb_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieTimeSlot) String() string {
+func (x *BookedSeats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieTimeSlot) ProtoMessage() {}
+func (*BookedSeats) ProtoMessage() {}
 
-func (x *MovieTimeSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[4]
+func (x *BookedSeats) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,96 +7548,96 @@ func (x *MovieTimeSlot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieTimeSlot.ProtoReflect.Descriptor instead.
-func (*MovieTimeSlot) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BookedSeats.ProtoReflect.Descriptor instead.
+func (*BookedSeats) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *MovieTimeSlot) GetStartTime() string {
+func (x *BookedSeats) GetId() int32 {
 	if x != nil {
-		return x.StartTime
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *MovieTimeSlot) GetEndTime() string {
+func (x *BookedSeats) GetSeatNumber() string {
 	if x != nil {
-		return x.EndTime
+		return x.SeatNumber
 	}
 	return ""
 }
 
-func (x *MovieTimeSlot) GetDuration() int32 {
+func (x *BookedSeats) GetMovieTimeSlotID() int32 {
 	if x != nil {
-		return x.Duration
+		return x.MovieTimeSlotID
 	}
 	return 0
 }
 
-func (x *MovieTimeSlot) GetDate() string {
+func (x *BookedSeats) GetSeatMatrixID() int32 {
 	if x != nil {
-		return x.Date
+		return x.SeatMatrixID
 	}
-	return ""
+	return 0
 }
 
-func (x *MovieTimeSlot) GetMovieFormat() SeatType {
+func (x *BookedSeats) GetIsBooked() bool {
 	if x != nil {
-		return x.MovieFormat
+		return x.IsBooked
 	}
-	return SeatType_TWO_D
+	return false
 }
 
-func (x *MovieTimeSlot) GetMovieid() int32 {
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *BookedSeats) GetPrice() int32 {
 	if x != nil {
-		return x.Movieid
+		return x.Price
 	}
 	return 0
 }
 
-func (x *MovieTimeSlot) GetVenueid() int32 {
+func (x *BookedSeats) GetMovieName() string {
 	if x != nil {
-		return x.Venueid
+		return x.MovieName
 	}
-	return 0
+	return ""
 }
 
-type Movie struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Duration        int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Language        []string               `protobuf:"bytes,4,rep,name=language,proto3" json:"language,omitempty"`
-	Type            []string               `protobuf:"bytes,5,rep,name=type,proto3" json:"type,omitempty"`
-	CastCrew        []*CastAndCrew         `protobuf:"bytes,6,rep,name=cast_crew,json=castCrew,proto3" json:"cast_crew,omitempty"`
-	PosterUrl       string                 `protobuf:"bytes,7,opt,name=poster_url,json=posterUrl,proto3" json:"poster_url,omitempty"`
-	TrailerUrl      string                 `protobuf:"bytes,8,opt,name=trailer_url,json=trailerUrl,proto3" json:"trailer_url,omitempty"`
-	ReleaseDate     string                 `protobuf:"bytes,9,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	MovieResolution []string               `protobuf:"bytes,10,rep,name=movie_resolution,json=movieResolution,proto3" json:"movie_resolution,omitempty"`
-	Venues          []*Venue               `protobuf:"bytes,11,rep,name=venues,proto3" json:"venues,omitempty"`
-	// string movieid = 12;
-	Votes         int64 `protobuf:"varint,13,opt,name=votes,proto3" json:"votes,omitempty"`
-	Ranking       int32 `protobuf:"varint,14,opt,name=ranking,proto3" json:"ranking,omitempty"`
-	Id            int32 `protobuf:"varint,15,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BookedSeats) GetPriceMoney() *Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
 }
 
-func (x *Movie) Reset() {
-	*x = Movie{}
-	mi := &file_moviedb_service_proto_msgTypes[5]
+type BookSeatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	MovieTimeSlot   *MovieTimeSlot `protobuf:"bytes,1,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
+	Seats           []*BookedSeats `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	MovieTimeSlotId int32          `protobuf:"varint,3,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	Email           string         `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneNumber     string         `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	HoldToken       string         `protobuf:"bytes,8,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BookSeatsRequest) Reset() {
+	*x = BookSeatsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Movie) String() string {
+func (x *BookSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Movie) ProtoMessage() {}
+func (*BookSeatsRequest) ProtoMessage() {}
 
-func (x *Movie) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[5]
+func (x *BookSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,144 +7648,144 @@ func (x *Movie) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Movie.ProtoReflect.Descriptor instead.
-func (*Movie) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BookSeatsRequest.ProtoReflect.Descriptor instead.
+func (*BookSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Movie) GetTitle() string {
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *BookSeatsRequest) GetMovieTimeSlot() *MovieTimeSlot {
 	if x != nil {
-		return x.Title
+		return x.MovieTimeSlot
 	}
-	return ""
+	return nil
 }
 
-func (x *Movie) GetDescription() string {
+func (x *BookSeatsRequest) GetSeats() []*BookedSeats {
 	if x != nil {
-		return x.Description
+		return x.Seats
 	}
-	return ""
+	return nil
 }
 
-func (x *Movie) GetDuration() int32 {
+func (x *BookSeatsRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Duration
+		return x.MovieTimeSlotId
 	}
 	return 0
 }
 
-func (x *Movie) GetLanguage() []string {
+func (x *BookSeatsRequest) GetEmail() string {
 	if x != nil {
-		return x.Language
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *Movie) GetType() []string {
+func (x *BookSeatsRequest) GetPhoneNumber() string {
 	if x != nil {
-		return x.Type
+		return x.PhoneNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *Movie) GetCastCrew() []*CastAndCrew {
+func (x *BookSeatsRequest) GetHoldToken() string {
 	if x != nil {
-		return x.CastCrew
+		return x.HoldToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Movie) GetPosterUrl() string {
-	if x != nil {
-		return x.PosterUrl
-	}
-	return ""
+type BookSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BookSeatsId   []int32                `protobuf:"varint,3,rep,packed,name=book_seats_id,json=bookSeatsId,proto3" json:"book_seats_id,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Movie) GetTrailerUrl() string {
-	if x != nil {
-		return x.TrailerUrl
-	}
-	return ""
+func (x *BookSeatsResponse) Reset() {
+	*x = BookSeatsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Movie) GetReleaseDate() string {
-	if x != nil {
-		return x.ReleaseDate
-	}
-	return ""
+func (x *BookSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Movie) GetMovieResolution() []string {
+func (*BookSeatsResponse) ProtoMessage() {}
+
+func (x *BookSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[91]
 	if x != nil {
-		return x.MovieResolution
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Movie) GetVenues() []*Venue {
+// Deprecated: Use BookSeatsResponse.ProtoReflect.Descriptor instead.
+func (*BookSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *BookSeatsResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Venues
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-func (x *Movie) GetVotes() int64 {
+func (x *BookSeatsResponse) GetMessage() string {
 	if x != nil {
-		return x.Votes
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Movie) GetRanking() int32 {
+func (x *BookSeatsResponse) GetBookSeatsId() []int32 {
 	if x != nil {
-		return x.Ranking
+		return x.BookSeatsId
 	}
-	return 0
+	return nil
 }
 
-func (x *Movie) GetId() int32 {
+func (x *BookSeatsResponse) GetError() string {
 	if x != nil {
-		return x.Id
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type Venue struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address              string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Type                 VenueType              `protobuf:"varint,3,opt,name=type,proto3,enum=moviedb_service.VenueType" json:"type,omitempty"`
-	Rows                 int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
-	Columns              int32                  `protobuf:"varint,5,opt,name=columns,proto3" json:"columns,omitempty"`
-	Longitude            float32                `protobuf:"fixed32,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude             float32                `protobuf:"fixed32,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	ScreenNumber         int32                  `protobuf:"varint,8,opt,name=screen_number,json=screenNumber,proto3" json:"screen_number,omitempty"`
-	Seats                []*SeatMatrix          `protobuf:"bytes,9,rep,name=seats,proto3" json:"seats,omitempty"`
-	MovieTimeSlots       []*MovieTimeSlot       `protobuf:"bytes,10,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
-	Movies               []*Movie               `protobuf:"bytes,11,rep,name=movies,proto3" json:"movies,omitempty"`
-	Id                   int32                  `protobuf:"varint,12,opt,name=id,proto3" json:"id,omitempty"`
-	MovieFormatSupported []string               `protobuf:"bytes,13,rep,name=movie_format_supported,json=movieFormatSupported,proto3" json:"movie_format_supported,omitempty"`
-	LanguageSupported    []string               `protobuf:"bytes,14,rep,name=language_supported,json=languageSupported,proto3" json:"language_supported,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetBookedSeatsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Venue) Reset() {
-	*x = Venue{}
-	mi := &file_moviedb_service_proto_msgTypes[6]
+func (x *GetBookedSeatsRequest) Reset() {
+	*x = GetBookedSeatsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Venue) String() string {
+func (x *GetBookedSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Venue) ProtoMessage() {}
+func (*GetBookedSeatsRequest) ProtoMessage() {}
 
-func (x *Venue) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[6]
+func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,131 +7796,178 @@ func (x *Venue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
-func (*Venue) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetBookedSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *Venue) GetName() string {
+func (x *GetBookedSeatsRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Name
+		return x.MovieTimeSlotId
 	}
-	return ""
+	return 0
 }
 
-func (x *Venue) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+type GetBookedSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BookedSeats   []*BookedSeats         `protobuf:"bytes,3,rep,name=booked_seats,json=bookedSeats,proto3" json:"booked_seats,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Venue) GetType() VenueType {
-	if x != nil {
-		return x.Type
-	}
-	return VenueType_MOVIE
+func (x *GetBookedSeatsResponse) Reset() {
+	*x = GetBookedSeatsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Venue) GetRows() int32 {
-	if x != nil {
-		return x.Rows
-	}
-	return 0
+func (x *GetBookedSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Venue) GetColumns() int32 {
+func (*GetBookedSeatsResponse) ProtoMessage() {}
+
+func (x *GetBookedSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[93]
 	if x != nil {
-		return x.Columns
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Venue) GetLongitude() float32 {
-	if x != nil {
-		return x.Longitude
-	}
-	return 0
+// Deprecated: Use GetBookedSeatsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *Venue) GetLatitude() float32 {
+func (x *GetBookedSeatsResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Latitude
+		return x.Status
 	}
 	return 0
 }
 
-func (x *Venue) GetScreenNumber() int32 {
+func (x *GetBookedSeatsResponse) GetMessage() string {
 	if x != nil {
-		return x.ScreenNumber
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Venue) GetSeats() []*SeatMatrix {
+func (x *GetBookedSeatsResponse) GetBookedSeats() []*BookedSeats {
 	if x != nil {
-		return x.Seats
+		return x.BookedSeats
 	}
 	return nil
 }
 
-func (x *Venue) GetMovieTimeSlots() []*MovieTimeSlot {
+func (x *GetBookedSeatsResponse) GetError() string {
 	if x != nil {
-		return x.MovieTimeSlots
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *Venue) GetMovies() []*Movie {
+type GetBookedSeatsDetailsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookedSeatsIds []int32                `protobuf:"varint,1,rep,packed,name=booked_seats_ids,json=bookedSeatsIds,proto3" json:"booked_seats_ids,omitempty"`
+	// Idempotency key of the checkout, recorded as the owner of the seat lock
+	IdempotentKey string `protobuf:"bytes,2,opt,name=idempotent_key,json=idempotentKey,proto3" json:"idempotent_key,omitempty"`
+	// Optional, 0 uses the venue's hold duration or the server default. Capped by the server
+	HoldDurationSeconds int32 `protobuf:"varint,3,opt,name=hold_duration_seconds,json=holdDurationSeconds,proto3" json:"hold_duration_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetBookedSeatsDetailsRequest) Reset() {
+	*x = GetBookedSeatsDetailsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookedSeatsDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookedSeatsDetailsRequest) ProtoMessage() {}
+
+func (x *GetBookedSeatsDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[94]
 	if x != nil {
-		return x.Movies
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Venue) GetId() int32 {
+// Deprecated: Use GetBookedSeatsDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetBookedSeatsDetailsRequest) GetBookedSeatsIds() []int32 {
 	if x != nil {
-		return x.Id
+		return x.BookedSeatsIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Venue) GetMovieFormatSupported() []string {
+func (x *GetBookedSeatsDetailsRequest) GetIdempotentKey() string {
 	if x != nil {
-		return x.MovieFormatSupported
+		return x.IdempotentKey
 	}
-	return nil
+	return ""
 }
 
-func (x *Venue) GetLanguageSupported() []string {
+func (x *GetBookedSeatsDetailsRequest) GetHoldDurationSeconds() int32 {
 	if x != nil {
-		return x.LanguageSupported
+		return x.HoldDurationSeconds
 	}
-	return nil
+	return 0
 }
 
-type MovieList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Movies        []*Movie               `protobuf:"bytes,1,rep,name=movies,proto3" json:"movies,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetBookedSeatsDetailsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Status      int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message     string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BookedSeats []*BookedSeats         `protobuf:"bytes,3,rep,name=booked_seats,json=bookedSeats,proto3" json:"booked_seats,omitempty"`
+	Error       string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Token identifying the seat hold, required by BookSeats, IsValidToCommitSeatsForBooking and CreateTicket
+	HoldToken string `protobuf:"bytes,5,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	// Expiry of the hold in RFC3339
+	LockedUntil    string `protobuf:"bytes,6,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	ExtensionsLeft int32  `protobuf:"varint,7,opt,name=extensions_left,json=extensionsLeft,proto3" json:"extensions_left,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *MovieList) Reset() {
-	*x = MovieList{}
-	mi := &file_moviedb_service_proto_msgTypes[7]
+func (x *GetBookedSeatsDetailsResponse) Reset() {
+	*x = GetBookedSeatsDetailsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieList) String() string {
+func (x *GetBookedSeatsDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieList) ProtoMessage() {}
+func (*GetBookedSeatsDetailsResponse) ProtoMessage() {}
 
-func (x *MovieList) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[7]
+func (x *GetBookedSeatsDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,42 +7978,84 @@ func (x *MovieList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieList.ProtoReflect.Descriptor instead.
-func (*MovieList) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetBookedSeatsDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *MovieList) GetMovies() []*Movie {
+func (x *GetBookedSeatsDetailsResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Movies
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetBookedSeatsDetailsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBookedSeatsDetailsResponse) GetBookedSeats() []*BookedSeats {
+	if x != nil {
+		return x.BookedSeats
 	}
 	return nil
 }
 
-type MovieRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Movieid       string                 `protobuf:"bytes,2,opt,name=movieid,proto3" json:"movieid,omitempty"`
-	Venueid       string                 `protobuf:"bytes,3,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetBookedSeatsDetailsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetBookedSeatsDetailsResponse) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *GetBookedSeatsDetailsResponse) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
+}
+
+func (x *GetBookedSeatsDetailsResponse) GetExtensionsLeft() int32 {
+	if x != nil {
+		return x.ExtensionsLeft
+	}
+	return 0
+}
+
+type IsValidToCommitSeatsForBooking_Request struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	SeatMatrixIds   []int32                `protobuf:"varint,2,rep,packed,name=seatMatrixIds,proto3" json:"seatMatrixIds,omitempty"`
+	HoldToken       string                 `protobuf:"bytes,3,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MovieRequest) Reset() {
-	*x = MovieRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[8]
+func (x *IsValidToCommitSeatsForBooking_Request) Reset() {
+	*x = IsValidToCommitSeatsForBooking_Request{}
+	mi := &file_moviedb_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieRequest) String() string {
+func (x *IsValidToCommitSeatsForBooking_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieRequest) ProtoMessage() {}
+func (*IsValidToCommitSeatsForBooking_Request) ProtoMessage() {}
 
-func (x *MovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[8]
+func (x *IsValidToCommitSeatsForBooking_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,57 +8066,58 @@ func (x *MovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieRequest.ProtoReflect.Descriptor instead.
-func (*MovieRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use IsValidToCommitSeatsForBooking_Request.ProtoReflect.Descriptor instead.
+func (*IsValidToCommitSeatsForBooking_Request) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *MovieRequest) GetTitle() string {
+func (x *IsValidToCommitSeatsForBooking_Request) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Title
+		return x.MovieTimeSlotId
 	}
-	return ""
+	return 0
 }
 
-func (x *MovieRequest) GetMovieid() string {
+func (x *IsValidToCommitSeatsForBooking_Request) GetSeatMatrixIds() []int32 {
 	if x != nil {
-		return x.Movieid
+		return x.SeatMatrixIds
 	}
-	return ""
+	return nil
 }
 
-func (x *MovieRequest) GetVenueid() string {
+func (x *IsValidToCommitSeatsForBooking_Request) GetHoldToken() string {
 	if x != nil {
-		return x.Venueid
+		return x.HoldToken
 	}
 	return ""
 }
 
-type MovieResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Movie         *Movie                 `protobuf:"bytes,3,opt,name=movie,proto3" json:"movie,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type IsValidToCommitSeatsForBooking_Response struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Isvalid         bool                   `protobuf:"varint,1,opt,name=isvalid,proto3" json:"isvalid,omitempty"`
+	Error           string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status          int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	ToBeBookedSeats []*BookedSeats         `protobuf:"bytes,4,rep,name=toBeBookedSeats,proto3" json:"toBeBookedSeats,omitempty"`
+	Quote           *BookingQuote          `protobuf:"bytes,5,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MovieResponse) Reset() {
-	*x = MovieResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[9]
+func (x *IsValidToCommitSeatsForBooking_Response) Reset() {
+	*x = IsValidToCommitSeatsForBooking_Response{}
+	mi := &file_moviedb_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieResponse) String() string {
+func (x *IsValidToCommitSeatsForBooking_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieResponse) ProtoMessage() {}
+func (*IsValidToCommitSeatsForBooking_Response) ProtoMessage() {}
 
-func (x *MovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[9]
+func (x *IsValidToCommitSeatsForBooking_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,64 +8128,72 @@ func (x *MovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieResponse.ProtoReflect.Descriptor instead.
-func (*MovieResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use IsValidToCommitSeatsForBooking_Response.ProtoReflect.Descriptor instead.
+func (*IsValidToCommitSeatsForBooking_Response) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *MovieResponse) GetStatus() int32 {
+func (x *IsValidToCommitSeatsForBooking_Response) GetIsvalid() bool {
 	if x != nil {
-		return x.Status
+		return x.Isvalid
 	}
-	return 0
+	return false
 }
 
-func (x *MovieResponse) GetMessage() string {
+func (x *IsValidToCommitSeatsForBooking_Response) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-func (x *MovieResponse) GetMovie() *Movie {
+func (x *IsValidToCommitSeatsForBooking_Response) GetStatus() int32 {
 	if x != nil {
-		return x.Movie
+		return x.Status
+	}
+	return 0
+}
+
+func (x *IsValidToCommitSeatsForBooking_Response) GetToBeBookedSeats() []*BookedSeats {
+	if x != nil {
+		return x.ToBeBookedSeats
 	}
 	return nil
 }
 
-func (x *MovieResponse) GetError() string {
+func (x *IsValidToCommitSeatsForBooking_Response) GetQuote() *BookingQuote {
 	if x != nil {
-		return x.Error
+		return x.Quote
 	}
-	return ""
+	return nil
 }
 
-type MovieListResponse struct {
+type CreateTicketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	MovieList     *MovieList             `protobuf:"bytes,3,opt,name=movie_list,json=movieList,proto3" json:"movie_list,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	IdempotentKey string                 `protobuf:"bytes,1,opt,name=idempotent_key,json=idempotentKey,proto3" json:"idempotent_key,omitempty"`
+	TrasactionId  string                 `protobuf:"bytes,2,opt,name=trasaction_id,json=trasactionId,proto3" json:"trasaction_id,omitempty"`
+	HoldToken     string                 `protobuf:"bytes,3,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	// Quote returned by IsValidToCommitSeatsForBooking, empty for the latest quote of the hold
+	QuoteId       string `protobuf:"bytes,4,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieListResponse) Reset() {
-	*x = MovieListResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[10]
+func (x *CreateTicketRequest) Reset() {
+	*x = CreateTicketRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieListResponse) String() string {
+func (x *CreateTicketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieListResponse) ProtoMessage() {}
+func (*CreateTicketRequest) ProtoMessage() {}
 
-func (x *MovieListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[10]
+func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,64 +8204,62 @@ func (x *MovieListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieListResponse.ProtoReflect.Descriptor instead.
-func (*MovieListResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CreateTicketRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *MovieListResponse) GetStatus() int32 {
+func (x *CreateTicketRequest) GetIdempotentKey() string {
 	if x != nil {
-		return x.Status
+		return x.IdempotentKey
 	}
-	return 0
+	return ""
 }
 
-func (x *MovieListResponse) GetMessage() string {
+func (x *CreateTicketRequest) GetTrasactionId() string {
 	if x != nil {
-		return x.Message
+		return x.TrasactionId
 	}
 	return ""
 }
 
-func (x *MovieListResponse) GetMovieList() *MovieList {
+func (x *CreateTicketRequest) GetHoldToken() string {
 	if x != nil {
-		return x.MovieList
+		return x.HoldToken
 	}
-	return nil
+	return ""
 }
 
-func (x *MovieListResponse) GetError() string {
+func (x *CreateTicketRequest) GetQuoteId() string {
 	if x != nil {
-		return x.Error
+		return x.QuoteId
 	}
 	return ""
 }
 
-type VenueResponse struct {
+type CreateRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Venue         *Venue                 `protobuf:"bytes,3,opt,name=Venue,proto3" json:"Venue,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VenueResponse) Reset() {
-	*x = VenueResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[11]
+func (x *CreateRequestResponse) Reset() {
+	*x = CreateRequestResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VenueResponse) String() string {
+func (x *CreateRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VenueResponse) ProtoMessage() {}
+func (*CreateRequestResponse) ProtoMessage() {}
 
-func (x *VenueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[11]
+func (x *CreateRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,61 +8270,48 @@ func (x *VenueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VenueResponse.ProtoReflect.Descriptor instead.
-func (*VenueResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CreateRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateRequestResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *VenueResponse) GetStatus() int32 {
+func (x *CreateRequestResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *VenueResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *VenueResponse) GetVenue() *Venue {
-	if x != nil {
-		return x.Venue
-	}
-	return nil
-}
-
-func (x *VenueResponse) GetError() string {
+func (x *CreateRequestResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type GetUpcomingMovieRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReleaseExpiredSeatLocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restricts the sweep to a single movie time slot, 0 sweeps every time slot
+	MovieTimeSlotId int32 `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetUpcomingMovieRequest) Reset() {
-	*x = GetUpcomingMovieRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[12]
+func (x *ReleaseExpiredSeatLocksRequest) Reset() {
+	*x = ReleaseExpiredSeatLocksRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingMovieRequest) String() string {
+func (x *ReleaseExpiredSeatLocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingMovieRequest) ProtoMessage() {}
+func (*ReleaseExpiredSeatLocksRequest) ProtoMessage() {}
 
-func (x *GetUpcomingMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[12]
+func (x *ReleaseExpiredSeatLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,43 +8322,43 @@ func (x *GetUpcomingMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingMovieRequest.ProtoReflect.Descriptor instead.
-func (*GetUpcomingMovieRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ReleaseExpiredSeatLocksRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredSeatLocksRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetUpcomingMovieRequest) GetDate() string {
+func (x *ReleaseExpiredSeatLocksRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Date
+		return x.MovieTimeSlotId
 	}
-	return ""
+	return 0
 }
 
-type GetUpcomingMovieResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	MovieList     []*Movie               `protobuf:"bytes,3,rep,name=movie_list,json=movieList,proto3" json:"movie_list,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReleaseExpiredSeatLocksResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Status                 int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message                string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReleasedBookedSeatsIds []int32                `protobuf:"varint,3,rep,packed,name=released_booked_seats_ids,json=releasedBookedSeatsIds,proto3" json:"released_booked_seats_ids,omitempty"`
+	Error                  string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetUpcomingMovieResponse) Reset() {
-	*x = GetUpcomingMovieResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[13]
+func (x *ReleaseExpiredSeatLocksResponse) Reset() {
+	*x = ReleaseExpiredSeatLocksResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingMovieResponse) String() string {
+func (x *ReleaseExpiredSeatLocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingMovieResponse) ProtoMessage() {}
+func (*ReleaseExpiredSeatLocksResponse) ProtoMessage() {}
 
-func (x *GetUpcomingMovieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[13]
+func (x *ReleaseExpiredSeatLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,62 +8369,63 @@ func (x *GetUpcomingMovieResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingMovieResponse.ProtoReflect.Descriptor instead.
-func (*GetUpcomingMovieResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ReleaseExpiredSeatLocksResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseExpiredSeatLocksResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetUpcomingMovieResponse) GetStatus() int32 {
+func (x *ReleaseExpiredSeatLocksResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *GetUpcomingMovieResponse) GetMessage() string {
+func (x *ReleaseExpiredSeatLocksResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetUpcomingMovieResponse) GetMovieList() []*Movie {
+func (x *ReleaseExpiredSeatLocksResponse) GetReleasedBookedSeatsIds() []int32 {
 	if x != nil {
-		return x.MovieList
+		return x.ReleasedBookedSeatsIds
 	}
 	return nil
 }
 
-func (x *GetUpcomingMovieResponse) GetError() string {
+func (x *ReleaseExpiredSeatLocksResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type GetNowPlayingMovieRequest struct {
+type ReleaseSeatLocksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Longitude     int64                  `protobuf:"varint,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude      int64                  `protobuf:"varint,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IdempotentKey string                 `protobuf:"bytes,1,opt,name=idempotent_key,json=idempotentKey,proto3" json:"idempotent_key,omitempty"`
+	// Optional, when empty every seat held by the idempotent key is released
+	BookedSeatsIds []int32 `protobuf:"varint,2,rep,packed,name=booked_seats_ids,json=bookedSeatsIds,proto3" json:"booked_seats_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetNowPlayingMovieRequest) Reset() {
-	*x = GetNowPlayingMovieRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[14]
+func (x *ReleaseSeatLocksRequest) Reset() {
+	*x = ReleaseSeatLocksRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNowPlayingMovieRequest) String() string {
+func (x *ReleaseSeatLocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNowPlayingMovieRequest) ProtoMessage() {}
+func (*ReleaseSeatLocksRequest) ProtoMessage() {}
 
-func (x *GetNowPlayingMovieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[14]
+func (x *ReleaseSeatLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,54 +8436,50 @@ func (x *GetNowPlayingMovieRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNowPlayingMovieRequest.ProtoReflect.Descriptor instead.
-func (*GetNowPlayingMovieRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReleaseSeatLocksRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatLocksRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetNowPlayingMovieRequest) GetLongitude() int64 {
+func (x *ReleaseSeatLocksRequest) GetIdempotentKey() string {
 	if x != nil {
-		return x.Longitude
+		return x.IdempotentKey
 	}
-	return 0
+	return ""
 }
 
-func (x *GetNowPlayingMovieRequest) GetLatitude() int64 {
+func (x *ReleaseSeatLocksRequest) GetBookedSeatsIds() []int32 {
 	if x != nil {
-		return x.Latitude
+		return x.BookedSeatsIds
 	}
-	return 0
-}
-
-type Review struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieID       int32                  `protobuf:"varint,1,opt,name=movieID,proto3" json:"movieID,omitempty"`
-	UserID        int32                  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	ReviewID      int32                  `protobuf:"varint,6,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
-	CreatedAt     int32                  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ReviewerName  string                 `protobuf:"bytes,8,opt,name=reviewerName,proto3" json:"reviewerName,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
-	mi := &file_moviedb_service_proto_msgTypes[15]
+type ReleaseSeatLocksResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Status                 int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message                string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ReleasedBookedSeatsIds []int32                `protobuf:"varint,3,rep,packed,name=released_booked_seats_ids,json=releasedBookedSeatsIds,proto3" json:"released_booked_seats_ids,omitempty"`
+	Error                  string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatLocksResponse) Reset() {
+	*x = ReleaseSeatLocksResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Review) String() string {
+func (x *ReleaseSeatLocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*ReleaseSeatLocksResponse) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[15]
+func (x *ReleaseSeatLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,94 +8490,66 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *Review) GetMovieID() int32 {
-	if x != nil {
-		return x.MovieID
-	}
-	return 0
-}
-
-func (x *Review) GetUserID() int32 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use ReleaseSeatLocksResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatLocksResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *Review) GetRating() int32 {
+func (x *ReleaseSeatLocksResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Status
 	}
 	return 0
 }
 
-func (x *Review) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-func (x *Review) GetTitle() string {
+func (x *ReleaseSeatLocksResponse) GetMessage() string {
 	if x != nil {
-		return x.Title
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Review) GetReviewID() int32 {
-	if x != nil {
-		return x.ReviewID
-	}
-	return 0
-}
-
-func (x *Review) GetCreatedAt() int32 {
+func (x *ReleaseSeatLocksResponse) GetReleasedBookedSeatsIds() []int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ReleasedBookedSeatsIds
 	}
-	return 0
+	return nil
 }
 
-func (x *Review) GetReviewerName() string {
+func (x *ReleaseSeatLocksResponse) GetError() string {
 	if x != nil {
-		return x.ReviewerName
+		return x.Error
 	}
 	return ""
 }
 
-type ReviewUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        int32                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ReviewID      int32                  `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
-	MovieID       int32                  `protobuf:"varint,3,opt,name=movieID,proto3" json:"movieID,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Rating        int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
+type GetMovieShowtimesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Movieid int32                  `protobuf:"varint,1,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	// Optional filters
+	Venueid     int32  `protobuf:"varint,2,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	MovieFormat string `protobuf:"bytes,3,opt,name=movie_format,json=movieFormat,proto3" json:"movie_format,omitempty"`
+	// YYYY-MM-DD
+	Date          string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReviewUpdateRequest) Reset() {
-	*x = ReviewUpdateRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[16]
+func (x *GetMovieShowtimesRequest) Reset() {
+	*x = GetMovieShowtimesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewUpdateRequest) String() string {
+func (x *GetMovieShowtimesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewUpdateRequest) ProtoMessage() {}
+func (*GetMovieShowtimesRequest) ProtoMessage() {}
 
-func (x *ReviewUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[16]
+func (x *GetMovieShowtimesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,78 +8560,64 @@ func (x *ReviewUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewUpdateRequest.ProtoReflect.Descriptor instead.
-func (*ReviewUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *ReviewUpdateRequest) GetUserID() int32 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use GetMovieShowtimesRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieShowtimesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ReviewUpdateRequest) GetReviewID() int32 {
+func (x *GetMovieShowtimesRequest) GetMovieid() int32 {
 	if x != nil {
-		return x.ReviewID
+		return x.Movieid
 	}
 	return 0
 }
 
-func (x *ReviewUpdateRequest) GetMovieID() int32 {
+func (x *GetMovieShowtimesRequest) GetVenueid() int32 {
 	if x != nil {
-		return x.MovieID
+		return x.Venueid
 	}
 	return 0
 }
 
-func (x *ReviewUpdateRequest) GetComment() string {
+func (x *GetMovieShowtimesRequest) GetMovieFormat() string {
 	if x != nil {
-		return x.Comment
+		return x.MovieFormat
 	}
 	return ""
 }
 
-func (x *ReviewUpdateRequest) GetTitle() string {
+func (x *GetMovieShowtimesRequest) GetDate() string {
 	if x != nil {
-		return x.Title
+		return x.Date
 	}
 	return ""
 }
 
-func (x *ReviewUpdateRequest) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-type ReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Review        *Review                `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetMovieShowtimesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlots []*MovieTimeSlot       `protobuf:"bytes,3,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ReviewResponse) Reset() {
-	*x = ReviewResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[17]
+func (x *GetMovieShowtimesResponse) Reset() {
+	*x = GetMovieShowtimesResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewResponse) String() string {
+func (x *GetMovieShowtimesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewResponse) ProtoMessage() {}
+func (*GetMovieShowtimesResponse) ProtoMessage() {}
 
-func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[17]
+func (x *GetMovieShowtimesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,63 +8628,76 @@ func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
-func (*ReviewResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetMovieShowtimesResponse.ProtoReflect.Descriptor instead.
+func (*GetMovieShowtimesResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ReviewResponse) GetStatus() int32 {
+func (x *GetMovieShowtimesResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *ReviewResponse) GetMessage() string {
+func (x *GetMovieShowtimesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ReviewResponse) GetReview() *Review {
+func (x *GetMovieShowtimesResponse) GetMovieTimeSlots() []*MovieTimeSlot {
 	if x != nil {
-		return x.Review
+		return x.MovieTimeSlots
 	}
 	return nil
 }
 
-func (x *ReviewResponse) GetError() string {
+func (x *GetMovieShowtimesResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type ReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        int32                  `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ReviewID      int32                  `protobuf:"varint,2,opt,name=reviewID,proto3" json:"reviewID,omitempty"`
-	MovieID       int32                  `protobuf:"varint,3,opt,name=movieID,proto3" json:"movieID,omitempty"`
+type ShowSeat struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	BookedSeatId int32                  `protobuf:"varint,1,opt,name=booked_seat_id,json=bookedSeatId,proto3" json:"booked_seat_id,omitempty"`
+	SeatMatrixId int32                  `protobuf:"varint,2,opt,name=seat_matrix_id,json=seatMatrixId,proto3" json:"seat_matrix_id,omitempty"`
+	SeatNumber   string                 `protobuf:"bytes,3,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	Row          int32                  `protobuf:"varint,4,opt,name=row,proto3" json:"row,omitempty"`
+	Column       int32                  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	Type         SeatType               `protobuf:"varint,6,opt,name=type,proto3,enum=moviedb_service.SeatType" json:"type,omitempty"`
+	// Minor units of the currency of the venue, use price_money
+	//
+	// Deprecated: Marked as deprecated in moviedb_service.proto.
+	Price  int32      `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	Status SeatStatus `protobuf:"varint,8,opt,name=status,proto3,enum=moviedb_service.SeatStatus" json:"status,omitempty"`
+	// Expiry of the hold in RFC3339, only set for held seats
+	HeldUntil string `protobuf:"bytes,9,opt,name=held_until,json=heldUntil,proto3" json:"held_until,omitempty"`
+	// Why a blocked seat is off sale, one of the SeatBlockReason names without BLOCK_ or REMOVED for a seat taken out of the venue
+	BlockReason   string `protobuf:"bytes,10,opt,name=block_reason,json=blockReason,proto3" json:"block_reason,omitempty"`
+	PriceMoney    *Money `protobuf:"bytes,11,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReviewRequest) Reset() {
-	*x = ReviewRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[18]
+func (x *ShowSeat) Reset() {
+	*x = ShowSeat{}
+	mi := &file_moviedb_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewRequest) String() string {
+func (x *ShowSeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewRequest) ProtoMessage() {}
+func (*ShowSeat) ProtoMessage() {}
 
-func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[18]
+func (x *ShowSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,103 +8708,120 @@ func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
-func (*ReviewRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ShowSeat.ProtoReflect.Descriptor instead.
+func (*ShowSeat) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ReviewRequest) GetUserID() int32 {
+func (x *ShowSeat) GetBookedSeatId() int32 {
 	if x != nil {
-		return x.UserID
+		return x.BookedSeatId
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetReviewID() int32 {
+func (x *ShowSeat) GetSeatMatrixId() int32 {
 	if x != nil {
-		return x.ReviewID
+		return x.SeatMatrixId
 	}
 	return 0
 }
 
-func (x *ReviewRequest) GetMovieID() int32 {
+func (x *ShowSeat) GetSeatNumber() string {
 	if x != nil {
-		return x.MovieID
+		return x.SeatNumber
+	}
+	return ""
+}
+
+func (x *ShowSeat) GetRow() int32 {
+	if x != nil {
+		return x.Row
 	}
 	return 0
 }
 
-type ReviewList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShowSeat) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
 }
 
-func (x *ReviewList) Reset() {
-	*x = ReviewList{}
-	mi := &file_moviedb_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ShowSeat) GetType() SeatType {
+	if x != nil {
+		return x.Type
+	}
+	return SeatType_TWO_D
 }
 
-func (x *ReviewList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Marked as deprecated in moviedb_service.proto.
+func (x *ShowSeat) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (*ReviewList) ProtoMessage() {}
+func (x *ShowSeat) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_FREE
+}
 
-func (x *ReviewList) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[19]
+func (x *ShowSeat) GetHeldUntil() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HeldUntil
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReviewList.ProtoReflect.Descriptor instead.
-func (*ReviewList) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{19}
+func (x *ShowSeat) GetBlockReason() string {
+	if x != nil {
+		return x.BlockReason
+	}
+	return ""
 }
 
-func (x *ReviewList) GetReviews() []*Review {
+func (x *ShowSeat) GetPriceMoney() *Money {
 	if x != nil {
-		return x.Reviews
+		return x.PriceMoney
 	}
 	return nil
 }
 
-type ReviewListResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Status           int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ReviewList       *ReviewList            `protobuf:"bytes,3,opt,name=review_list,json=reviewList,proto3" json:"review_list,omitempty"`
-	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	TotalReviewCount int32                  `protobuf:"varint,5,opt,name=totalReviewCount,proto3" json:"totalReviewCount,omitempty"`
-	TotalVotes       int32                  `protobuf:"varint,6,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SeatBlock struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Venueid      int32                  `protobuf:"varint,2,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	SeatMatrixId int32                  `protobuf:"varint,3,opt,name=seat_matrix_id,json=seatMatrixId,proto3" json:"seat_matrix_id,omitempty"`
+	// 0 when the block covers every show of the venue between start_time and end_time
+	MovieTimeSlotId int32           `protobuf:"varint,4,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	Reason          SeatBlockReason `protobuf:"varint,5,opt,name=reason,proto3,enum=moviedb_service.SeatBlockReason" json:"reason,omitempty"`
+	Note            string          `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	// RFC3339, empty for a block without a start or end
+	StartTime     string `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReviewListResponse) Reset() {
-	*x = ReviewListResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[20]
+func (x *SeatBlock) Reset() {
+	*x = SeatBlock{}
+	mi := &file_moviedb_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReviewListResponse) String() string {
+func (x *SeatBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReviewListResponse) ProtoMessage() {}
+func (*SeatBlock) ProtoMessage() {}
 
-func (x *ReviewListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[20]
+func (x *SeatBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,79 +8832,98 @@ func (x *ReviewListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReviewListResponse.ProtoReflect.Descriptor instead.
-func (*ReviewListResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SeatBlock.ProtoReflect.Descriptor instead.
+func (*SeatBlock) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ReviewListResponse) GetStatus() int32 {
+func (x *SeatBlock) GetId() int32 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ReviewListResponse) GetMessage() string {
+func (x *SeatBlock) GetVenueid() int32 {
 	if x != nil {
-		return x.Message
+		return x.Venueid
 	}
-	return ""
+	return 0
 }
 
-func (x *ReviewListResponse) GetReviewList() *ReviewList {
+func (x *SeatBlock) GetSeatMatrixId() int32 {
 	if x != nil {
-		return x.ReviewList
+		return x.SeatMatrixId
 	}
-	return nil
+	return 0
 }
 
-func (x *ReviewListResponse) GetError() string {
+func (x *SeatBlock) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Error
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *SeatBlock) GetReason() SeatBlockReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SeatBlockReason_BLOCK_BROKEN
+}
+
+func (x *SeatBlock) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-func (x *ReviewListResponse) GetTotalReviewCount() int32 {
+func (x *SeatBlock) GetStartTime() string {
 	if x != nil {
-		return x.TotalReviewCount
+		return x.StartTime
 	}
-	return 0
+	return ""
 }
 
-func (x *ReviewListResponse) GetTotalVotes() int32 {
+func (x *SeatBlock) GetEndTime() string {
 	if x != nil {
-		return x.TotalVotes
+		return x.EndTime
 	}
-	return 0
+	return ""
 }
 
-type GetAllMovieReviewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieID       int32                  `protobuf:"varint,1,opt,name=movieID,proto3" json:"movieID,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	SortBy        SortBy                 `protobuf:"varint,5,opt,name=sortBy,proto3,enum=moviedb_service.SortBy" json:"sortBy,omitempty"`
-	FilterBy      FilterBy               `protobuf:"varint,6,opt,name=filterBy,proto3,enum=moviedb_service.FilterBy" json:"filterBy,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Sold or held seats cannot be blocked for a show the block covers
+type BlockSeatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required unless movie_time_slot_id is given
+	Venueid int32 `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// Only block the seats for this show, start_time and end_time have to be empty then
+	MovieTimeSlotId int32           `protobuf:"varint,2,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	SeatMatrixIds   []int32         `protobuf:"varint,3,rep,packed,name=seat_matrix_ids,json=seatMatrixIds,proto3" json:"seat_matrix_ids,omitempty"`
+	Reason          SeatBlockReason `protobuf:"varint,4,opt,name=reason,proto3,enum=moviedb_service.SeatBlockReason" json:"reason,omitempty"`
+	Note            string          `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	StartTime       string          `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         string          `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetAllMovieReviewsRequest) Reset() {
-	*x = GetAllMovieReviewsRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[21]
+func (x *BlockSeatsRequest) Reset() {
+	*x = BlockSeatsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllMovieReviewsRequest) String() string {
+func (x *BlockSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllMovieReviewsRequest) ProtoMessage() {}
+func (*BlockSeatsRequest) ProtoMessage() {}
 
-func (x *GetAllMovieReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[21]
+func (x *BlockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,76 +8934,82 @@ func (x *GetAllMovieReviewsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllMovieReviewsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllMovieReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BlockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*BlockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetAllMovieReviewsRequest) GetMovieID() int32 {
+func (x *BlockSeatsRequest) GetVenueid() int32 {
 	if x != nil {
-		return x.MovieID
+		return x.Venueid
 	}
 	return 0
 }
 
-func (x *GetAllMovieReviewsRequest) GetLimit() int32 {
+func (x *BlockSeatsRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Limit
+		return x.MovieTimeSlotId
 	}
 	return 0
 }
 
-func (x *GetAllMovieReviewsRequest) GetOffset() int32 {
+func (x *BlockSeatsRequest) GetSeatMatrixIds() []int32 {
 	if x != nil {
-		return x.Offset
+		return x.SeatMatrixIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetAllMovieReviewsRequest) GetSortBy() SortBy {
+func (x *BlockSeatsRequest) GetReason() SeatBlockReason {
 	if x != nil {
-		return x.SortBy
+		return x.Reason
 	}
-	return SortBy_ASCENDING
+	return SeatBlockReason_BLOCK_BROKEN
 }
 
-func (x *GetAllMovieReviewsRequest) GetFilterBy() FilterBy {
+func (x *BlockSeatsRequest) GetNote() string {
 	if x != nil {
-		return x.FilterBy
+		return x.Note
 	}
-	return FilterBy_RATING
+	return ""
 }
 
-type GetMovieTimeSlotRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Movieid   string                 `protobuf:"bytes,1,opt,name=movieid,proto3" json:"movieid,omitempty"`
-	StartDate string                 `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate   string                 `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	// Deprecated: Marked as deprecated in moviedb_service.proto.
-	OldLongitude int64 `protobuf:"varint,5,opt,name=old_longitude,json=oldLongitude,proto3" json:"old_longitude,omitempty"`
-	// Deprecated: Marked as deprecated in moviedb_service.proto.
-	OldLatitude   int64   `protobuf:"varint,6,opt,name=old_latitude,json=oldLatitude,proto3" json:"old_latitude,omitempty"`
-	Longitude     float32 `protobuf:"fixed32,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude      float32 `protobuf:"fixed32,8,opt,name=latitude,proto3" json:"latitude,omitempty"`
+func (x *BlockSeatsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type UnblockSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockIds      []int32                `protobuf:"varint,1,rep,packed,name=block_ids,json=blockIds,proto3" json:"block_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMovieTimeSlotRequest) Reset() {
-	*x = GetMovieTimeSlotRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[22]
+func (x *UnblockSeatsRequest) Reset() {
+	*x = UnblockSeatsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMovieTimeSlotRequest) String() string {
+func (x *UnblockSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMovieTimeSlotRequest) ProtoMessage() {}
+func (*UnblockSeatsRequest) ProtoMessage() {}
 
-func (x *GetMovieTimeSlotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[22]
+func (x *UnblockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,88 +9020,103 @@ func (x *GetMovieTimeSlotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMovieTimeSlotRequest.ProtoReflect.Descriptor instead.
-func (*GetMovieTimeSlotRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UnblockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetMovieTimeSlotRequest) GetMovieid() string {
+func (x *UnblockSeatsRequest) GetBlockIds() []int32 {
 	if x != nil {
-		return x.Movieid
+		return x.BlockIds
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMovieTimeSlotRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+type UnblockSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMovieTimeSlotRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+func (x *UnblockSeatsResponse) Reset() {
+	*x = UnblockSeatsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-// Deprecated: Marked as deprecated in moviedb_service.proto.
-func (x *GetMovieTimeSlotRequest) GetOldLongitude() int64 {
+func (x *UnblockSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsResponse) ProtoMessage() {}
+
+func (x *UnblockSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[110]
 	if x != nil {
-		return x.OldLongitude
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// Deprecated: Marked as deprecated in moviedb_service.proto.
-func (x *GetMovieTimeSlotRequest) GetOldLatitude() int64 {
+// Deprecated: Use UnblockSeatsResponse.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UnblockSeatsResponse) GetStatus() int32 {
 	if x != nil {
-		return x.OldLatitude
+		return x.Status
 	}
 	return 0
 }
 
-func (x *GetMovieTimeSlotRequest) GetLongitude() float32 {
+func (x *UnblockSeatsResponse) GetMessage() string {
 	if x != nil {
-		return x.Longitude
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMovieTimeSlotRequest) GetLatitude() float32 {
+func (x *UnblockSeatsResponse) GetError() string {
 	if x != nil {
-		return x.Latitude
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type GetMovieTimeSlotResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	MovieTimeSlots []*MovieTimeSlot       `protobuf:"bytes,3,rep,name=movie_time_slots,json=movieTimeSlots,proto3" json:"movie_time_slots,omitempty"`
-	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Venues         []*Venue               `protobuf:"bytes,5,rep,name=venues,proto3" json:"venues,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetSeatBlocksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Blocks of the venue that did not end
+	Venueid int32 `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// Blocks that cover this show instead
+	MovieTimeSlotId int32 `protobuf:"varint,2,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetMovieTimeSlotResponse) Reset() {
-	*x = GetMovieTimeSlotResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[23]
+func (x *GetSeatBlocksRequest) Reset() {
+	*x = GetSeatBlocksRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMovieTimeSlotResponse) String() string {
+func (x *GetSeatBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMovieTimeSlotResponse) ProtoMessage() {}
+func (*GetSeatBlocksRequest) ProtoMessage() {}
 
-func (x *GetMovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[23]
+func (x *GetSeatBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,70 +9127,50 @@ func (x *GetMovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMovieTimeSlotResponse.ProtoReflect.Descriptor instead.
-func (*GetMovieTimeSlotResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetSeatBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetMovieTimeSlotResponse) GetStatus() int32 {
+func (x *GetSeatBlocksRequest) GetVenueid() int32 {
 	if x != nil {
-		return x.Status
+		return x.Venueid
 	}
 	return 0
 }
 
-func (x *GetMovieTimeSlotResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *GetMovieTimeSlotResponse) GetMovieTimeSlots() []*MovieTimeSlot {
-	if x != nil {
-		return x.MovieTimeSlots
-	}
-	return nil
-}
-
-func (x *GetMovieTimeSlotResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *GetMovieTimeSlotResponse) GetVenues() []*Venue {
+func (x *GetSeatBlocksRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Venues
+		return x.MovieTimeSlotId
 	}
-	return nil
+	return 0
 }
 
-type MovieTimeSlotResponse struct {
+type SeatBlocksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Blocks        []*SeatBlock           `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieTimeSlotResponse) Reset() {
-	*x = MovieTimeSlotResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[24]
+func (x *SeatBlocksResponse) Reset() {
+	*x = SeatBlocksResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieTimeSlotResponse) String() string {
+func (x *SeatBlocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieTimeSlotResponse) ProtoMessage() {}
+func (*SeatBlocksResponse) ProtoMessage() {}
 
-func (x *MovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[24]
+func (x *SeatBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,57 +9181,69 @@ func (x *MovieTimeSlotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieTimeSlotResponse.ProtoReflect.Descriptor instead.
-func (*MovieTimeSlotResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SeatBlocksResponse.ProtoReflect.Descriptor instead.
+func (*SeatBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *MovieTimeSlotResponse) GetStatus() int32 {
+func (x *SeatBlocksResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *MovieTimeSlotResponse) GetMessage() string {
+func (x *SeatBlocksResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *MovieTimeSlotResponse) GetError() string {
+func (x *SeatBlocksResponse) GetBlocks() []*SeatBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *SeatBlocksResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type MovieTimeSlotUpdateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	MovieTimeSlot *MovieTimeSlot         `protobuf:"bytes,3,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+type GetShowSeatLayoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the show directly, otherwise it is looked up from the fields below
+	MovieTimeSlotId int32  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	Movieid         int32  `protobuf:"varint,2,opt,name=movieid,proto3" json:"movieid,omitempty"`
+	Venueid         int32  `protobuf:"varint,3,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	MovieFormat     string `protobuf:"bytes,4,opt,name=movie_format,json=movieFormat,proto3" json:"movie_format,omitempty"`
+	// YYYY-MM-DD, used together with a HH:MM start_time
+	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// RFC3339 or HH:MM
+	StartTime     string `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieTimeSlotUpdateResponse) Reset() {
-	*x = MovieTimeSlotUpdateResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[25]
+func (x *GetShowSeatLayoutRequest) Reset() {
+	*x = GetShowSeatLayoutRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieTimeSlotUpdateResponse) String() string {
+func (x *GetShowSeatLayoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieTimeSlotUpdateResponse) ProtoMessage() {}
+func (*GetShowSeatLayoutRequest) ProtoMessage() {}
 
-func (x *MovieTimeSlotUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[25]
+func (x *GetShowSeatLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,68 +9254,81 @@ func (x *MovieTimeSlotUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieTimeSlotUpdateResponse.ProtoReflect.Descriptor instead.
-func (*MovieTimeSlotUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetShowSeatLayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetShowSeatLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *MovieTimeSlotUpdateResponse) GetStatus() int32 {
+func (x *GetShowSeatLayoutRequest) GetMovieTimeSlotId() int32 {
 	if x != nil {
-		return x.Status
+		return x.MovieTimeSlotId
 	}
 	return 0
 }
 
-func (x *MovieTimeSlotUpdateResponse) GetMessage() string {
+func (x *GetShowSeatLayoutRequest) GetMovieid() int32 {
 	if x != nil {
-		return x.Message
+		return x.Movieid
+	}
+	return 0
+}
+
+func (x *GetShowSeatLayoutRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+func (x *GetShowSeatLayoutRequest) GetMovieFormat() string {
+	if x != nil {
+		return x.MovieFormat
 	}
 	return ""
 }
 
-func (x *MovieTimeSlotUpdateResponse) GetMovieTimeSlot() *MovieTimeSlot {
+func (x *GetShowSeatLayoutRequest) GetDate() string {
 	if x != nil {
-		return x.MovieTimeSlot
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *MovieTimeSlotUpdateResponse) GetError() string {
+func (x *GetShowSeatLayoutRequest) GetStartTime() string {
 	if x != nil {
-		return x.Error
+		return x.StartTime
 	}
 	return ""
 }
 
-type MovieTimeSlotUpdate struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	StartTime       string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime         string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Duration        int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Date            string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	MovieFormat     SeatType               `protobuf:"varint,5,opt,name=movie_format,json=movieFormat,proto3,enum=moviedb_service.SeatType" json:"movie_format,omitempty"`
-	Movieid         int32                  `protobuf:"varint,6,opt,name=movieid,proto3" json:"movieid,omitempty"`
-	Venueid         int32                  `protobuf:"varint,7,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	MovieTimeSlotId int32                  `protobuf:"varint,8,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetShowSeatLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MovieTimeSlot *MovieTimeSlot         `protobuf:"bytes,3,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
+	Venue         *Venue                 `protobuf:"bytes,4,opt,name=venue,proto3" json:"venue,omitempty"`
+	Seats         []*ShowSeat            `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	FreeSeats     int32                  `protobuf:"varint,6,opt,name=free_seats,json=freeSeats,proto3" json:"free_seats,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieTimeSlotUpdate) Reset() {
-	*x = MovieTimeSlotUpdate{}
-	mi := &file_moviedb_service_proto_msgTypes[26]
+func (x *GetShowSeatLayoutResponse) Reset() {
+	*x = GetShowSeatLayoutResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieTimeSlotUpdate) String() string {
+func (x *GetShowSeatLayoutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieTimeSlotUpdate) ProtoMessage() {}
+func (*GetShowSeatLayoutResponse) ProtoMessage() {}
 
-func (x *MovieTimeSlotUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[26]
+func (x *GetShowSeatLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2326,89 +9339,89 @@ func (x *MovieTimeSlotUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieTimeSlotUpdate.ProtoReflect.Descriptor instead.
-func (*MovieTimeSlotUpdate) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetShowSeatLayoutResponse.ProtoReflect.Descriptor instead.
+func (*GetShowSeatLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *MovieTimeSlotUpdate) GetStartTime() string {
+func (x *GetShowSeatLayoutResponse) GetStatus() int32 {
 	if x != nil {
-		return x.StartTime
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *MovieTimeSlotUpdate) GetEndTime() string {
+func (x *GetShowSeatLayoutResponse) GetMessage() string {
 	if x != nil {
-		return x.EndTime
+		return x.Message
 	}
 	return ""
 }
 
-func (x *MovieTimeSlotUpdate) GetDuration() int32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
-}
-
-func (x *MovieTimeSlotUpdate) GetDate() string {
+func (x *GetShowSeatLayoutResponse) GetMovieTimeSlot() *MovieTimeSlot {
 	if x != nil {
-		return x.Date
+		return x.MovieTimeSlot
 	}
-	return ""
+	return nil
 }
 
-func (x *MovieTimeSlotUpdate) GetMovieFormat() SeatType {
+func (x *GetShowSeatLayoutResponse) GetVenue() *Venue {
 	if x != nil {
-		return x.MovieFormat
+		return x.Venue
 	}
-	return SeatType_TWO_D
+	return nil
 }
 
-func (x *MovieTimeSlotUpdate) GetMovieid() int32 {
+func (x *GetShowSeatLayoutResponse) GetSeats() []*ShowSeat {
 	if x != nil {
-		return x.Movieid
+		return x.Seats
 	}
-	return 0
+	return nil
 }
 
-func (x *MovieTimeSlotUpdate) GetVenueid() int32 {
+func (x *GetShowSeatLayoutResponse) GetFreeSeats() int32 {
 	if x != nil {
-		return x.Venueid
+		return x.FreeSeats
 	}
 	return 0
 }
 
-func (x *MovieTimeSlotUpdate) GetMovieTimeSlotId() int32 {
+func (x *GetShowSeatLayoutResponse) GetError() string {
 	if x != nil {
-		return x.MovieTimeSlotId
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type MovieTimeSlotDelete struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Rows and columns start at 1, row 1 is the closest to the screen
+type LayoutCell struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Row    int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Kind   LayoutCellKind         `protobuf:"varint,3,opt,name=kind,proto3,enum=moviedb_service.LayoutCellKind" json:"kind,omitempty"`
+	// Only set for seats
+	Seat *SeatMatrix `protobuf:"bytes,4,opt,name=seat,proto3" json:"seat,omitempty"`
+	// Name of the section the cell lies in
+	Section       string `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieTimeSlotDelete) Reset() {
-	*x = MovieTimeSlotDelete{}
-	mi := &file_moviedb_service_proto_msgTypes[27]
+func (x *LayoutCell) Reset() {
+	*x = LayoutCell{}
+	mi := &file_moviedb_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieTimeSlotDelete) String() string {
+func (x *LayoutCell) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieTimeSlotDelete) ProtoMessage() {}
+func (*LayoutCell) ProtoMessage() {}
 
-func (x *MovieTimeSlotDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[27]
+func (x *LayoutCell) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2419,40 +9432,71 @@ func (x *MovieTimeSlotDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieTimeSlotDelete.ProtoReflect.Descriptor instead.
-func (*MovieTimeSlotDelete) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use LayoutCell.ProtoReflect.Descriptor instead.
+func (*LayoutCell) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *MovieTimeSlotDelete) GetMovieTimeSlotId() int32 {
+func (x *LayoutCell) GetRow() int32 {
 	if x != nil {
-		return x.MovieTimeSlotId
+		return x.Row
 	}
 	return 0
 }
 
-type GetSeatMatrixRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+func (x *LayoutCell) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *LayoutCell) GetKind() LayoutCellKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LayoutCellKind_CELL_EMPTY
+}
+
+func (x *LayoutCell) GetSeat() *SeatMatrix {
+	if x != nil {
+		return x.Seat
+	}
+	return nil
+}
+
+func (x *LayoutCell) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+type LayoutRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Row   int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Label string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Every cell of the row from column 1 on
+	Cells         []*LayoutCell `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSeatMatrixRequest) Reset() {
-	*x = GetSeatMatrixRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[28]
+func (x *LayoutRow) Reset() {
+	*x = LayoutRow{}
+	mi := &file_moviedb_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeatMatrixRequest) String() string {
+func (x *LayoutRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeatMatrixRequest) ProtoMessage() {}
+func (*LayoutRow) ProtoMessage() {}
 
-func (x *GetSeatMatrixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[28]
+func (x *LayoutRow) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2463,43 +9507,58 @@ func (x *GetSeatMatrixRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeatMatrixRequest.ProtoReflect.Descriptor instead.
-func (*GetSeatMatrixRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use LayoutRow.ProtoReflect.Descriptor instead.
+func (*LayoutRow) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetSeatMatrixRequest) GetVenueid() int32 {
+func (x *LayoutRow) GetRow() int32 {
 	if x != nil {
-		return x.Venueid
+		return x.Row
 	}
 	return 0
 }
 
-type GetSeatMatrixResponse struct {
+func (x *LayoutRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LayoutRow) GetCells() []*LayoutCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type SeatSection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Seats         []*SeatMatrix          `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FirstRow      int32                  `protobuf:"varint,2,opt,name=first_row,json=firstRow,proto3" json:"first_row,omitempty"`
+	LastRow       int32                  `protobuf:"varint,3,opt,name=last_row,json=lastRow,proto3" json:"last_row,omitempty"`
+	FirstColumn   int32                  `protobuf:"varint,4,opt,name=first_column,json=firstColumn,proto3" json:"first_column,omitempty"`
+	LastColumn    int32                  `protobuf:"varint,5,opt,name=last_column,json=lastColumn,proto3" json:"last_column,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSeatMatrixResponse) Reset() {
-	*x = GetSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[29]
+func (x *SeatSection) Reset() {
+	*x = SeatSection{}
+	mi := &file_moviedb_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeatMatrixResponse) String() string {
+func (x *SeatSection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeatMatrixResponse) ProtoMessage() {}
+func (*SeatSection) ProtoMessage() {}
 
-func (x *GetSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[29]
+func (x *SeatSection) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2510,62 +9569,68 @@ func (x *GetSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*GetSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SeatSection.ProtoReflect.Descriptor instead.
+func (*SeatSection) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetSeatMatrixResponse) GetStatus() int32 {
+func (x *SeatSection) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeatSection) GetFirstRow() int32 {
+	if x != nil {
+		return x.FirstRow
 	}
 	return 0
 }
 
-func (x *GetSeatMatrixResponse) GetMessage() string {
+func (x *SeatSection) GetLastRow() int32 {
 	if x != nil {
-		return x.Message
+		return x.LastRow
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSeatMatrixResponse) GetSeats() []*SeatMatrix {
+func (x *SeatSection) GetFirstColumn() int32 {
 	if x != nil {
-		return x.Seats
+		return x.FirstColumn
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSeatMatrixResponse) GetError() string {
+func (x *SeatSection) GetLastColumn() int32 {
 	if x != nil {
-		return x.Error
+		return x.LastColumn
 	}
-	return ""
+	return 0
 }
 
-type UpdateSeatMatrixRequest struct {
+type GetVenueLayoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	Seats         []*SeatMatrix          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSeatMatrixRequest) Reset() {
-	*x = UpdateSeatMatrixRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[30]
+func (x *GetVenueLayoutRequest) Reset() {
+	*x = GetVenueLayoutRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSeatMatrixRequest) String() string {
+func (x *GetVenueLayoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSeatMatrixRequest) ProtoMessage() {}
+func (*GetVenueLayoutRequest) ProtoMessage() {}
 
-func (x *UpdateSeatMatrixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[30]
+func (x *GetVenueLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,49 +9641,47 @@ func (x *UpdateSeatMatrixRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSeatMatrixRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSeatMatrixRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetVenueLayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetVenueLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *UpdateSeatMatrixRequest) GetVenueid() int32 {
+func (x *GetVenueLayoutRequest) GetVenueid() int32 {
 	if x != nil {
 		return x.Venueid
 	}
 	return 0
 }
 
-func (x *UpdateSeatMatrixRequest) GetSeats() []*SeatMatrix {
-	if x != nil {
-		return x.Seats
-	}
-	return nil
-}
-
-type UpdateSeatMatrixResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+type SetVenueLayoutRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Venueid        int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	ScreenPosition ScreenPosition         `protobuf:"varint,2,opt,name=screen_position,json=screenPosition,proto3,enum=moviedb_service.ScreenPosition" json:"screen_position,omitempty"`
+	CurvedRows     bool                   `protobuf:"varint,3,opt,name=curved_rows,json=curvedRows,proto3" json:"curved_rows,omitempty"`
+	// Labels from row 1 on, rows without one are lettered A, B, C
+	RowLabels []string `protobuf:"bytes,4,rep,name=row_labels,json=rowLabels,proto3" json:"row_labels,omitempty"`
+	// Cells that are not seats, seats are managed with AddSeatMatrix
+	Cells         []*LayoutCell  `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"`
+	Sections      []*SeatSection `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSeatMatrixResponse) Reset() {
-	*x = UpdateSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[31]
+func (x *SetVenueLayoutRequest) Reset() {
+	*x = SetVenueLayoutRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSeatMatrixResponse) String() string {
+func (x *SetVenueLayoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSeatMatrixResponse) ProtoMessage() {}
+func (*SetVenueLayoutRequest) ProtoMessage() {}
 
-func (x *UpdateSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[31]
+func (x *SetVenueLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2629,118 +9692,186 @@ func (x *UpdateSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SetVenueLayoutRequest.ProtoReflect.Descriptor instead.
+func (*SetVenueLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *UpdateSeatMatrixResponse) GetStatus() int32 {
+func (x *SetVenueLayoutRequest) GetVenueid() int32 {
 	if x != nil {
-		return x.Status
+		return x.Venueid
 	}
 	return 0
 }
 
-func (x *UpdateSeatMatrixResponse) GetMessage() string {
+func (x *SetVenueLayoutRequest) GetScreenPosition() ScreenPosition {
 	if x != nil {
-		return x.Message
+		return x.ScreenPosition
 	}
-	return ""
+	return ScreenPosition_SCREEN_TOP
 }
 
-func (x *UpdateSeatMatrixResponse) GetError() string {
+func (x *SetVenueLayoutRequest) GetCurvedRows() bool {
 	if x != nil {
-		return x.Error
+		return x.CurvedRows
 	}
-	return ""
+	return false
 }
 
-type DeleteSeatMatrixRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Venueid int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	// Deprecated: Marked as deprecated in moviedb_service.proto.
-	Seats         []*SeatMatrix `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
-	SeatMatrixId  int32         `protobuf:"varint,3,opt,name=seat_matrix_id,json=seatMatrixId,proto3" json:"seat_matrix_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetVenueLayoutRequest) GetRowLabels() []string {
+	if x != nil {
+		return x.RowLabels
+	}
+	return nil
 }
 
-func (x *DeleteSeatMatrixRequest) Reset() {
-	*x = DeleteSeatMatrixRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[32]
+func (x *SetVenueLayoutRequest) GetCells() []*LayoutCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *SetVenueLayoutRequest) GetSections() []*SeatSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type VenueLayoutResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Venue          *Venue                 `protobuf:"bytes,3,opt,name=venue,proto3" json:"venue,omitempty"`
+	ScreenPosition ScreenPosition         `protobuf:"varint,4,opt,name=screen_position,json=screenPosition,proto3,enum=moviedb_service.ScreenPosition" json:"screen_position,omitempty"`
+	CurvedRows     bool                   `protobuf:"varint,5,opt,name=curved_rows,json=curvedRows,proto3" json:"curved_rows,omitempty"`
+	Rows           []*LayoutRow           `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	Sections       []*SeatSection         `protobuf:"bytes,7,rep,name=sections,proto3" json:"sections,omitempty"`
+	Error          string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VenueLayoutResponse) Reset() {
+	*x = VenueLayoutResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSeatMatrixRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VenueLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueLayoutResponse) ProtoMessage() {}
+
+func (x *VenueLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueLayoutResponse.ProtoReflect.Descriptor instead.
+func (*VenueLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *VenueLayoutResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VenueLayoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VenueLayoutResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
 }
 
-func (*DeleteSeatMatrixRequest) ProtoMessage() {}
-
-func (x *DeleteSeatMatrixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[32]
+func (x *VenueLayoutResponse) GetScreenPosition() ScreenPosition {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ScreenPosition
 	}
-	return mi.MessageOf(x)
+	return ScreenPosition_SCREEN_TOP
 }
 
-// Deprecated: Use DeleteSeatMatrixRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSeatMatrixRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{32}
+func (x *VenueLayoutResponse) GetCurvedRows() bool {
+	if x != nil {
+		return x.CurvedRows
+	}
+	return false
 }
 
-func (x *DeleteSeatMatrixRequest) GetVenueid() int32 {
+func (x *VenueLayoutResponse) GetRows() []*LayoutRow {
 	if x != nil {
-		return x.Venueid
+		return x.Rows
 	}
-	return 0
+	return nil
 }
 
-// Deprecated: Marked as deprecated in moviedb_service.proto.
-func (x *DeleteSeatMatrixRequest) GetSeats() []*SeatMatrix {
+func (x *VenueLayoutResponse) GetSections() []*SeatSection {
 	if x != nil {
-		return x.Seats
+		return x.Sections
 	}
 	return nil
 }
 
-func (x *DeleteSeatMatrixRequest) GetSeatMatrixId() int32 {
+func (x *VenueLayoutResponse) GetError() string {
 	if x != nil {
-		return x.SeatMatrixId
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type DeleteSeatMatrixResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+// The grid has one line per row, N is a normal seat, V a VIP seat, 2, 3 and 4 TWO_D, THREE_D and FOUR_D seats, a type in brackets
+// like [RECLINER] a seat of that type, W a wheelchair space, | an aisle, = stairs, # a pillar and _ an empty cell
+type ImportSeatGridRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Venueid int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	// TEXT rows look like "A: V V _ N N", CSV rows start with the row label
+	Data   string         `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Format SeatGridFormat `protobuf:"varint,3,opt,name=format,proto3,enum=moviedb_service.SeatGridFormat" json:"format,omitempty"`
+	// Price by seat type, e.g. VIP, in minor units of the currency of the venue
+	Prices map[string]int32 `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Validate and return the layout without writing it
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Currency of the prices, empty for the currency of the venue. Another currency is refused
+	Currency      string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSeatMatrixResponse) Reset() {
-	*x = DeleteSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[33]
+func (x *ImportSeatGridRequest) Reset() {
+	*x = ImportSeatGridRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSeatMatrixResponse) String() string {
+func (x *ImportSeatGridRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSeatMatrixResponse) ProtoMessage() {}
+func (*ImportSeatGridRequest) ProtoMessage() {}
 
-func (x *DeleteSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[33]
+func (x *ImportSeatGridRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,54 +9882,76 @@ func (x *DeleteSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ImportSeatGridRequest.ProtoReflect.Descriptor instead.
+func (*ImportSeatGridRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DeleteSeatMatrixResponse) GetStatus() int32 {
+func (x *ImportSeatGridRequest) GetVenueid() int32 {
 	if x != nil {
-		return x.Status
+		return x.Venueid
 	}
 	return 0
 }
 
-func (x *DeleteSeatMatrixResponse) GetMessage() string {
+func (x *ImportSeatGridRequest) GetData() string {
 	if x != nil {
-		return x.Message
+		return x.Data
 	}
 	return ""
 }
 
-func (x *DeleteSeatMatrixResponse) GetError() string {
+func (x *ImportSeatGridRequest) GetFormat() SeatGridFormat {
 	if x != nil {
-		return x.Error
+		return x.Format
+	}
+	return SeatGridFormat_GRID_TEXT
+}
+
+func (x *ImportSeatGridRequest) GetPrices() map[string]int32 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ImportSeatGridRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportSeatGridRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-type DeleteEntireSeatMatrixRequest struct {
+type ExportSeatGridRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	Format        SeatGridFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=moviedb_service.SeatGridFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntireSeatMatrixRequest) Reset() {
-	*x = DeleteEntireSeatMatrixRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[34]
+func (x *ExportSeatGridRequest) Reset() {
+	*x = ExportSeatGridRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntireSeatMatrixRequest) String() string {
+func (x *ExportSeatGridRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntireSeatMatrixRequest) ProtoMessage() {}
+func (*ExportSeatGridRequest) ProtoMessage() {}
 
-func (x *DeleteEntireSeatMatrixRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[34]
+func (x *ExportSeatGridRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2809,42 +9962,53 @@ func (x *DeleteEntireSeatMatrixRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntireSeatMatrixRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntireSeatMatrixRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ExportSeatGridRequest.ProtoReflect.Descriptor instead.
+func (*ExportSeatGridRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *DeleteEntireSeatMatrixRequest) GetVenueid() int32 {
+func (x *ExportSeatGridRequest) GetVenueid() int32 {
 	if x != nil {
 		return x.Venueid
 	}
 	return 0
 }
 
-type DeleteEntireSeatMatrixResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+func (x *ExportSeatGridRequest) GetFormat() SeatGridFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SeatGridFormat_GRID_TEXT
+}
+
+type ExportSeatGridResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data    string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// Minor units of currency
+	Prices        map[string]int32 `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Error         string           `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Currency      string           `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntireSeatMatrixResponse) Reset() {
-	*x = DeleteEntireSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[35]
+func (x *ExportSeatGridResponse) Reset() {
+	*x = ExportSeatGridResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntireSeatMatrixResponse) String() string {
+func (x *ExportSeatGridResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntireSeatMatrixResponse) ProtoMessage() {}
+func (*ExportSeatGridResponse) ProtoMessage() {}
 
-func (x *DeleteEntireSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[35]
+func (x *ExportSeatGridResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,55 +10019,80 @@ func (x *DeleteEntireSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntireSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntireSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ExportSeatGridResponse.ProtoReflect.Descriptor instead.
+func (*ExportSeatGridResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *DeleteEntireSeatMatrixResponse) GetStatus() int32 {
+func (x *ExportSeatGridResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *DeleteEntireSeatMatrixResponse) GetMessage() string {
+func (x *ExportSeatGridResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *DeleteEntireSeatMatrixResponse) GetError() string {
+func (x *ExportSeatGridResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ExportSeatGridResponse) GetPrices() map[string]int32 {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *ExportSeatGridResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type AddSingleSeatMatrixInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Venueid       int32                  `protobuf:"varint,1,opt,name=venueid,proto3" json:"venueid,omitempty"`
-	Seat          *SeatMatrix            `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+func (x *ExportSeatGridResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// A version of a layout template is never changed, venues keep the version they were given
+type LayoutTemplateVersion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Seat grid in the TEXT format of ImportSeatGrid
+	Data          string           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Prices        map[string]int32 `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	CreatedAt     string           `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSingleSeatMatrixInput) Reset() {
-	*x = AddSingleSeatMatrixInput{}
-	mi := &file_moviedb_service_proto_msgTypes[36]
+func (x *LayoutTemplateVersion) Reset() {
+	*x = LayoutTemplateVersion{}
+	mi := &file_moviedb_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSingleSeatMatrixInput) String() string {
+func (x *LayoutTemplateVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSingleSeatMatrixInput) ProtoMessage() {}
+func (*LayoutTemplateVersion) ProtoMessage() {}
 
-func (x *AddSingleSeatMatrixInput) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[36]
+func (x *LayoutTemplateVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2914,49 +10103,68 @@ func (x *AddSingleSeatMatrixInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSingleSeatMatrixInput.ProtoReflect.Descriptor instead.
-func (*AddSingleSeatMatrixInput) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use LayoutTemplateVersion.ProtoReflect.Descriptor instead.
+func (*LayoutTemplateVersion) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *AddSingleSeatMatrixInput) GetVenueid() int32 {
+func (x *LayoutTemplateVersion) GetVersion() int32 {
 	if x != nil {
-		return x.Venueid
+		return x.Version
 	}
 	return 0
 }
 
-func (x *AddSingleSeatMatrixInput) GetSeat() *SeatMatrix {
+func (x *LayoutTemplateVersion) GetData() string {
 	if x != nil {
-		return x.Seat
+		return x.Data
+	}
+	return ""
+}
+
+func (x *LayoutTemplateVersion) GetPrices() map[string]int32 {
+	if x != nil {
+		return x.Prices
 	}
 	return nil
 }
 
-type AddSingleSeatMatrixResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+func (x *LayoutTemplateVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type LayoutTemplate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Latest version
+	Version  int32                    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Versions []*LayoutTemplateVersion `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions,omitempty"`
+	// ISO 4217, prices of every version are in its minor units. The template can only be applied to venues charging in it
+	Currency      string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddSingleSeatMatrixResponse) Reset() {
-	*x = AddSingleSeatMatrixResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[37]
+func (x *LayoutTemplate) Reset() {
+	*x = LayoutTemplate{}
+	mi := &file_moviedb_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddSingleSeatMatrixResponse) String() string {
+func (x *LayoutTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSingleSeatMatrixResponse) ProtoMessage() {}
+func (*LayoutTemplate) ProtoMessage() {}
 
-func (x *AddSingleSeatMatrixResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[37]
+func (x *LayoutTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,60 +10175,84 @@ func (x *AddSingleSeatMatrixResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSingleSeatMatrixResponse.ProtoReflect.Descriptor instead.
-func (*AddSingleSeatMatrixResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use LayoutTemplate.ProtoReflect.Descriptor instead.
+func (*LayoutTemplate) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *AddSingleSeatMatrixResponse) GetStatus() int32 {
+func (x *LayoutTemplate) GetId() int32 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AddSingleSeatMatrixResponse) GetMessage() string {
+func (x *LayoutTemplate) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddSingleSeatMatrixResponse) GetError() string {
+func (x *LayoutTemplate) GetDescription() string {
 	if x != nil {
-		return x.Error
+		return x.Description
 	}
 	return ""
 }
 
-type BookedSeats struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SeatNumber      string                 `protobuf:"bytes,2,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	MovieTimeSlotID int32                  `protobuf:"varint,3,opt,name=movieTimeSlotID,proto3" json:"movieTimeSlotID,omitempty"`
-	SeatMatrixID    int32                  `protobuf:"varint,4,opt,name=seatMatrixID,proto3" json:"seatMatrixID,omitempty"`
-	IsBooked        bool                   `protobuf:"varint,5,opt,name=is_booked,json=isBooked,proto3" json:"is_booked,omitempty"`
-	Price           int32                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
-	MovieName       string                 `protobuf:"bytes,9,opt,name=movieName,proto3" json:"movieName,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *LayoutTemplate) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *BookedSeats) Reset() {
-	*x = BookedSeats{}
-	mi := &file_moviedb_service_proto_msgTypes[38]
+func (x *LayoutTemplate) GetVersions() []*LayoutTemplateVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *LayoutTemplate) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateLayoutTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Seat grid as in ImportSeatGridRequest, ignored when from_venueid is set
+	Data   string           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Format SeatGridFormat   `protobuf:"varint,4,opt,name=format,proto3,enum=moviedb_service.SeatGridFormat" json:"format,omitempty"`
+	Prices map[string]int32 `protobuf:"bytes,5,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Optional, take the seat grid from this venue instead
+	FromVenueid int32 `protobuf:"varint,6,opt,name=from_venueid,json=fromVenueid,proto3" json:"from_venueid,omitempty"`
+	// Currency of the prices, empty is INR or the currency of from_venueid
+	Currency      string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLayoutTemplateRequest) Reset() {
+	*x = CreateLayoutTemplateRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookedSeats) String() string {
+func (x *CreateLayoutTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookedSeats) ProtoMessage() {}
+func (*CreateLayoutTemplateRequest) ProtoMessage() {}
 
-func (x *BookedSeats) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[38]
+func (x *CreateLayoutTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3031,87 +10263,87 @@ func (x *BookedSeats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookedSeats.ProtoReflect.Descriptor instead.
-func (*BookedSeats) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CreateLayoutTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateLayoutTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *BookedSeats) GetId() int32 {
+func (x *CreateLayoutTemplateRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *BookedSeats) GetSeatNumber() string {
+func (x *CreateLayoutTemplateRequest) GetDescription() string {
 	if x != nil {
-		return x.SeatNumber
+		return x.Description
 	}
 	return ""
 }
 
-func (x *BookedSeats) GetMovieTimeSlotID() int32 {
+func (x *CreateLayoutTemplateRequest) GetData() string {
 	if x != nil {
-		return x.MovieTimeSlotID
+		return x.Data
 	}
-	return 0
+	return ""
 }
 
-func (x *BookedSeats) GetSeatMatrixID() int32 {
+func (x *CreateLayoutTemplateRequest) GetFormat() SeatGridFormat {
 	if x != nil {
-		return x.SeatMatrixID
+		return x.Format
 	}
-	return 0
+	return SeatGridFormat_GRID_TEXT
 }
 
-func (x *BookedSeats) GetIsBooked() bool {
+func (x *CreateLayoutTemplateRequest) GetPrices() map[string]int32 {
 	if x != nil {
-		return x.IsBooked
+		return x.Prices
 	}
-	return false
+	return nil
 }
 
-func (x *BookedSeats) GetPrice() int32 {
+func (x *CreateLayoutTemplateRequest) GetFromVenueid() int32 {
 	if x != nil {
-		return x.Price
+		return x.FromVenueid
 	}
 	return 0
 }
 
-func (x *BookedSeats) GetMovieName() string {
+func (x *CreateLayoutTemplateRequest) GetCurrency() string {
 	if x != nil {
-		return x.MovieName
+		return x.Currency
 	}
 	return ""
 }
 
-type BookSeatsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in moviedb_service.proto.
-	MovieTimeSlot   *MovieTimeSlot `protobuf:"bytes,1,opt,name=movie_time_slot,json=movieTimeSlot,proto3" json:"movie_time_slot,omitempty"`
-	Seats           []*BookedSeats `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
-	MovieTimeSlotId int32          `protobuf:"varint,3,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
-	Email           string         `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneNumber     string         `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UpdateLayoutTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId int32                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Data       string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Format     SeatGridFormat         `protobuf:"varint,3,opt,name=format,proto3,enum=moviedb_service.SeatGridFormat" json:"format,omitempty"`
+	Prices     map[string]int32       `protobuf:"bytes,4,rep,name=prices,proto3" json:"prices,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Currency of the prices, empty for the currency of the template. Another currency is refused
+	Currency      string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookSeatsRequest) Reset() {
-	*x = BookSeatsRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[39]
+func (x *UpdateLayoutTemplateRequest) Reset() {
+	*x = UpdateLayoutTemplateRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookSeatsRequest) String() string {
+func (x *UpdateLayoutTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookSeatsRequest) ProtoMessage() {}
+func (*UpdateLayoutTemplateRequest) ProtoMessage() {}
 
-func (x *BookSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[39]
+func (x *UpdateLayoutTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,72 +10354,115 @@ func (x *BookSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookSeatsRequest.ProtoReflect.Descriptor instead.
-func (*BookSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateLayoutTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLayoutTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{127}
 }
 
-// Deprecated: Marked as deprecated in moviedb_service.proto.
-func (x *BookSeatsRequest) GetMovieTimeSlot() *MovieTimeSlot {
+func (x *UpdateLayoutTemplateRequest) GetTemplateId() int32 {
 	if x != nil {
-		return x.MovieTimeSlot
+		return x.TemplateId
 	}
-	return nil
+	return 0
 }
 
-func (x *BookSeatsRequest) GetSeats() []*BookedSeats {
+func (x *UpdateLayoutTemplateRequest) GetData() string {
 	if x != nil {
-		return x.Seats
+		return x.Data
 	}
-	return nil
+	return ""
 }
 
-func (x *BookSeatsRequest) GetMovieTimeSlotId() int32 {
+func (x *UpdateLayoutTemplateRequest) GetFormat() SeatGridFormat {
 	if x != nil {
-		return x.MovieTimeSlotId
+		return x.Format
 	}
-	return 0
+	return SeatGridFormat_GRID_TEXT
 }
 
-func (x *BookSeatsRequest) GetEmail() string {
+func (x *UpdateLayoutTemplateRequest) GetPrices() map[string]int32 {
 	if x != nil {
-		return x.Email
+		return x.Prices
 	}
-	return ""
+	return nil
 }
 
-func (x *BookSeatsRequest) GetPhoneNumber() string {
+func (x *UpdateLayoutTemplateRequest) GetCurrency() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Currency
 	}
 	return ""
 }
 
-type BookSeatsResponse struct {
+type GetLayoutTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int32                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLayoutTemplateRequest) Reset() {
+	*x = GetLayoutTemplateRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLayoutTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLayoutTemplateRequest) ProtoMessage() {}
+
+func (x *GetLayoutTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLayoutTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetLayoutTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetLayoutTemplateRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+type LayoutTemplateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	BookSeatsId   []int32                `protobuf:"varint,3,rep,packed,name=book_seats_id,json=bookSeatsId,proto3" json:"book_seats_id,omitempty"`
+	Template      *LayoutTemplate        `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
 	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookSeatsResponse) Reset() {
-	*x = BookSeatsResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[40]
+func (x *LayoutTemplateResponse) Reset() {
+	*x = LayoutTemplateResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookSeatsResponse) String() string {
+func (x *LayoutTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookSeatsResponse) ProtoMessage() {}
+func (*LayoutTemplateResponse) ProtoMessage() {}
 
-func (x *BookSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[40]
+func (x *LayoutTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3198,61 +10473,65 @@ func (x *BookSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookSeatsResponse.ProtoReflect.Descriptor instead.
-func (*BookSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use LayoutTemplateResponse.ProtoReflect.Descriptor instead.
+func (*LayoutTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *BookSeatsResponse) GetStatus() int32 {
+func (x *LayoutTemplateResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *BookSeatsResponse) GetMessage() string {
+func (x *LayoutTemplateResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *BookSeatsResponse) GetBookSeatsId() []int32 {
+func (x *LayoutTemplateResponse) GetTemplate() *LayoutTemplate {
 	if x != nil {
-		return x.BookSeatsId
+		return x.Template
 	}
 	return nil
 }
 
-func (x *BookSeatsResponse) GetError() string {
+func (x *LayoutTemplateResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type GetBookedSeatsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Refused while the venue has upcoming shows on sale
+type ApplyLayoutTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId int32                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 0 applies the latest version
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Venueid       int32 `protobuf:"varint,3,opt,name=venueid,proto3" json:"venueid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookedSeatsRequest) Reset() {
-	*x = GetBookedSeatsRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[41]
+func (x *ApplyLayoutTemplateRequest) Reset() {
+	*x = ApplyLayoutTemplateRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsRequest) String() string {
+func (x *ApplyLayoutTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsRequest) ProtoMessage() {}
+func (*ApplyLayoutTemplateRequest) ProtoMessage() {}
 
-func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[41]
+func (x *ApplyLayoutTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3263,43 +10542,56 @@ func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ApplyLayoutTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyLayoutTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GetBookedSeatsRequest) GetMovieTimeSlotId() int32 {
+func (x *ApplyLayoutTemplateRequest) GetTemplateId() int32 {
 	if x != nil {
-		return x.MovieTimeSlotId
+		return x.TemplateId
 	}
 	return 0
 }
 
-type GetBookedSeatsResponse struct {
+func (x *ApplyLayoutTemplateRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ApplyLayoutTemplateRequest) GetVenueid() int32 {
+	if x != nil {
+		return x.Venueid
+	}
+	return 0
+}
+
+// Copies seats, cells, sections, row labels and screen position, refused while the target venue has upcoming shows on sale
+type CloneSeatLayoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	BookedSeats   []*BookedSeats         `protobuf:"bytes,3,rep,name=booked_seats,json=bookedSeats,proto3" json:"booked_seats,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	FromVenueid   int32                  `protobuf:"varint,1,opt,name=from_venueid,json=fromVenueid,proto3" json:"from_venueid,omitempty"`
+	ToVenueid     int32                  `protobuf:"varint,2,opt,name=to_venueid,json=toVenueid,proto3" json:"to_venueid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookedSeatsResponse) Reset() {
-	*x = GetBookedSeatsResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[42]
+func (x *CloneSeatLayoutRequest) Reset() {
+	*x = CloneSeatLayoutRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsResponse) String() string {
+func (x *CloneSeatLayoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsResponse) ProtoMessage() {}
+func (*CloneSeatLayoutRequest) ProtoMessage() {}
 
-func (x *GetBookedSeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[42]
+func (x *CloneSeatLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,61 +10602,141 @@ func (x *GetBookedSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsResponse.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CloneSeatLayoutRequest.ProtoReflect.Descriptor instead.
+func (*CloneSeatLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetBookedSeatsResponse) GetStatus() int32 {
+func (x *CloneSeatLayoutRequest) GetFromVenueid() int32 {
 	if x != nil {
-		return x.Status
+		return x.FromVenueid
 	}
 	return 0
 }
 
-func (x *GetBookedSeatsResponse) GetMessage() string {
+func (x *CloneSeatLayoutRequest) GetToVenueid() int32 {
 	if x != nil {
-		return x.Message
+		return x.ToVenueid
+	}
+	return 0
+}
+
+type SuggestSeatsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
+	PartySize       int32                  `protobuf:"varint,2,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	// Optional, only seats of this type, e.g. VIP
+	SeatType string `protobuf:"bytes,3,opt,name=seat_type,json=seatType,proto3" json:"seat_type,omitempty"`
+	// Hold the suggested seats straight away like LockBookedSeats
+	Hold          bool   `protobuf:"varint,4,opt,name=hold,proto3" json:"hold,omitempty"`
+	IdempotentKey string `protobuf:"bytes,5,opt,name=idempotent_key,json=idempotentKey,proto3" json:"idempotent_key,omitempty"`
+	// Optional, 0 uses the venue's hold duration or the server default. Capped by the server
+	HoldDurationSeconds int32 `protobuf:"varint,6,opt,name=hold_duration_seconds,json=holdDurationSeconds,proto3" json:"hold_duration_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SuggestSeatsRequest) Reset() {
+	*x = SuggestSeatsRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSeatsRequest) ProtoMessage() {}
+
+func (x *SuggestSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SuggestSeatsRequest) GetMovieTimeSlotId() int32 {
+	if x != nil {
+		return x.MovieTimeSlotId
+	}
+	return 0
+}
+
+func (x *SuggestSeatsRequest) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *SuggestSeatsRequest) GetSeatType() string {
+	if x != nil {
+		return x.SeatType
 	}
 	return ""
 }
 
-func (x *GetBookedSeatsResponse) GetBookedSeats() []*BookedSeats {
+func (x *SuggestSeatsRequest) GetHold() bool {
 	if x != nil {
-		return x.BookedSeats
+		return x.Hold
 	}
-	return nil
+	return false
 }
 
-func (x *GetBookedSeatsResponse) GetError() string {
+func (x *SuggestSeatsRequest) GetIdempotentKey() string {
 	if x != nil {
-		return x.Error
+		return x.IdempotentKey
 	}
 	return ""
 }
 
-type GetBookedSeatsDetailsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BookedSeatsIds []int32                `protobuf:"varint,1,rep,packed,name=booked_seats_ids,json=bookedSeatsIds,proto3" json:"booked_seats_ids,omitempty"`
+func (x *SuggestSeatsRequest) GetHoldDurationSeconds() int32 {
+	if x != nil {
+		return x.HoldDurationSeconds
+	}
+	return 0
+}
+
+type SuggestSeatsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Seats   []*ShowSeat            `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	Error   string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Only set when the seats were held
+	HoldToken      string `protobuf:"bytes,5,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	LockedUntil    string `protobuf:"bytes,6,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	ExtensionsLeft int32  `protobuf:"varint,7,opt,name=extensions_left,json=extensionsLeft,proto3" json:"extensions_left,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetBookedSeatsDetailsRequest) Reset() {
-	*x = GetBookedSeatsDetailsRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[43]
+func (x *SuggestSeatsResponse) Reset() {
+	*x = SuggestSeatsResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsDetailsRequest) String() string {
+func (x *SuggestSeatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsDetailsRequest) ProtoMessage() {}
+func (*SuggestSeatsResponse) ProtoMessage() {}
 
-func (x *GetBookedSeatsDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[43]
+func (x *SuggestSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,43 +10747,84 @@ func (x *GetBookedSeatsDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SuggestSeatsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetBookedSeatsDetailsRequest) GetBookedSeatsIds() []int32 {
+func (x *SuggestSeatsResponse) GetStatus() int32 {
 	if x != nil {
-		return x.BookedSeatsIds
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SuggestSeatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SuggestSeatsResponse) GetSeats() []*ShowSeat {
+	if x != nil {
+		return x.Seats
 	}
 	return nil
 }
 
-type GetBookedSeatsDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	BookedSeats   []*BookedSeats         `protobuf:"bytes,3,rep,name=booked_seats,json=bookedSeats,proto3" json:"booked_seats,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SuggestSeatsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *GetBookedSeatsDetailsResponse) Reset() {
-	*x = GetBookedSeatsDetailsResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[44]
+func (x *SuggestSeatsResponse) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *SuggestSeatsResponse) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
+}
+
+func (x *SuggestSeatsResponse) GetExtensionsLeft() int32 {
+	if x != nil {
+		return x.ExtensionsLeft
+	}
+	return 0
+}
+
+type ExtendSeatHoldRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HoldToken string                 `protobuf:"bytes,1,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	// Optional, 0 extends by the default hold duration. Capped by the server
+	ExtendBySeconds int32 `protobuf:"varint,2,opt,name=extend_by_seconds,json=extendBySeconds,proto3" json:"extend_by_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExtendSeatHoldRequest) Reset() {
+	*x = ExtendSeatHoldRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsDetailsResponse) String() string {
+func (x *ExtendSeatHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsDetailsResponse) ProtoMessage() {}
+func (*ExtendSeatHoldRequest) ProtoMessage() {}
 
-func (x *GetBookedSeatsDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[44]
+func (x *ExtendSeatHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3422,62 +10835,52 @@ func (x *GetBookedSeatsDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetBookedSeatsDetailsResponse) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *GetBookedSeatsDetailsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ExtendSeatHoldRequest.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetBookedSeatsDetailsResponse) GetBookedSeats() []*BookedSeats {
+func (x *ExtendSeatHoldRequest) GetHoldToken() string {
 	if x != nil {
-		return x.BookedSeats
+		return x.HoldToken
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBookedSeatsDetailsResponse) GetError() string {
+func (x *ExtendSeatHoldRequest) GetExtendBySeconds() int32 {
 	if x != nil {
-		return x.Error
+		return x.ExtendBySeconds
 	}
-	return ""
+	return 0
 }
 
-type IsValidToCommitSeatsForBooking_Request struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	MovieTimeSlotId int32                  `protobuf:"varint,1,opt,name=movie_time_slot_id,json=movieTimeSlotId,proto3" json:"movie_time_slot_id,omitempty"`
-	SeatMatrixIds   []int32                `protobuf:"varint,2,rep,packed,name=seatMatrixIds,proto3" json:"seatMatrixIds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ExtendSeatHoldResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// New expiry of the hold in RFC3339
+	LockedUntil    string `protobuf:"bytes,3,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	ExtensionsLeft int32  `protobuf:"varint,4,opt,name=extensions_left,json=extensionsLeft,proto3" json:"extensions_left,omitempty"`
+	Error          string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *IsValidToCommitSeatsForBooking_Request) Reset() {
-	*x = IsValidToCommitSeatsForBooking_Request{}
-	mi := &file_moviedb_service_proto_msgTypes[45]
+func (x *ExtendSeatHoldResponse) Reset() {
+	*x = ExtendSeatHoldResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsValidToCommitSeatsForBooking_Request) String() string {
+func (x *ExtendSeatHoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsValidToCommitSeatsForBooking_Request) ProtoMessage() {}
+func (*ExtendSeatHoldResponse) ProtoMessage() {}
 
-func (x *IsValidToCommitSeatsForBooking_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[45]
+func (x *ExtendSeatHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3488,50 +10891,77 @@ func (x *IsValidToCommitSeatsForBooking_Request) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsValidToCommitSeatsForBooking_Request.ProtoReflect.Descriptor instead.
-func (*IsValidToCommitSeatsForBooking_Request) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ExtendSeatHoldResponse.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *IsValidToCommitSeatsForBooking_Request) GetMovieTimeSlotId() int32 {
+func (x *ExtendSeatHoldResponse) GetStatus() int32 {
 	if x != nil {
-		return x.MovieTimeSlotId
+		return x.Status
 	}
 	return 0
 }
 
-func (x *IsValidToCommitSeatsForBooking_Request) GetSeatMatrixIds() []int32 {
+func (x *ExtendSeatHoldResponse) GetMessage() string {
 	if x != nil {
-		return x.SeatMatrixIds
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type IsValidToCommitSeatsForBooking_Response struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Isvalid         bool                   `protobuf:"varint,1,opt,name=isvalid,proto3" json:"isvalid,omitempty"`
-	Error           string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Status          int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	ToBeBookedSeats []*BookedSeats         `protobuf:"bytes,4,rep,name=toBeBookedSeats,proto3" json:"toBeBookedSeats,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ExtendSeatHoldResponse) GetLockedUntil() string {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return ""
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) Reset() {
-	*x = IsValidToCommitSeatsForBooking_Response{}
-	mi := &file_moviedb_service_proto_msgTypes[46]
+func (x *ExtendSeatHoldResponse) GetExtensionsLeft() int32 {
+	if x != nil {
+		return x.ExtensionsLeft
+	}
+	return 0
+}
+
+func (x *ExtendSeatHoldResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SearchMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Free text, supports quoted phrases, OR and -word
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional filters
+	Language        string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Type            string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MovieResolution string `protobuf:"bytes,4,opt,name=movie_resolution,json=movieResolution,proto3" json:"movie_resolution,omitempty"`
+	// Defaults to 20, at most 100
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, empty for the first page
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMoviesRequest) Reset() {
+	*x = SearchMoviesRequest{}
+	mi := &file_moviedb_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) String() string {
+func (x *SearchMoviesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsValidToCommitSeatsForBooking_Response) ProtoMessage() {}
+func (*SearchMoviesRequest) ProtoMessage() {}
 
-func (x *IsValidToCommitSeatsForBooking_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[46]
+func (x *SearchMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3542,62 +10972,80 @@ func (x *IsValidToCommitSeatsForBooking_Response) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsValidToCommitSeatsForBooking_Response.ProtoReflect.Descriptor instead.
-func (*IsValidToCommitSeatsForBooking_Response) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SearchMoviesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) GetIsvalid() bool {
+func (x *SearchMoviesRequest) GetQuery() string {
 	if x != nil {
-		return x.Isvalid
+		return x.Query
 	}
-	return false
+	return ""
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) GetError() string {
+func (x *SearchMoviesRequest) GetLanguage() string {
 	if x != nil {
-		return x.Error
+		return x.Language
 	}
 	return ""
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) GetStatus() int32 {
+func (x *SearchMoviesRequest) GetType() string {
 	if x != nil {
-		return x.Status
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *IsValidToCommitSeatsForBooking_Response) GetToBeBookedSeats() []*BookedSeats {
+func (x *SearchMoviesRequest) GetMovieResolution() string {
 	if x != nil {
-		return x.ToBeBookedSeats
+		return x.MovieResolution
 	}
-	return nil
+	return ""
 }
 
-type CreateTicketRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdempotentKey string                 `protobuf:"bytes,1,opt,name=idempotent_key,json=idempotentKey,proto3" json:"idempotent_key,omitempty"`
-	TrasactionId  string                 `protobuf:"bytes,2,opt,name=trasaction_id,json=trasactionId,proto3" json:"trasaction_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SearchMoviesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *CreateTicketRequest) Reset() {
-	*x = CreateTicketRequest{}
-	mi := &file_moviedb_service_proto_msgTypes[47]
+func (x *SearchMoviesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type MovieSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Movie *Movie                 `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Rank  float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Matched words are wrapped in <mark></mark>
+	TitleHighlight     string   `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionSnippet string   `protobuf:"bytes,4,opt,name=description_snippet,json=descriptionSnippet,proto3" json:"description_snippet,omitempty"`
+	MatchedCast        []string `protobuf:"bytes,5,rep,name=matched_cast,json=matchedCast,proto3" json:"matched_cast,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MovieSearchResult) Reset() {
+	*x = MovieSearchResult{}
+	mi := &file_moviedb_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTicketRequest) String() string {
+func (x *MovieSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTicketRequest) ProtoMessage() {}
+func (*MovieSearchResult) ProtoMessage() {}
 
-func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[47]
+func (x *MovieSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3608,48 +11056,73 @@ func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTicketRequest.ProtoReflect.Descriptor instead.
-func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use MovieSearchResult.ProtoReflect.Descriptor instead.
+func (*MovieSearchResult) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *CreateTicketRequest) GetIdempotentKey() string {
+func (x *MovieSearchResult) GetMovie() *Movie {
 	if x != nil {
-		return x.IdempotentKey
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *MovieSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MovieSearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
 	}
 	return ""
 }
 
-func (x *CreateTicketRequest) GetTrasactionId() string {
+func (x *MovieSearchResult) GetDescriptionSnippet() string {
 	if x != nil {
-		return x.TrasactionId
+		return x.DescriptionSnippet
 	}
 	return ""
 }
 
-type CreateRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+func (x *MovieSearchResult) GetMatchedCast() []string {
+	if x != nil {
+		return x.MatchedCast
+	}
+	return nil
+}
+
+type SearchMoviesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Status  int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results []*MovieSearchResult   `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Error   string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRequestResponse) Reset() {
-	*x = CreateRequestResponse{}
-	mi := &file_moviedb_service_proto_msgTypes[48]
+func (x *SearchMoviesResponse) Reset() {
+	*x = SearchMoviesResponse{}
+	mi := &file_moviedb_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRequestResponse) String() string {
+func (x *SearchMoviesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRequestResponse) ProtoMessage() {}
+func (*SearchMoviesResponse) ProtoMessage() {}
 
-func (x *CreateRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_moviedb_service_proto_msgTypes[48]
+func (x *SearchMoviesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_moviedb_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3660,40 +11133,66 @@ func (x *CreateRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRequestResponse.ProtoReflect.Descriptor instead.
-func (*CreateRequestResponse) Descriptor() ([]byte, []int) {
-	return file_moviedb_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SearchMoviesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMoviesResponse) Descriptor() ([]byte, []int) {
+	return file_moviedb_service_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *CreateRequestResponse) GetStatus() int32 {
+func (x *SearchMoviesResponse) GetStatus() int32 {
 	if x != nil {
 		return x.Status
 	}
 	return 0
 }
 
-func (x *CreateRequestResponse) GetError() string {
+func (x *SearchMoviesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchMoviesResponse) GetResults() []*MovieSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMoviesResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
+func (x *SearchMoviesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_moviedb_service_proto protoreflect.FileDescriptor
 
 const file_moviedb_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15moviedb_service.proto\x12\x0fmoviedb_service\x1a\x1bgoogle/protobuf/empty.proto\"\xcd\x01\n" +
+	"\x15moviedb_service.proto\x12\x0fmoviedb_service\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x8a\x02\n" +
 	"\n" +
 	"SeatMatrix\x12\x1f\n" +
 	"\vseat_number\x18\x01 \x01(\tR\n" +
 	"seatNumber\x12\x1f\n" +
-	"\tis_booked\x18\x02 \x01(\bB\x02\x18\x01R\bisBooked\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x10\n" +
+	"\tis_booked\x18\x02 \x01(\bB\x02\x18\x01R\bisBooked\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x05B\x02\x18\x01R\x05price\x12\x10\n" +
 	"\x03row\x18\x04 \x01(\x05R\x03row\x12\x16\n" +
 	"\x06column\x18\x05 \x01(\x05R\x06column\x12-\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x19.moviedb_service.SeatTypeR\x04type\x12\x0e\n" +
-	"\x02id\x18\a \x01(\x05R\x02id\"a\n" +
+	"\x02id\x18\a \x01(\x05R\x02id\x127\n" +
+	"\vprice_money\x18\b \x01(\v2\x16.moviedb_service.MoneyR\n" +
+	"priceMoney\"a\n" +
 	"\x12AddSeatMatrixInput\x12\x18\n" +
 	"\avenueid\x18\x01 \x01(\x05R\avenueid\x121\n" +
 	"\x05seats\x18\x02 \x03(\v2\x1b.moviedb_service.SeatMatrixR\x05seats\"_\n" +
@@ -3705,16 +11204,26 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
 	"\x04type\x18\x02 \x01(\x0e2 .moviedb_service.CastAndCrewTypeR\x04type\x12%\n" +
 	"\x0echaracter_name\x18\x03 \x01(\tR\rcharacterName\x12\x1a\n" +
-	"\bphotourl\x18\x04 \x01(\tR\bphotourl\"\xeb\x01\n" +
+	"\bphotourl\x18\x04 \x01(\tR\bphotourl\"\x96\x04\n" +
 	"\rMovieTimeSlot\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x02 \x01(\tR\aendTime\x12\x1a\n" +
 	"\bduration\x18\x03 \x01(\x05R\bduration\x12\x12\n" +
-	"\x04date\x18\x04 \x01(\tR\x04date\x12<\n" +
-	"\fmovie_format\x18\x05 \x01(\x0e2\x19.moviedb_service.SeatTypeR\vmovieFormat\x12\x18\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12?\n" +
+	"\fmovie_format\x18\x05 \x01(\x0e2\x1c.moviedb_service.MovieFormatR\vmovieFormat\x12\x18\n" +
 	"\amovieid\x18\x06 \x01(\x05R\amovieid\x12\x18\n" +
-	"\avenueid\x18\a \x01(\x05R\avenueid\"\xca\x03\n" +
+	"\avenueid\x18\a \x01(\x05R\avenueid\x12\x0e\n" +
+	"\x02id\x18\b \x01(\x05R\x02id\x12'\n" +
+	"\x0fshow_scheduleid\x18\t \x01(\x05R\x0eshowScheduleid\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12/\n" +
+	"\x13cancellation_reason\x18\v \x01(\tR\x12cancellationReason\x12(\n" +
+	"\x10local_start_time\x18\f \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\r \x01(\tR\flocalEndTime\x12\x1a\n" +
+	"\btimezone\x18\x0e \x01(\tR\btimezone\x12\x1a\n" +
+	"\blanguage\x18\x0f \x01(\tR\blanguage\x12\x1c\n" +
+	"\tsubtitles\x18\x10 \x03(\tR\tsubtitles\"\x94\x04\n" +
 	"\x05Movie\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
@@ -3732,7 +11241,10 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x06venues\x18\v \x03(\v2\x16.moviedb_service.VenueR\x06venues\x12\x14\n" +
 	"\x05votes\x18\r \x01(\x03R\x05votes\x12\x18\n" +
 	"\aranking\x18\x0e \x01(\x05R\aranking\x12\x0e\n" +
-	"\x02id\x18\x0f \x01(\x05R\x02idJ\x04\b\f\x10\r\"\x94\x04\n" +
+	"\x02id\x18\x0f \x01(\x05R\x02id\x12\x1f\n" +
+	"\vdistance_km\x18\x10 \x01(\x01R\n" +
+	"distanceKm\x12'\n" +
+	"\x0fnearest_venueid\x18\x11 \x01(\x05R\x0enearestVenueidJ\x04\b\f\x10\r\"\x9d\b\n" +
 	"\x05Venue\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12.\n" +
@@ -3748,7 +11260,126 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x06movies\x18\v \x03(\v2\x16.moviedb_service.MovieR\x06movies\x12\x0e\n" +
 	"\x02id\x18\f \x01(\x05R\x02id\x124\n" +
 	"\x16movie_format_supported\x18\r \x03(\tR\x14movieFormatSupported\x12-\n" +
-	"\x12language_supported\x18\x0e \x03(\tR\x11languageSupported\";\n" +
+	"\x12language_supported\x18\x0e \x03(\tR\x11languageSupported\x12*\n" +
+	"\x11seat_hold_minutes\x18\x0f \x01(\x05R\x0fseatHoldMinutes\x12\x1f\n" +
+	"\vdistance_km\x18\x10 \x01(\x01R\n" +
+	"distanceKm\x12\x1f\n" +
+	"\vregion_code\x18\x11 \x01(\tR\n" +
+	"regionCode\x12\x1a\n" +
+	"\bcinemaid\x18\x12 \x01(\x05R\bcinemaid\x12!\n" +
+	"\fopening_time\x18\x13 \x01(\tR\vopeningTime\x12!\n" +
+	"\fclosing_time\x18\x14 \x01(\tR\vclosingTime\x12\x1a\n" +
+	"\btimezone\x18\x15 \x01(\tR\btimezone\x12,\n" +
+	"\x12layout_template_id\x18\x16 \x01(\x05R\x10layoutTemplateId\x12%\n" +
+	"\x0elayout_version\x18\x17 \x01(\x05R\rlayoutVersion\x12\x1a\n" +
+	"\bcurrency\x18\x18 \x01(\tR\bcurrency\x12%\n" +
+	"\x0eprice_rounding\x18\x19 \x01(\x05R\rpriceRounding\x12B\n" +
+	"\rrounding_mode\x18\x1a \x01(\x0e2\x1d.moviedb_service.RoundingModeR\froundingMode\x12?\n" +
+	"\x0fconvenience_fee\x18\x1b \x01(\v2\x16.moviedb_service.MoneyR\x0econvenienceFee\"\xf4\x02\n" +
+	"\x06Cinema\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x1f\n" +
+	"\vregion_code\x18\x05 \x01(\tR\n" +
+	"regionCode\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x1c\n" +
+	"\tamenities\x18\t \x03(\tR\tamenities\x12!\n" +
+	"\fphone_number\x18\n" +
+	" \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05email\x18\v \x01(\tR\x05email\x12\x18\n" +
+	"\awebsite\x18\f \x01(\tR\awebsite\x120\n" +
+	"\ascreens\x18\r \x03(\v2\x16.moviedb_service.VenueR\ascreens\"+\n" +
+	"\rCinemaRequest\x12\x1a\n" +
+	"\bcinemaid\x18\x01 \x01(\x05R\bcinemaid\"\x89\x01\n" +
+	"\x0eCinemaResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x06cinema\x18\x03 \x01(\v2\x17.moviedb_service.CinemaR\x06cinema\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"s\n" +
+	"\x14GetAllCinemasRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vregion_code\x18\x03 \x01(\tR\n" +
+	"regionCode\"\xb7\x01\n" +
+	"\x12CinemaListResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\acinemas\x18\x03 \x03(\v2\x17.moviedb_service.CinemaR\acinemas\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x1f\n" +
+	"\x1dMigrateVenuesToCinemasRequest\"\xb6\x01\n" +
+	"\x1eMigrateVenuesToCinemasResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0fcinemas_created\x18\x03 \x01(\x05R\x0ecinemasCreated\x12#\n" +
+	"\rvenues_linked\x18\x04 \x01(\x05R\fvenuesLinked\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xfe\x02\n" +
+	"\fShowSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\amovieid\x18\x02 \x01(\x05R\amovieid\x12\x18\n" +
+	"\avenueid\x18\x03 \x01(\x05R\avenueid\x12?\n" +
+	"\fmovie_format\x18\x04 \x01(\x0e2\x1c.moviedb_service.MovieFormatR\vmovieFormat\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12 \n" +
+	"\fdays_of_week\x18\a \x03(\x05R\n" +
+	"daysOfWeek\x12\x1f\n" +
+	"\vstart_times\x18\b \x03(\tR\n" +
+	"startTimes\x12\x1a\n" +
+	"\bduration\x18\t \x01(\x05R\bduration\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1a\n" +
+	"\blanguage\x18\v \x01(\tR\blanguage\x12\x1c\n" +
+	"\tsubtitles\x18\f \x03(\tR\tsubtitles\"\x99\x01\n" +
+	"\x13ShowScheduleRequest\x12B\n" +
+	"\rshow_schedule\x18\x01 \x01(\v2\x1d.moviedb_service.ShowScheduleR\fshowSchedule\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12%\n" +
+	"\x0eskip_conflicts\x18\x03 \x01(\bR\rskipConflicts\"D\n" +
+	"\x19CancelShowScheduleRequest\x12'\n" +
+	"\x0fshow_scheduleid\x18\x01 \x01(\x05R\x0eshowScheduleid\"\x9e\x01\n" +
+	"\x15ScheduledShowConflict\x12F\n" +
+	"\x0fmovie_time_slot\x18\x01 \x01(\v2\x1e.moviedb_service.MovieTimeSlotR\rmovieTimeSlot\x12=\n" +
+	"\bconflict\x18\x02 \x01(\v2!.moviedb_service.ScheduleConflictR\bconflict\"\x9a\x03\n" +
+	"\x14ShowScheduleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12B\n" +
+	"\rshow_schedule\x18\x03 \x01(\v2\x1d.moviedb_service.ShowScheduleR\fshowSchedule\x128\n" +
+	"\acreated\x18\x04 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\acreated\x128\n" +
+	"\aupdated\x18\x05 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\aupdated\x12<\n" +
+	"\tcancelled\x18\x06 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\tcancelled\x12D\n" +
+	"\tconflicts\x18\a \x03(\v2&.moviedb_service.ScheduledShowConflictR\tconflicts\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\x8a\x01\n" +
+	"\fPlannedMovie\x12\x18\n" +
+	"\amovieid\x18\x01 \x01(\x05R\amovieid\x12!\n" +
+	"\ftarget_shows\x18\x02 \x01(\x05R\vtargetShows\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12!\n" +
+	"\fmovie_format\x18\x04 \x01(\tR\vmovieFormat\"\xb4\x01\n" +
+	"\x14PlanProgrammeRequest\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tR\tweekStart\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x1a\n" +
+	"\bvenueids\x18\x03 \x03(\x05R\bvenueids\x125\n" +
+	"\x06movies\x18\x04 \x03(\v2\x1d.moviedb_service.PlannedMovieR\x06movies\x12\x16\n" +
+	"\x06commit\x18\x05 \x01(\bR\x06commit\"w\n" +
+	"\x11PlannedMovieShows\x12\x18\n" +
+	"\amovieid\x18\x01 \x01(\x05R\amovieid\x12#\n" +
+	"\rplanned_shows\x18\x02 \x01(\x05R\fplannedShows\x12#\n" +
+	"\rmissing_shows\x18\x03 \x01(\x05R\fmissingShows\"7\n" +
+	"\tScreenUse\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12\x10\n" +
+	"\x03use\x18\x02 \x01(\x01R\x03use\"\xda\x02\n" +
+	"\x15PlanProgrammeResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12H\n" +
+	"\x10movie_time_slots\x18\x03 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\x0emovieTimeSlots\x12:\n" +
+	"\x06movies\x18\x04 \x03(\v2\".moviedb_service.PlannedMovieShowsR\x06movies\x124\n" +
+	"\ascreens\x18\x05 \x03(\v2\x1a.moviedb_service.ScreenUseR\ascreens\x12=\n" +
+	"\bconflict\x18\x06 \x01(\v2!.moviedb_service.ScheduleConflictR\bconflict\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\";\n" +
 	"\tMovieList\x12.\n" +
 	"\x06movies\x18\x01 \x03(\v2\x16.moviedb_service.MovieR\x06movies\"X\n" +
 	"\fMovieRequest\x12\x14\n" +
@@ -3759,29 +11390,58 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
 	"\x05movie\x18\x03 \x01(\v2\x16.moviedb_service.MovieR\x05movie\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"\x96\x01\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xbe\x01\n" +
 	"\x11MovieListResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
 	"\n" +
 	"movie_list\x18\x03 \x01(\v2\x1a.moviedb_service.MovieListR\tmovieList\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"\x85\x01\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x81\x01\n" +
+	"\x13GetAllMoviesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"p\n" +
+	"\x13GetAllVenuesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"venue_type\x18\x03 \x01(\tR\tvenueType\"\xb3\x01\n" +
+	"\x11VenueListResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x06venues\x18\x03 \x03(\v2\x16.moviedb_service.VenueR\x06venues\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x85\x01\n" +
 	"\rVenueResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
 	"\x05Venue\x18\x03 \x01(\v2\x16.moviedb_service.VenueR\x05Venue\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"-\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"I\n" +
 	"\x17GetUpcomingMovieRequest\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\"\x99\x01\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"\x99\x01\n" +
 	"\x18GetUpcomingMovieResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
 	"\n" +
 	"movie_list\x18\x03 \x03(\v2\x16.moviedb_service.MovieR\tmovieList\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"U\n" +
-	"\x19GetNowPlayingMovieRequest\x12\x1c\n" +
-	"\tlongitude\x18\x01 \x01(\x03R\tlongitude\x12\x1a\n" +
-	"\blatitude\x18\x02 \x01(\x03R\blatitude\"\xe0\x01\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xbd\x01\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12>\n" +
+	"\vradius_unit\x18\x04 \x01(\x0e2\x1d.moviedb_service.DistanceUnitR\n" +
+	"radiusUnit\x12\x1f\n" +
+	"\vregion_code\x18\x05 \x01(\tR\n" +
+	"regionCode\"\xa2\x01\n" +
+	"\x19GetNowPlayingMovieRequest\x12'\n" +
+	"\rold_longitude\x18\x01 \x01(\x03B\x02\x18\x01R\foldLongitude\x12%\n" +
+	"\fold_latitude\x18\x02 \x01(\x03B\x02\x18\x01R\voldLatitude\x125\n" +
+	"\blocation\x18\x03 \x01(\v2\x19.moviedb_service.LocationR\blocation\"\xe0\x01\n" +
 	"\x06Review\x12\x18\n" +
 	"\amovieID\x18\x01 \x01(\x05R\amovieID\x12\x16\n" +
 	"\x06userID\x18\x02 \x01(\x05R\x06userID\x12\x16\n" +
@@ -3825,92 +11485,274 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12/\n" +
 	"\x06sortBy\x18\x05 \x01(\x0e2\x17.moviedb_service.SortByR\x06sortBy\x125\n" +
-	"\bfilterBy\x18\x06 \x01(\x0e2\x19.moviedb_service.FilterByR\bfilterBy\"\xfb\x01\n" +
+	"\bfilterBy\x18\x06 \x01(\x0e2\x19.moviedb_service.FilterByR\bfilterBy\"\xf9\x02\n" +
 	"\x17GetMovieTimeSlotRequest\x12\x18\n" +
 	"\amovieid\x18\x01 \x01(\tR\amovieid\x12\x1c\n" +
 	"\tstartDate\x18\x03 \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\x04 \x01(\tR\aendDate\x12'\n" +
 	"\rold_longitude\x18\x05 \x01(\x03B\x02\x18\x01R\foldLongitude\x12%\n" +
-	"\fold_latitude\x18\x06 \x01(\x03B\x02\x18\x01R\voldLatitude\x12\x1c\n" +
-	"\tlongitude\x18\a \x01(\x02R\tlongitude\x12\x1a\n" +
-	"\blatitude\x18\b \x01(\x02R\blatitudeJ\x04\b\x02\x10\x03\"\xdc\x01\n" +
+	"\fold_latitude\x18\x06 \x01(\x03B\x02\x18\x01R\voldLatitude\x12 \n" +
+	"\tlongitude\x18\a \x01(\x02B\x02\x18\x01R\tlongitude\x12\x1e\n" +
+	"\blatitude\x18\b \x01(\x02B\x02\x18\x01R\blatitude\x125\n" +
+	"\blocation\x18\t \x01(\v2\x19.moviedb_service.LocationR\blocation\x12\x1a\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tR\blanguage\x12!\n" +
+	"\fmovie_format\x18\v \x01(\tR\vmovieFormatJ\x04\b\x02\x10\x03\"\x89\x01\n" +
+	"\x0fScreenShowtimes\x12,\n" +
+	"\x05venue\x18\x01 \x01(\v2\x16.moviedb_service.VenueR\x05venue\x12H\n" +
+	"\x10movie_time_slots\x18\x02 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\x0emovieTimeSlots\"\x9f\x01\n" +
+	"\x0fCinemaShowtimes\x12/\n" +
+	"\x06cinema\x18\x01 \x01(\v2\x17.moviedb_service.CinemaR\x06cinema\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\x12:\n" +
+	"\ascreens\x18\x03 \x03(\v2 .moviedb_service.ScreenShowtimesR\ascreens\"\x98\x02\n" +
 	"\x18GetMovieTimeSlotResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12H\n" +
 	"\x10movie_time_slots\x18\x03 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\x0emovieTimeSlots\x12\x14\n" +
 	"\x05error\x18\x04 \x01(\tR\x05error\x12.\n" +
-	"\x06venues\x18\x05 \x03(\v2\x16.moviedb_service.VenueR\x06venues\"_\n" +
+	"\x06venues\x18\x05 \x03(\v2\x16.moviedb_service.VenueR\x06venues\x12:\n" +
+	"\acinemas\x18\x06 \x03(\v2 .moviedb_service.CinemaShowtimesR\acinemas\"\x89\x01\n" +
+	"\x10ScheduleConflict\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12]\n" +
+	"\x1bconflicting_movie_time_slot\x18\x02 \x01(\v2\x1e.moviedb_service.MovieTimeSlotR\x18conflictingMovieTimeSlot\"\x9e\x01\n" +
 	"\x15MovieTimeSlotResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"\xad\x01\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12=\n" +
+	"\bconflict\x18\x04 \x01(\v2!.moviedb_service.ScheduleConflictR\bconflict\"\xec\x01\n" +
 	"\x1bMovieTimeSlotUpdateResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12F\n" +
 	"\x0fmovie_time_slot\x18\x03 \x01(\v2\x1e.moviedb_service.MovieTimeSlotR\rmovieTimeSlot\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"\x9e\x02\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12=\n" +
+	"\bconflict\x18\x05 \x01(\v2!.moviedb_service.ScheduleConflictR\bconflict\"\xdb\x02\n" +
 	"\x13MovieTimeSlotUpdate\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x02 \x01(\tR\aendTime\x12\x1a\n" +
 	"\bduration\x18\x03 \x01(\x05R\bduration\x12\x12\n" +
-	"\x04date\x18\x04 \x01(\tR\x04date\x12<\n" +
-	"\fmovie_format\x18\x05 \x01(\x0e2\x19.moviedb_service.SeatTypeR\vmovieFormat\x12\x18\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12?\n" +
+	"\fmovie_format\x18\x05 \x01(\x0e2\x1c.moviedb_service.MovieFormatR\vmovieFormat\x12\x18\n" +
 	"\amovieid\x18\x06 \x01(\x05R\amovieid\x12\x18\n" +
 	"\avenueid\x18\a \x01(\x05R\avenueid\x12+\n" +
-	"\x12movie_time_slot_id\x18\b \x01(\x05R\x0fmovieTimeSlotId\"B\n" +
+	"\x12movie_time_slot_id\x18\b \x01(\x05R\x0fmovieTimeSlotId\x12\x1a\n" +
+	"\blanguage\x18\t \x01(\tR\blanguage\x12\x1c\n" +
+	"\tsubtitles\x18\n" +
+	" \x03(\tR\tsubtitles\"B\n" +
 	"\x13MovieTimeSlotDelete\x12+\n" +
-	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\"0\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\"_\n" +
+	"\x18SetShowtimeStatusRequest\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\\\n" +
+	"\x15CancelShowtimeRequest\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xa9\x02\n" +
+	"\x16CancelShowtimeResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12F\n" +
+	"\x0fmovie_time_slot\x18\x03 \x01(\v2\x1e.moviedb_service.MovieTimeSlotR\rmovieTimeSlot\x12)\n" +
+	"\x10refund_ticketids\x18\x04 \x03(\x05R\x0frefundTicketids\x12%\n" +
+	"\x0ereleased_seats\x18\x05 \x01(\x05R\rreleasedSeats\x12-\n" +
+	"\x12notified_customers\x18\x06 \x01(\x05R\x11notifiedCustomers\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"0\n" +
 	"\x14GetSeatMatrixRequest\x12\x18\n" +
 	"\avenueid\x18\x01 \x01(\x05R\avenueid\"\x92\x01\n" +
 	"\x15GetSeatMatrixResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
 	"\x05seats\x18\x03 \x03(\v2\x1b.moviedb_service.SeatMatrixR\x05seats\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"f\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x9b\x01\n" +
 	"\x17UpdateSeatMatrixRequest\x12\x18\n" +
 	"\avenueid\x18\x01 \x01(\x05R\avenueid\x121\n" +
-	"\x05seats\x18\x02 \x03(\v2\x1b.moviedb_service.SeatMatrixR\x05seats\"b\n" +
+	"\x05seats\x18\x02 \x03(\v2\x1b.moviedb_service.SeatMatrixR\x05seats\x123\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x1f.moviedb_service.SeatChangeModeR\x04mode\"b\n" +
 	"\x18UpdateSeatMatrixResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"\x90\x01\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc5\x01\n" +
 	"\x17DeleteSeatMatrixRequest\x12\x18\n" +
 	"\avenueid\x18\x01 \x01(\x05R\avenueid\x125\n" +
 	"\x05seats\x18\x02 \x03(\v2\x1b.moviedb_service.SeatMatrixB\x02\x18\x01R\x05seats\x12$\n" +
-	"\x0eseat_matrix_id\x18\x03 \x01(\x05R\fseatMatrixId\"b\n" +
+	"\x0eseat_matrix_id\x18\x03 \x01(\x05R\fseatMatrixId\x123\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1f.moviedb_service.SeatChangeModeR\x04mode\"b\n" +
 	"\x18DeleteSeatMatrixResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"9\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"n\n" +
 	"\x1dDeleteEntireSeatMatrixRequest\x12\x18\n" +
-	"\avenueid\x18\x01 \x01(\x05R\avenueid\"h\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x123\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1f.moviedb_service.SeatChangeModeR\x04mode\"h\n" +
 	"\x1eDeleteEntireSeatMatrixResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"e\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"R\n" +
+	"\x1dApplySeatMatrixToShowsRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa2\x01\n" +
+	"\x0fShowSeatChanges\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12\x14\n" +
+	"\x05added\x18\x02 \x01(\x05R\x05added\x12\x18\n" +
+	"\aremoved\x18\x03 \x01(\x05R\aremoved\x12\x1e\n" +
+	"\n" +
+	"renumbered\x18\x04 \x01(\x05R\n" +
+	"renumbered\x12\x12\n" +
+	"\x04kept\x18\x05 \x01(\x05R\x04kept\"\xa0\x01\n" +
+	"\x1eApplySeatMatrixToShowsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\x05shows\x18\x03 \x03(\v2 .moviedb_service.ShowSeatChangesR\x05shows\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xf8\x02\n" +
+	"\tPriceRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1e.moviedb_service.PriceRuleKindR\x04kind\x12.\n" +
+	"\x06amount\x18\f \x01(\v2\x16.moviedb_service.MoneyR\x06amount\x12\x18\n" +
+	"\avenueid\x18\x05 \x01(\x05R\avenueid\x12+\n" +
+	"\x12movie_time_slot_id\x18\x06 \x01(\x05R\x0fmovieTimeSlotId\x12\x1b\n" +
+	"\tseat_type\x18\a \x01(\tR\bseatType\x12!\n" +
+	"\fmovie_format\x18\b \x01(\tR\vmovieFormat\x12 \n" +
+	"\fdays_of_week\x18\t \x03(\x05R\n" +
+	"daysOfWeek\x12\x1b\n" +
+	"\tfrom_time\x18\n" +
+	" \x01(\tR\bfromTime\x12\x17\n" +
+	"\ato_time\x18\v \x01(\tR\x06toTimeJ\x04\b\x04\x10\x05\"E\n" +
+	"\x13AddPriceRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.moviedb_service.PriceRuleR\x04rule\"\x8b\x01\n" +
+	"\x11PriceRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04rule\x18\x03 \x01(\v2\x1a.moviedb_service.PriceRuleR\x04rule\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"0\n" +
+	"\x14GetPriceRulesRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\"\x91\x01\n" +
+	"\x15GetPriceRulesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x05rules\x18\x03 \x03(\v2\x1a.moviedb_service.PriceRuleR\x05rules\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"(\n" +
+	"\x16DeletePriceRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"a\n" +
+	"\x17DeletePriceRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x9a\x02\n" +
+	"\aTaxRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fregion_codes\x18\x04 \x03(\tR\vregionCodes\x12<\n" +
+	"\n" +
+	"applies_to\x18\x05 \x01(\x0e2\x1d.moviedb_service.TaxAppliesToR\tappliesTo\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x05R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\a \x01(\bR\tinclusive\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\b \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\t \x01(\x03R\tmaxAmount\"A\n" +
+	"\x11AddTaxRuleRequest\x12,\n" +
+	"\x04rule\x18\x01 \x01(\v2\x18.moviedb_service.TaxRuleR\x04rule\"\x87\x01\n" +
+	"\x0fTaxRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04rule\x18\x03 \x01(\v2\x18.moviedb_service.TaxRuleR\x04rule\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"0\n" +
+	"\x12GetTaxRulesRequest\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\"\x8d\x01\n" +
+	"\x13GetTaxRulesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x05rules\x18\x03 \x03(\v2\x18.moviedb_service.TaxRuleR\x05rules\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"&\n" +
+	"\x14DeleteTaxRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"_\n" +
+	"\x15DeleteTaxRuleResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"y\n" +
+	"\x0fPriceAdjustment\x12\"\n" +
+	"\rprice_rule_id\x18\x01 \x01(\x05R\vpriceRuleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x06amount\x18\x03 \x01(\v2\x16.moviedb_service.MoneyR\x06amount\"\xdf\x01\n" +
+	"\tTaxAmount\x12\x1e\n" +
+	"\vtax_rule_id\x18\x01 \x01(\x05R\ttaxRuleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12<\n" +
+	"\n" +
+	"applies_to\x18\x03 \x01(\x0e2\x1d.moviedb_service.TaxAppliesToR\tappliesTo\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x05R\x04rate\x12\x1c\n" +
+	"\tinclusive\x18\x05 \x01(\bR\tinclusive\x12.\n" +
+	"\x06amount\x18\x06 \x01(\v2\x16.moviedb_service.MoneyR\x06amount\"\xcb\x04\n" +
+	"\tQuoteSeat\x12$\n" +
+	"\x0ebooked_seat_id\x18\x01 \x01(\x05R\fbookedSeatId\x12$\n" +
+	"\x0eseat_matrix_id\x18\x02 \x01(\x05R\fseatMatrixId\x12\x1f\n" +
+	"\vseat_number\x18\x03 \x01(\tR\n" +
+	"seatNumber\x12*\n" +
+	"\x04base\x18\x04 \x01(\v2\x16.moviedb_service.MoneyR\x04base\x12 \n" +
+	"\fbase_rule_id\x18\x05 \x01(\x05R\n" +
+	"baseRuleId\x12@\n" +
+	"\n" +
+	"surcharges\x18\x06 \x03(\v2 .moviedb_service.PriceAdjustmentR\n" +
+	"surcharges\x12>\n" +
+	"\tdiscounts\x18\a \x03(\v2 .moviedb_service.PriceAdjustmentR\tdiscounts\x122\n" +
+	"\brounding\x18\b \x01(\v2\x16.moviedb_service.MoneyR\brounding\x12,\n" +
+	"\x05price\x18\t \x01(\v2\x16.moviedb_service.MoneyR\x05price\x12?\n" +
+	"\x0fconvenience_fee\x18\n" +
+	" \x01(\v2\x16.moviedb_service.MoneyR\x0econvenienceFee\x120\n" +
+	"\x05taxes\x18\v \x03(\v2\x1a.moviedb_service.TaxAmountR\x05taxes\x12,\n" +
+	"\x05total\x18\f \x01(\v2\x16.moviedb_service.MoneyR\x05total\"\xd3\x04\n" +
+	"\fBookingQuote\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12+\n" +
+	"\x12movie_time_slot_id\x18\x02 \x01(\x05R\x0fmovieTimeSlotId\x12\x1d\n" +
+	"\n" +
+	"movie_name\x18\x03 \x01(\tR\tmovieName\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x120\n" +
+	"\x05seats\x18\x05 \x03(\v2\x1a.moviedb_service.QuoteSeatR\x05seats\x12*\n" +
+	"\x04base\x18\x06 \x01(\v2\x16.moviedb_service.MoneyR\x04base\x126\n" +
+	"\n" +
+	"surcharges\x18\a \x01(\v2\x16.moviedb_service.MoneyR\n" +
+	"surcharges\x124\n" +
+	"\tdiscounts\x18\b \x01(\v2\x16.moviedb_service.MoneyR\tdiscounts\x122\n" +
+	"\brounding\x18\t \x01(\v2\x16.moviedb_service.MoneyR\brounding\x12A\n" +
+	"\x10convenience_fees\x18\n" +
+	" \x01(\v2\x16.moviedb_service.MoneyR\x0fconvenienceFees\x120\n" +
+	"\x05taxes\x18\v \x03(\v2\x1a.moviedb_service.TaxAmountR\x05taxes\x12,\n" +
+	"\x05total\x18\f \x01(\v2\x16.moviedb_service.MoneyR\x05total\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"3\n" +
+	"\x16GetBookingQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\"\x96\x01\n" +
+	"\x17GetBookingQuoteResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\x05quote\x18\x03 \x01(\v2\x1d.moviedb_service.BookingQuoteR\x05quote\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"e\n" +
 	"\x18AddSingleSeatMatrixInput\x12\x18\n" +
 	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12/\n" +
 	"\x04seat\x18\x02 \x01(\v2\x1b.moviedb_service.SeatMatrixR\x04seat\"e\n" +
 	"\x1bAddSingleSeatMatrixResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"\xe9\x01\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa6\x02\n" +
 	"\vBookedSeats\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
 	"\vseat_number\x18\x02 \x01(\tR\n" +
 	"seatNumber\x12(\n" +
 	"\x0fmovieTimeSlotID\x18\x03 \x01(\x05R\x0fmovieTimeSlotID\x12\"\n" +
 	"\fseatMatrixID\x18\x04 \x01(\x05R\fseatMatrixID\x12\x1b\n" +
-	"\tis_booked\x18\x05 \x01(\bR\bisBooked\x12\x14\n" +
-	"\x05price\x18\b \x01(\x05R\x05price\x12\x1c\n" +
-	"\tmovieName\x18\t \x01(\tR\tmovieNameJ\x04\b\x06\x10\aJ\x04\b\a\x10\b\"\x84\x02\n" +
+	"\tis_booked\x18\x05 \x01(\bR\bisBooked\x12\x18\n" +
+	"\x05price\x18\b \x01(\x05B\x02\x18\x01R\x05price\x12\x1c\n" +
+	"\tmovieName\x18\t \x01(\tR\tmovieName\x127\n" +
+	"\vprice_money\x18\n" +
+	" \x01(\v2\x16.moviedb_service.MoneyR\n" +
+	"priceMoneyJ\x04\b\x06\x10\aJ\x04\b\a\x10\b\"\xa3\x02\n" +
 	"\x10BookSeatsRequest\x12J\n" +
 	"\x0fmovie_time_slot\x18\x01 \x01(\v2\x1e.moviedb_service.MovieTimeSlotB\x02\x18\x01R\rmovieTimeSlot\x122\n" +
 	"\x05seats\x18\x02 \x03(\v2\x1c.moviedb_service.BookedSeatsR\x05seats\x12+\n" +
 	"\x12movie_time_slot_id\x18\x03 \x01(\x05R\x0fmovieTimeSlotId\x12\x14\n" +
 	"\x05email\x18\x05 \x01(\tR\x05email\x12!\n" +
-	"\fphone_number\x18\a \x01(\tR\vphoneNumberJ\x04\b\x04\x10\x05J\x04\b\x06\x10\a\"\x7f\n" +
+	"\fphone_number\x18\a \x01(\tR\vphoneNumber\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\b \x01(\tR\tholdTokenJ\x04\b\x04\x10\x05J\x04\b\x06\x10\a\"\x7f\n" +
 	"\x11BookSeatsResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
@@ -3922,28 +11764,294 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12?\n" +
 	"\fbooked_seats\x18\x03 \x03(\v2\x1c.moviedb_service.BookedSeatsR\vbookedSeats\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"H\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa3\x01\n" +
 	"\x1cGetBookedSeatsDetailsRequest\x12(\n" +
-	"\x10booked_seats_ids\x18\x01 \x03(\x05R\x0ebookedSeatsIds\"\xa8\x01\n" +
+	"\x10booked_seats_ids\x18\x01 \x03(\x05R\x0ebookedSeatsIds\x12%\n" +
+	"\x0eidempotent_key\x18\x02 \x01(\tR\ridempotentKey\x122\n" +
+	"\x15hold_duration_seconds\x18\x03 \x01(\x05R\x13holdDurationSeconds\"\x93\x02\n" +
 	"\x1dGetBookedSeatsDetailsResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12?\n" +
 	"\fbooked_seats\x18\x03 \x03(\v2\x1c.moviedb_service.BookedSeatsR\vbookedSeats\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"{\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x05 \x01(\tR\tholdToken\x12!\n" +
+	"\flocked_until\x18\x06 \x01(\tR\vlockedUntil\x12'\n" +
+	"\x0fextensions_left\x18\a \x01(\x05R\x0eextensionsLeft\"\x9a\x01\n" +
 	"&IsValidToCommitSeatsForBooking_Request\x12+\n" +
 	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12$\n" +
-	"\rseatMatrixIds\x18\x02 \x03(\x05R\rseatMatrixIds\"\xb9\x01\n" +
+	"\rseatMatrixIds\x18\x02 \x03(\x05R\rseatMatrixIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x03 \x01(\tR\tholdToken\"\xee\x01\n" +
 	"'IsValidToCommitSeatsForBooking_Response\x12\x18\n" +
 	"\aisvalid\x18\x01 \x01(\bR\aisvalid\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\x12F\n" +
-	"\x0ftoBeBookedSeats\x18\x04 \x03(\v2\x1c.moviedb_service.BookedSeatsR\x0ftoBeBookedSeats\"a\n" +
+	"\x0ftoBeBookedSeats\x18\x04 \x03(\v2\x1c.moviedb_service.BookedSeatsR\x0ftoBeBookedSeats\x123\n" +
+	"\x05quote\x18\x05 \x01(\v2\x1d.moviedb_service.BookingQuoteR\x05quote\"\x9b\x01\n" +
 	"\x13CreateTicketRequest\x12%\n" +
 	"\x0eidempotent_key\x18\x01 \x01(\tR\ridempotentKey\x12#\n" +
-	"\rtrasaction_id\x18\x02 \x01(\tR\ftrasactionId\"E\n" +
+	"\rtrasaction_id\x18\x02 \x01(\tR\ftrasactionId\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x03 \x01(\tR\tholdToken\x12\x19\n" +
+	"\bquote_id\x18\x04 \x01(\tR\aquoteId\"E\n" +
 	"\x15CreateRequestResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error*C\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"M\n" +
+	"\x1eReleaseExpiredSeatLocksRequest\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\"\xa4\x01\n" +
+	"\x1fReleaseExpiredSeatLocksResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x19released_booked_seats_ids\x18\x03 \x03(\x05R\x16releasedBookedSeatsIds\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"j\n" +
+	"\x17ReleaseSeatLocksRequest\x12%\n" +
+	"\x0eidempotent_key\x18\x01 \x01(\tR\ridempotentKey\x12(\n" +
+	"\x10booked_seats_ids\x18\x02 \x03(\x05R\x0ebookedSeatsIds\"\x9d\x01\n" +
+	"\x18ReleaseSeatLocksResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\x19released_booked_seats_ids\x18\x03 \x03(\x05R\x16releasedBookedSeatsIds\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x85\x01\n" +
+	"\x18GetMovieShowtimesRequest\x12\x18\n" +
+	"\amovieid\x18\x01 \x01(\x05R\amovieid\x12\x18\n" +
+	"\avenueid\x18\x02 \x01(\x05R\avenueid\x12!\n" +
+	"\fmovie_format\x18\x03 \x01(\tR\vmovieFormat\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\"\xad\x01\n" +
+	"\x19GetMovieShowtimesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12H\n" +
+	"\x10movie_time_slots\x18\x03 \x03(\v2\x1e.moviedb_service.MovieTimeSlotR\x0emovieTimeSlots\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x9a\x03\n" +
+	"\bShowSeat\x12$\n" +
+	"\x0ebooked_seat_id\x18\x01 \x01(\x05R\fbookedSeatId\x12$\n" +
+	"\x0eseat_matrix_id\x18\x02 \x01(\x05R\fseatMatrixId\x12\x1f\n" +
+	"\vseat_number\x18\x03 \x01(\tR\n" +
+	"seatNumber\x12\x10\n" +
+	"\x03row\x18\x04 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\x05R\x06column\x12-\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x19.moviedb_service.SeatTypeR\x04type\x12\x18\n" +
+	"\x05price\x18\a \x01(\x05B\x02\x18\x01R\x05price\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x1b.moviedb_service.SeatStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"held_until\x18\t \x01(\tR\theldUntil\x12!\n" +
+	"\fblock_reason\x18\n" +
+	" \x01(\tR\vblockReason\x127\n" +
+	"\vprice_money\x18\v \x01(\v2\x16.moviedb_service.MoneyR\n" +
+	"priceMoney\"\x90\x02\n" +
+	"\tSeatBlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\avenueid\x18\x02 \x01(\x05R\avenueid\x12$\n" +
+	"\x0eseat_matrix_id\x18\x03 \x01(\x05R\fseatMatrixId\x12+\n" +
+	"\x12movie_time_slot_id\x18\x04 \x01(\x05R\x0fmovieTimeSlotId\x128\n" +
+	"\x06reason\x18\x05 \x01(\x0e2 .moviedb_service.SeatBlockReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\a \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\b \x01(\tR\aendTime\"\x8a\x02\n" +
+	"\x11BlockSeatsRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12+\n" +
+	"\x12movie_time_slot_id\x18\x02 \x01(\x05R\x0fmovieTimeSlotId\x12&\n" +
+	"\x0fseat_matrix_ids\x18\x03 \x03(\x05R\rseatMatrixIds\x128\n" +
+	"\x06reason\x18\x04 \x01(\x0e2 .moviedb_service.SeatBlockReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\"2\n" +
+	"\x13UnblockSeatsRequest\x12\x1b\n" +
+	"\tblock_ids\x18\x01 \x03(\x05R\bblockIds\"^\n" +
+	"\x14UnblockSeatsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"]\n" +
+	"\x14GetSeatBlocksRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12+\n" +
+	"\x12movie_time_slot_id\x18\x02 \x01(\x05R\x0fmovieTimeSlotId\"\x90\x01\n" +
+	"\x12SeatBlocksResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\x06blocks\x18\x03 \x03(\v2\x1a.moviedb_service.SeatBlockR\x06blocks\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xd1\x01\n" +
+	"\x18GetShowSeatLayoutRequest\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12\x18\n" +
+	"\amovieid\x18\x02 \x01(\x05R\amovieid\x12\x18\n" +
+	"\avenueid\x18\x03 \x01(\x05R\avenueid\x12!\n" +
+	"\fmovie_format\x18\x04 \x01(\tR\vmovieFormat\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\"\xa9\x02\n" +
+	"\x19GetShowSeatLayoutResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12F\n" +
+	"\x0fmovie_time_slot\x18\x03 \x01(\v2\x1e.moviedb_service.MovieTimeSlotR\rmovieTimeSlot\x12,\n" +
+	"\x05venue\x18\x04 \x01(\v2\x16.moviedb_service.VenueR\x05venue\x12/\n" +
+	"\x05seats\x18\x05 \x03(\v2\x19.moviedb_service.ShowSeatR\x05seats\x12\x1d\n" +
+	"\n" +
+	"free_seats\x18\x06 \x01(\x05R\tfreeSeats\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xb6\x01\n" +
+	"\n" +
+	"LayoutCell\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\x05R\x06column\x123\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1f.moviedb_service.LayoutCellKindR\x04kind\x12/\n" +
+	"\x04seat\x18\x04 \x01(\v2\x1b.moviedb_service.SeatMatrixR\x04seat\x12\x18\n" +
+	"\asection\x18\x05 \x01(\tR\asection\"f\n" +
+	"\tLayoutRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x121\n" +
+	"\x05cells\x18\x03 \x03(\v2\x1b.moviedb_service.LayoutCellR\x05cells\"\x9d\x01\n" +
+	"\vSeatSection\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tfirst_row\x18\x02 \x01(\x05R\bfirstRow\x12\x19\n" +
+	"\blast_row\x18\x03 \x01(\x05R\alastRow\x12!\n" +
+	"\ffirst_column\x18\x04 \x01(\x05R\vfirstColumn\x12\x1f\n" +
+	"\vlast_column\x18\x05 \x01(\x05R\n" +
+	"lastColumn\"1\n" +
+	"\x15GetVenueLayoutRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\"\xa8\x02\n" +
+	"\x15SetVenueLayoutRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12H\n" +
+	"\x0fscreen_position\x18\x02 \x01(\x0e2\x1f.moviedb_service.ScreenPositionR\x0escreenPosition\x12\x1f\n" +
+	"\vcurved_rows\x18\x03 \x01(\bR\n" +
+	"curvedRows\x12\x1d\n" +
+	"\n" +
+	"row_labels\x18\x04 \x03(\tR\trowLabels\x121\n" +
+	"\x05cells\x18\x05 \x03(\v2\x1b.moviedb_service.LayoutCellR\x05cells\x128\n" +
+	"\bsections\x18\x06 \x03(\v2\x1c.moviedb_service.SeatSectionR\bsections\"\xe0\x02\n" +
+	"\x13VenueLayoutResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x05venue\x18\x03 \x01(\v2\x16.moviedb_service.VenueR\x05venue\x12H\n" +
+	"\x0fscreen_position\x18\x04 \x01(\x0e2\x1f.moviedb_service.ScreenPositionR\x0escreenPosition\x12\x1f\n" +
+	"\vcurved_rows\x18\x05 \x01(\bR\n" +
+	"curvedRows\x12.\n" +
+	"\x04rows\x18\x06 \x03(\v2\x1a.moviedb_service.LayoutRowR\x04rows\x128\n" +
+	"\bsections\x18\a \x03(\v2\x1c.moviedb_service.SeatSectionR\bsections\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\xba\x02\n" +
+	"\x15ImportSeatGridRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x127\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1f.moviedb_service.SeatGridFormatR\x06format\x12J\n" +
+	"\x06prices\x18\x04 \x03(\v22.moviedb_service.ImportSeatGridRequest.PricesEntryR\x06prices\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"j\n" +
+	"\x15ExportSeatGridRequest\x12\x18\n" +
+	"\avenueid\x18\x01 \x01(\x05R\avenueid\x127\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1f.moviedb_service.SeatGridFormatR\x06format\"\x98\x02\n" +
+	"\x16ExportSeatGridResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12K\n" +
+	"\x06prices\x18\x04 \x03(\v23.moviedb_service.ExportSeatGridResponse.PricesEntryR\x06prices\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xeb\x01\n" +
+	"\x15LayoutTemplateVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12J\n" +
+	"\x06prices\x18\x03 \x03(\v22.moviedb_service.LayoutTemplateVersion.PricesEntryR\x06prices\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xd0\x01\n" +
+	"\x0eLayoutTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12B\n" +
+	"\bversions\x18\x05 \x03(\v2&.moviedb_service.LayoutTemplateVersionR\bversions\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\xec\x02\n" +
+	"\x1bCreateLayoutTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x127\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1f.moviedb_service.SeatGridFormatR\x06format\x12P\n" +
+	"\x06prices\x18\x05 \x03(\v28.moviedb_service.CreateLayoutTemplateRequest.PricesEntryR\x06prices\x12!\n" +
+	"\ffrom_venueid\x18\x06 \x01(\x05R\vfromVenueid\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb4\x02\n" +
+	"\x1bUpdateLayoutTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x05R\n" +
+	"templateId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x127\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1f.moviedb_service.SeatGridFormatR\x06format\x12P\n" +
+	"\x06prices\x18\x04 \x03(\v28.moviedb_service.UpdateLayoutTemplateRequest.PricesEntryR\x06prices\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x1a9\n" +
+	"\vPricesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\";\n" +
+	"\x18GetLayoutTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x05R\n" +
+	"templateId\"\x9d\x01\n" +
+	"\x16LayoutTemplateResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\btemplate\x18\x03 \x01(\v2\x1f.moviedb_service.LayoutTemplateR\btemplate\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"q\n" +
+	"\x1aApplyLayoutTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x05R\n" +
+	"templateId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x18\n" +
+	"\avenueid\x18\x03 \x01(\x05R\avenueid\"Z\n" +
+	"\x16CloneSeatLayoutRequest\x12!\n" +
+	"\ffrom_venueid\x18\x01 \x01(\x05R\vfromVenueid\x12\x1d\n" +
+	"\n" +
+	"to_venueid\x18\x02 \x01(\x05R\ttoVenueid\"\xed\x01\n" +
+	"\x13SuggestSeatsRequest\x12+\n" +
+	"\x12movie_time_slot_id\x18\x01 \x01(\x05R\x0fmovieTimeSlotId\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x02 \x01(\x05R\tpartySize\x12\x1b\n" +
+	"\tseat_type\x18\x03 \x01(\tR\bseatType\x12\x12\n" +
+	"\x04hold\x18\x04 \x01(\bR\x04hold\x12%\n" +
+	"\x0eidempotent_key\x18\x05 \x01(\tR\ridempotentKey\x122\n" +
+	"\x15hold_duration_seconds\x18\x06 \x01(\x05R\x13holdDurationSeconds\"\xfa\x01\n" +
+	"\x14SuggestSeatsResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x05seats\x18\x03 \x03(\v2\x19.moviedb_service.ShowSeatR\x05seats\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x05 \x01(\tR\tholdToken\x12!\n" +
+	"\flocked_until\x18\x06 \x01(\tR\vlockedUntil\x12'\n" +
+	"\x0fextensions_left\x18\a \x01(\x05R\x0eextensionsLeft\"b\n" +
+	"\x15ExtendSeatHoldRequest\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x01 \x01(\tR\tholdToken\x12*\n" +
+	"\x11extend_by_seconds\x18\x02 \x01(\x05R\x0fextendBySeconds\"\xac\x01\n" +
+	"\x16ExtendSeatHoldResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\flocked_until\x18\x03 \x01(\tR\vlockedUntil\x12'\n" +
+	"\x0fextensions_left\x18\x04 \x01(\x05R\x0eextensionsLeft\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xc2\x01\n" +
+	"\x13SearchMoviesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12)\n" +
+	"\x10movie_resolution\x18\x04 \x01(\tR\x0fmovieResolution\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xd2\x01\n" +
+	"\x11MovieSearchResult\x12,\n" +
+	"\x05movie\x18\x01 \x01(\v2\x16.moviedb_service.MovieR\x05movie\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x12/\n" +
+	"\x13description_snippet\x18\x04 \x01(\tR\x12descriptionSnippet\x12!\n" +
+	"\fmatched_cast\x18\x05 \x03(\tR\vmatchedCast\"\xc4\x01\n" +
+	"\x14SearchMoviesResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\aresults\x18\x03 \x03(\v2\".moviedb_service.MovieSearchResultR\aresults\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken*C\n" +
 	"\bSeatType\x12\t\n" +
 	"\x05TWO_D\x10\x00\x12\v\n" +
 	"\aTHREE_D\x10\x01\x12\n" +
@@ -3951,7 +12059,19 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x06FOUR_D\x10\x02\x12\n" +
 	"\n" +
 	"\x06NORMAL\x10\x03\x12\a\n" +
-	"\x03VIP\x10\x04*\xb8\x03\n" +
+	"\x03VIP\x10\x04*^\n" +
+	"\vMovieFormat\x12\r\n" +
+	"\tFORMAT_2D\x10\x00\x12\r\n" +
+	"\tFORMAT_3D\x10\x01\x12\x0e\n" +
+	"\n" +
+	"FORMAT_4DX\x10\x02\x12\x0f\n" +
+	"\vFORMAT_IMAX\x10\x03\x12\x10\n" +
+	"\fFORMAT_DOLBY\x10\x04*?\n" +
+	"\fRoundingMode\x12\x11\n" +
+	"\rROUND_HALF_UP\x10\x00\x12\f\n" +
+	"\bROUND_UP\x10\x01\x12\x0e\n" +
+	"\n" +
+	"ROUND_DOWN\x10\x02*\xb8\x03\n" +
 	"\x0fCastAndCrewType\x12\t\n" +
 	"\x05ACTOR\x10\x00\x12\f\n" +
 	"\bDIRECTOR\x10\x01\x12\f\n" +
@@ -3987,7 +12107,13 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\x05MOVIE\x10\x00\x12\v\n" +
 	"\aCONCERT\x10\x01\x12\b\n" +
 	"\x04PLAY\x10\x02\x12\v\n" +
-	"\aSTANDUP\x10\x03*'\n" +
+	"\aSTANDUP\x10\x03*5\n" +
+	"\fDistanceUnit\x12\x0e\n" +
+	"\n" +
+	"KILOMETERS\x10\x00\x12\t\n" +
+	"\x05MILES\x10\x01\x12\n" +
+	"\n" +
+	"\x06METERS\x10\x02*'\n" +
 	"\x06SortBy\x12\r\n" +
 	"\tASCENDING\x10\x00\x12\x0e\n" +
 	"\n" +
@@ -3995,16 +12121,55 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\bFilterBy\x12\n" +
 	"\n" +
 	"\x06RATING\x10\x00\x12\b\n" +
-	"\x04DATE\x10\x012\x9a\x17\n" +
+	"\x04DATE\x10\x01*F\n" +
+	"\x0eSeatChangeMode\x12\x16\n" +
+	"\x12SEAT_CHANGE_REJECT\x10\x00\x12\x1c\n" +
+	"\x18SEAT_CHANGE_UNSOLD_SHOWS\x10\x01*3\n" +
+	"\rPriceRuleKind\x12\x0e\n" +
+	"\n" +
+	"RULE_PRICE\x10\x00\x12\x12\n" +
+	"\x0eRULE_SURCHARGE\x10\x01*+\n" +
+	"\fTaxAppliesTo\x12\x0e\n" +
+	"\n" +
+	"TAX_TICKET\x10\x00\x12\v\n" +
+	"\aTAX_FEE\x10\x01*9\n" +
+	"\n" +
+	"SeatStatus\x12\b\n" +
+	"\x04FREE\x10\x00\x12\b\n" +
+	"\x04HELD\x10\x01\x12\n" +
+	"\n" +
+	"\x06BOOKED\x10\x02\x12\v\n" +
+	"\aBLOCKED\x10\x03*\\\n" +
+	"\x0fSeatBlockReason\x12\x10\n" +
+	"\fBLOCK_BROKEN\x10\x00\x12\x0f\n" +
+	"\vBLOCK_HOUSE\x10\x01\x12\x14\n" +
+	"\x10BLOCK_DISTANCING\x10\x02\x12\x10\n" +
+	"\fBLOCK_CAMERA\x10\x03*v\n" +
+	"\x0eLayoutCellKind\x12\x0e\n" +
+	"\n" +
+	"CELL_EMPTY\x10\x00\x12\r\n" +
+	"\tCELL_SEAT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"CELL_AISLE\x10\x02\x12\x0f\n" +
+	"\vCELL_STAIRS\x10\x03\x12\x0f\n" +
+	"\vCELL_PILLAR\x10\x04\x12\x13\n" +
+	"\x0fCELL_WHEELCHAIR\x10\x05*3\n" +
+	"\x0eScreenPosition\x12\x0e\n" +
+	"\n" +
+	"SCREEN_TOP\x10\x00\x12\x11\n" +
+	"\rSCREEN_BOTTOM\x10\x01*-\n" +
+	"\x0eSeatGridFormat\x12\r\n" +
+	"\tGRID_TEXT\x10\x00\x12\f\n" +
+	"\bGRID_CSV\x10\x012\xb05\n" +
 	"\x0eMovieDBService\x12B\n" +
 	"\bAddMovie\x12\x16.moviedb_service.Movie\x1a\x1e.moviedb_service.MovieResponse\x12I\n" +
-	"\bGetMovie\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.MovieResponse\x12J\n" +
-	"\fGetAllMovies\x12\x16.google.protobuf.Empty\x1a\".moviedb_service.MovieListResponse\x12E\n" +
+	"\bGetMovie\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.MovieResponse\x12X\n" +
+	"\fGetAllMovies\x12$.moviedb_service.GetAllMoviesRequest\x1a\".moviedb_service.MovieListResponse\x12E\n" +
 	"\vUpdateMovie\x12\x16.moviedb_service.Movie\x1a\x1e.moviedb_service.MovieResponse\x12L\n" +
 	"\vDeleteMovie\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.MovieResponse\x12B\n" +
 	"\bAddVenue\x12\x16.moviedb_service.Venue\x1a\x1e.moviedb_service.VenueResponse\x12I\n" +
-	"\bGetVenue\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.VenueResponse\x12J\n" +
-	"\fGetAllVenues\x12\x16.google.protobuf.Empty\x1a\".moviedb_service.MovieListResponse\x12E\n" +
+	"\bGetVenue\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.VenueResponse\x12X\n" +
+	"\fGetAllVenues\x12$.moviedb_service.GetAllVenuesRequest\x1a\".moviedb_service.VenueListResponse\x12E\n" +
 	"\vUpdateVenue\x12\x16.moviedb_service.Venue\x1a\x1e.moviedb_service.VenueResponse\x12L\n" +
 	"\vDeleteVenue\x12\x1d.moviedb_service.MovieRequest\x1a\x1e.moviedb_service.MovieResponse\x12h\n" +
 	"\x11GetUpcomingMovies\x12(.moviedb_service.GetUpcomingMovieRequest\x1a).moviedb_service.GetUpcomingMovieResponse\x12l\n" +
@@ -4023,12 +12188,53 @@ const file_moviedb_service_proto_rawDesc = "" +
 	"\rGetSeatMatrix\x12%.moviedb_service.GetSeatMatrixRequest\x1a&.moviedb_service.GetSeatMatrixResponse\x12g\n" +
 	"\x10UpdateSeatMatrix\x12(.moviedb_service.UpdateSeatMatrixRequest\x1a).moviedb_service.UpdateSeatMatrixResponse\x12g\n" +
 	"\x10DeleteSeatMatrix\x12(.moviedb_service.DeleteSeatMatrixRequest\x1a).moviedb_service.DeleteSeatMatrixResponse\x12y\n" +
-	"\x16DeleteEntireSeatMatrix\x12..moviedb_service.DeleteEntireSeatMatrixRequest\x1a/.moviedb_service.DeleteEntireSeatMatrixResponse\x12R\n" +
+	"\x16DeleteEntireSeatMatrix\x12..moviedb_service.DeleteEntireSeatMatrixRequest\x1a/.moviedb_service.DeleteEntireSeatMatrixResponse\x12y\n" +
+	"\x16ApplySeatMatrixToShows\x12..moviedb_service.ApplySeatMatrixToShowsRequest\x1a/.moviedb_service.ApplySeatMatrixToShowsResponse\x12U\n" +
+	"\n" +
+	"BlockSeats\x12\".moviedb_service.BlockSeatsRequest\x1a#.moviedb_service.SeatBlocksResponse\x12[\n" +
+	"\fUnblockSeats\x12$.moviedb_service.UnblockSeatsRequest\x1a%.moviedb_service.UnblockSeatsResponse\x12[\n" +
+	"\rGetSeatBlocks\x12%.moviedb_service.GetSeatBlocksRequest\x1a#.moviedb_service.SeatBlocksResponse\x12X\n" +
+	"\fAddPriceRule\x12$.moviedb_service.AddPriceRuleRequest\x1a\".moviedb_service.PriceRuleResponse\x12^\n" +
+	"\rGetPriceRules\x12%.moviedb_service.GetPriceRulesRequest\x1a&.moviedb_service.GetPriceRulesResponse\x12d\n" +
+	"\x0fDeletePriceRule\x12'.moviedb_service.DeletePriceRuleRequest\x1a(.moviedb_service.DeletePriceRuleResponse\x12R\n" +
+	"\n" +
+	"AddTaxRule\x12\".moviedb_service.AddTaxRuleRequest\x1a .moviedb_service.TaxRuleResponse\x12X\n" +
+	"\vGetTaxRules\x12#.moviedb_service.GetTaxRulesRequest\x1a$.moviedb_service.GetTaxRulesResponse\x12^\n" +
+	"\rDeleteTaxRule\x12%.moviedb_service.DeleteTaxRuleRequest\x1a&.moviedb_service.DeleteTaxRuleResponse\x12d\n" +
+	"\x0fGetBookingQuote\x12'.moviedb_service.GetBookingQuoteRequest\x1a(.moviedb_service.GetBookingQuoteResponse\x12R\n" +
 	"\tBookSeats\x12!.moviedb_service.BookSeatsRequest\x1a\".moviedb_service.BookSeatsResponse\x12a\n" +
 	"\x0eGetBookedSeats\x12&.moviedb_service.GetBookedSeatsRequest\x1a'.moviedb_service.GetBookedSeatsResponse\x12\x93\x01\n" +
 	"\x1eIsValidToCommitSeatsForBooking\x127.moviedb_service.IsValidToCommitSeatsForBooking_Request\x1a8.moviedb_service.IsValidToCommitSeatsForBooking_Response\x12p\n" +
 	"\x0fLockBookedSeats\x12-.moviedb_service.GetBookedSeatsDetailsRequest\x1a..moviedb_service.GetBookedSeatsDetailsResponse\x12\\\n" +
-	"\fCreateTicket\x12$.moviedb_service.CreateTicketRequest\x1a&.moviedb_service.CreateRequestResponseBFZDgithub.com/kartik7120/booking_moviedb_service/cmd/grpcServer;moviedbb\x06proto3"
+	"\fCreateTicket\x12$.moviedb_service.CreateTicketRequest\x1a&.moviedb_service.CreateRequestResponse\x12|\n" +
+	"\x17ReleaseExpiredSeatLocks\x12/.moviedb_service.ReleaseExpiredSeatLocksRequest\x1a0.moviedb_service.ReleaseExpiredSeatLocksResponse\x12g\n" +
+	"\x10ReleaseSeatLocks\x12(.moviedb_service.ReleaseSeatLocksRequest\x1a).moviedb_service.ReleaseSeatLocksResponse\x12a\n" +
+	"\x0eExtendSeatHold\x12&.moviedb_service.ExtendSeatHoldRequest\x1a'.moviedb_service.ExtendSeatHoldResponse\x12j\n" +
+	"\x11GetMovieShowtimes\x12).moviedb_service.GetMovieShowtimesRequest\x1a*.moviedb_service.GetMovieShowtimesResponse\x12j\n" +
+	"\x11GetShowSeatLayout\x12).moviedb_service.GetShowSeatLayoutRequest\x1a*.moviedb_service.GetShowSeatLayoutResponse\x12[\n" +
+	"\fSuggestSeats\x12$.moviedb_service.SuggestSeatsRequest\x1a%.moviedb_service.SuggestSeatsResponse\x12^\n" +
+	"\x0eGetVenueLayout\x12&.moviedb_service.GetVenueLayoutRequest\x1a$.moviedb_service.VenueLayoutResponse\x12^\n" +
+	"\x0eSetVenueLayout\x12&.moviedb_service.SetVenueLayoutRequest\x1a$.moviedb_service.VenueLayoutResponse\x12^\n" +
+	"\x0eImportSeatGrid\x12&.moviedb_service.ImportSeatGridRequest\x1a$.moviedb_service.VenueLayoutResponse\x12a\n" +
+	"\x0eExportSeatGrid\x12&.moviedb_service.ExportSeatGridRequest\x1a'.moviedb_service.ExportSeatGridResponse\x12m\n" +
+	"\x14CreateLayoutTemplate\x12,.moviedb_service.CreateLayoutTemplateRequest\x1a'.moviedb_service.LayoutTemplateResponse\x12m\n" +
+	"\x14UpdateLayoutTemplate\x12,.moviedb_service.UpdateLayoutTemplateRequest\x1a'.moviedb_service.LayoutTemplateResponse\x12g\n" +
+	"\x11GetLayoutTemplate\x12).moviedb_service.GetLayoutTemplateRequest\x1a'.moviedb_service.LayoutTemplateResponse\x12h\n" +
+	"\x13ApplyLayoutTemplate\x12+.moviedb_service.ApplyLayoutTemplateRequest\x1a$.moviedb_service.VenueLayoutResponse\x12`\n" +
+	"\x0fCloneSeatLayout\x12'.moviedb_service.CloneSeatLayoutRequest\x1a$.moviedb_service.VenueLayoutResponse\x12[\n" +
+	"\fSearchMovies\x12$.moviedb_service.SearchMoviesRequest\x1a%.moviedb_service.SearchMoviesResponse\x12E\n" +
+	"\tAddCinema\x12\x17.moviedb_service.Cinema\x1a\x1f.moviedb_service.CinemaResponse\x12L\n" +
+	"\tGetCinema\x12\x1e.moviedb_service.CinemaRequest\x1a\x1f.moviedb_service.CinemaResponse\x12[\n" +
+	"\rGetAllCinemas\x12%.moviedb_service.GetAllCinemasRequest\x1a#.moviedb_service.CinemaListResponse\x12H\n" +
+	"\fUpdateCinema\x12\x17.moviedb_service.Cinema\x1a\x1f.moviedb_service.CinemaResponse\x12O\n" +
+	"\fDeleteCinema\x12\x1e.moviedb_service.CinemaRequest\x1a\x1f.moviedb_service.CinemaResponse\x12y\n" +
+	"\x16MigrateVenuesToCinemas\x12..moviedb_service.MigrateVenuesToCinemasRequest\x1a/.moviedb_service.MigrateVenuesToCinemasResponse\x12a\n" +
+	"\x12CreateShowSchedule\x12$.moviedb_service.ShowScheduleRequest\x1a%.moviedb_service.ShowScheduleResponse\x12a\n" +
+	"\x12UpdateShowSchedule\x12$.moviedb_service.ShowScheduleRequest\x1a%.moviedb_service.ShowScheduleResponse\x12g\n" +
+	"\x12CancelShowSchedule\x12*.moviedb_service.CancelShowScheduleRequest\x1a%.moviedb_service.ShowScheduleResponse\x12^\n" +
+	"\rPlanProgramme\x12%.moviedb_service.PlanProgrammeRequest\x1a&.moviedb_service.PlanProgrammeResponse\x12l\n" +
+	"\x11SetShowtimeStatus\x12).moviedb_service.SetShowtimeStatusRequest\x1a,.moviedb_service.MovieTimeSlotUpdateResponse\x12a\n" +
+	"\x0eCancelShowtime\x12&.moviedb_service.CancelShowtimeRequest\x1a'.moviedb_service.CancelShowtimeResponseBFZDgithub.com/kartik7120/booking_moviedb_service/cmd/grpcServer;moviedbb\x06proto3"
 
 var (
 	file_moviedb_service_proto_rawDescOnce sync.Once
@@ -4042,168 +12248,453 @@ func file_moviedb_service_proto_rawDescGZIP() []byte {
 	return file_moviedb_service_proto_rawDescData
 }
 
-var file_moviedb_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_moviedb_service_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_moviedb_service_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_moviedb_service_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
 var file_moviedb_service_proto_goTypes = []any{
 	(SeatType)(0),                                   // 0: moviedb_service.SeatType
-	(CastAndCrewType)(0),                            // 1: moviedb_service.CastAndCrewType
-	(VenueType)(0),                                  // 2: moviedb_service.VenueType
-	(SortBy)(0),                                     // 3: moviedb_service.SortBy
-	(FilterBy)(0),                                   // 4: moviedb_service.FilterBy
-	(*SeatMatrix)(nil),                              // 5: moviedb_service.SeatMatrix
-	(*AddSeatMatrixInput)(nil),                      // 6: moviedb_service.AddSeatMatrixInput
-	(*AddSeatMatrixResponse)(nil),                   // 7: moviedb_service.AddSeatMatrixResponse
-	(*CastAndCrew)(nil),                             // 8: moviedb_service.CastAndCrew
-	(*MovieTimeSlot)(nil),                           // 9: moviedb_service.MovieTimeSlot
-	(*Movie)(nil),                                   // 10: moviedb_service.Movie
-	(*Venue)(nil),                                   // 11: moviedb_service.Venue
-	(*MovieList)(nil),                               // 12: moviedb_service.MovieList
-	(*MovieRequest)(nil),                            // 13: moviedb_service.MovieRequest
-	(*MovieResponse)(nil),                           // 14: moviedb_service.MovieResponse
-	(*MovieListResponse)(nil),                       // 15: moviedb_service.MovieListResponse
-	(*VenueResponse)(nil),                           // 16: moviedb_service.VenueResponse
-	(*GetUpcomingMovieRequest)(nil),                 // 17: moviedb_service.GetUpcomingMovieRequest
-	(*GetUpcomingMovieResponse)(nil),                // 18: moviedb_service.GetUpcomingMovieResponse
-	(*GetNowPlayingMovieRequest)(nil),               // 19: moviedb_service.GetNowPlayingMovieRequest
-	(*Review)(nil),                                  // 20: moviedb_service.Review
-	(*ReviewUpdateRequest)(nil),                     // 21: moviedb_service.ReviewUpdateRequest
-	(*ReviewResponse)(nil),                          // 22: moviedb_service.ReviewResponse
-	(*ReviewRequest)(nil),                           // 23: moviedb_service.ReviewRequest
-	(*ReviewList)(nil),                              // 24: moviedb_service.ReviewList
-	(*ReviewListResponse)(nil),                      // 25: moviedb_service.ReviewListResponse
-	(*GetAllMovieReviewsRequest)(nil),               // 26: moviedb_service.GetAllMovieReviewsRequest
-	(*GetMovieTimeSlotRequest)(nil),                 // 27: moviedb_service.GetMovieTimeSlotRequest
-	(*GetMovieTimeSlotResponse)(nil),                // 28: moviedb_service.GetMovieTimeSlotResponse
-	(*MovieTimeSlotResponse)(nil),                   // 29: moviedb_service.MovieTimeSlotResponse
-	(*MovieTimeSlotUpdateResponse)(nil),             // 30: moviedb_service.MovieTimeSlotUpdateResponse
-	(*MovieTimeSlotUpdate)(nil),                     // 31: moviedb_service.MovieTimeSlotUpdate
-	(*MovieTimeSlotDelete)(nil),                     // 32: moviedb_service.MovieTimeSlotDelete
-	(*GetSeatMatrixRequest)(nil),                    // 33: moviedb_service.GetSeatMatrixRequest
-	(*GetSeatMatrixResponse)(nil),                   // 34: moviedb_service.GetSeatMatrixResponse
-	(*UpdateSeatMatrixRequest)(nil),                 // 35: moviedb_service.UpdateSeatMatrixRequest
-	(*UpdateSeatMatrixResponse)(nil),                // 36: moviedb_service.UpdateSeatMatrixResponse
-	(*DeleteSeatMatrixRequest)(nil),                 // 37: moviedb_service.DeleteSeatMatrixRequest
-	(*DeleteSeatMatrixResponse)(nil),                // 38: moviedb_service.DeleteSeatMatrixResponse
-	(*DeleteEntireSeatMatrixRequest)(nil),           // 39: moviedb_service.DeleteEntireSeatMatrixRequest
-	(*DeleteEntireSeatMatrixResponse)(nil),          // 40: moviedb_service.DeleteEntireSeatMatrixResponse
-	(*AddSingleSeatMatrixInput)(nil),                // 41: moviedb_service.AddSingleSeatMatrixInput
-	(*AddSingleSeatMatrixResponse)(nil),             // 42: moviedb_service.AddSingleSeatMatrixResponse
-	(*BookedSeats)(nil),                             // 43: moviedb_service.BookedSeats
-	(*BookSeatsRequest)(nil),                        // 44: moviedb_service.BookSeatsRequest
-	(*BookSeatsResponse)(nil),                       // 45: moviedb_service.BookSeatsResponse
-	(*GetBookedSeatsRequest)(nil),                   // 46: moviedb_service.GetBookedSeatsRequest
-	(*GetBookedSeatsResponse)(nil),                  // 47: moviedb_service.GetBookedSeatsResponse
-	(*GetBookedSeatsDetailsRequest)(nil),            // 48: moviedb_service.GetBookedSeatsDetailsRequest
-	(*GetBookedSeatsDetailsResponse)(nil),           // 49: moviedb_service.GetBookedSeatsDetailsResponse
-	(*IsValidToCommitSeatsForBooking_Request)(nil),  // 50: moviedb_service.IsValidToCommitSeatsForBooking_Request
-	(*IsValidToCommitSeatsForBooking_Response)(nil), // 51: moviedb_service.IsValidToCommitSeatsForBooking_Response
-	(*CreateTicketRequest)(nil),                     // 52: moviedb_service.CreateTicketRequest
-	(*CreateRequestResponse)(nil),                   // 53: moviedb_service.CreateRequestResponse
-	(*empty.Empty)(nil),                             // 54: google.protobuf.Empty
+	(MovieFormat)(0),                                // 1: moviedb_service.MovieFormat
+	(RoundingMode)(0),                               // 2: moviedb_service.RoundingMode
+	(CastAndCrewType)(0),                            // 3: moviedb_service.CastAndCrewType
+	(VenueType)(0),                                  // 4: moviedb_service.VenueType
+	(DistanceUnit)(0),                               // 5: moviedb_service.DistanceUnit
+	(SortBy)(0),                                     // 6: moviedb_service.SortBy
+	(FilterBy)(0),                                   // 7: moviedb_service.FilterBy
+	(SeatChangeMode)(0),                             // 8: moviedb_service.SeatChangeMode
+	(PriceRuleKind)(0),                              // 9: moviedb_service.PriceRuleKind
+	(TaxAppliesTo)(0),                               // 10: moviedb_service.TaxAppliesTo
+	(SeatStatus)(0),                                 // 11: moviedb_service.SeatStatus
+	(SeatBlockReason)(0),                            // 12: moviedb_service.SeatBlockReason
+	(LayoutCellKind)(0),                             // 13: moviedb_service.LayoutCellKind
+	(ScreenPosition)(0),                             // 14: moviedb_service.ScreenPosition
+	(SeatGridFormat)(0),                             // 15: moviedb_service.SeatGridFormat
+	(*Money)(nil),                                   // 16: moviedb_service.Money
+	(*SeatMatrix)(nil),                              // 17: moviedb_service.SeatMatrix
+	(*AddSeatMatrixInput)(nil),                      // 18: moviedb_service.AddSeatMatrixInput
+	(*AddSeatMatrixResponse)(nil),                   // 19: moviedb_service.AddSeatMatrixResponse
+	(*CastAndCrew)(nil),                             // 20: moviedb_service.CastAndCrew
+	(*MovieTimeSlot)(nil),                           // 21: moviedb_service.MovieTimeSlot
+	(*Movie)(nil),                                   // 22: moviedb_service.Movie
+	(*Venue)(nil),                                   // 23: moviedb_service.Venue
+	(*Cinema)(nil),                                  // 24: moviedb_service.Cinema
+	(*CinemaRequest)(nil),                           // 25: moviedb_service.CinemaRequest
+	(*CinemaResponse)(nil),                          // 26: moviedb_service.CinemaResponse
+	(*GetAllCinemasRequest)(nil),                    // 27: moviedb_service.GetAllCinemasRequest
+	(*CinemaListResponse)(nil),                      // 28: moviedb_service.CinemaListResponse
+	(*MigrateVenuesToCinemasRequest)(nil),           // 29: moviedb_service.MigrateVenuesToCinemasRequest
+	(*MigrateVenuesToCinemasResponse)(nil),          // 30: moviedb_service.MigrateVenuesToCinemasResponse
+	(*ShowSchedule)(nil),                            // 31: moviedb_service.ShowSchedule
+	(*ShowScheduleRequest)(nil),                     // 32: moviedb_service.ShowScheduleRequest
+	(*CancelShowScheduleRequest)(nil),               // 33: moviedb_service.CancelShowScheduleRequest
+	(*ScheduledShowConflict)(nil),                   // 34: moviedb_service.ScheduledShowConflict
+	(*ShowScheduleResponse)(nil),                    // 35: moviedb_service.ShowScheduleResponse
+	(*PlannedMovie)(nil),                            // 36: moviedb_service.PlannedMovie
+	(*PlanProgrammeRequest)(nil),                    // 37: moviedb_service.PlanProgrammeRequest
+	(*PlannedMovieShows)(nil),                       // 38: moviedb_service.PlannedMovieShows
+	(*ScreenUse)(nil),                               // 39: moviedb_service.ScreenUse
+	(*PlanProgrammeResponse)(nil),                   // 40: moviedb_service.PlanProgrammeResponse
+	(*MovieList)(nil),                               // 41: moviedb_service.MovieList
+	(*MovieRequest)(nil),                            // 42: moviedb_service.MovieRequest
+	(*MovieResponse)(nil),                           // 43: moviedb_service.MovieResponse
+	(*MovieListResponse)(nil),                       // 44: moviedb_service.MovieListResponse
+	(*GetAllMoviesRequest)(nil),                     // 45: moviedb_service.GetAllMoviesRequest
+	(*GetAllVenuesRequest)(nil),                     // 46: moviedb_service.GetAllVenuesRequest
+	(*VenueListResponse)(nil),                       // 47: moviedb_service.VenueListResponse
+	(*VenueResponse)(nil),                           // 48: moviedb_service.VenueResponse
+	(*GetUpcomingMovieRequest)(nil),                 // 49: moviedb_service.GetUpcomingMovieRequest
+	(*GetUpcomingMovieResponse)(nil),                // 50: moviedb_service.GetUpcomingMovieResponse
+	(*Location)(nil),                                // 51: moviedb_service.Location
+	(*GetNowPlayingMovieRequest)(nil),               // 52: moviedb_service.GetNowPlayingMovieRequest
+	(*Review)(nil),                                  // 53: moviedb_service.Review
+	(*ReviewUpdateRequest)(nil),                     // 54: moviedb_service.ReviewUpdateRequest
+	(*ReviewResponse)(nil),                          // 55: moviedb_service.ReviewResponse
+	(*ReviewRequest)(nil),                           // 56: moviedb_service.ReviewRequest
+	(*ReviewList)(nil),                              // 57: moviedb_service.ReviewList
+	(*ReviewListResponse)(nil),                      // 58: moviedb_service.ReviewListResponse
+	(*GetAllMovieReviewsRequest)(nil),               // 59: moviedb_service.GetAllMovieReviewsRequest
+	(*GetMovieTimeSlotRequest)(nil),                 // 60: moviedb_service.GetMovieTimeSlotRequest
+	(*ScreenShowtimes)(nil),                         // 61: moviedb_service.ScreenShowtimes
+	(*CinemaShowtimes)(nil),                         // 62: moviedb_service.CinemaShowtimes
+	(*GetMovieTimeSlotResponse)(nil),                // 63: moviedb_service.GetMovieTimeSlotResponse
+	(*ScheduleConflict)(nil),                        // 64: moviedb_service.ScheduleConflict
+	(*MovieTimeSlotResponse)(nil),                   // 65: moviedb_service.MovieTimeSlotResponse
+	(*MovieTimeSlotUpdateResponse)(nil),             // 66: moviedb_service.MovieTimeSlotUpdateResponse
+	(*MovieTimeSlotUpdate)(nil),                     // 67: moviedb_service.MovieTimeSlotUpdate
+	(*MovieTimeSlotDelete)(nil),                     // 68: moviedb_service.MovieTimeSlotDelete
+	(*SetShowtimeStatusRequest)(nil),                // 69: moviedb_service.SetShowtimeStatusRequest
+	(*CancelShowtimeRequest)(nil),                   // 70: moviedb_service.CancelShowtimeRequest
+	(*CancelShowtimeResponse)(nil),                  // 71: moviedb_service.CancelShowtimeResponse
+	(*GetSeatMatrixRequest)(nil),                    // 72: moviedb_service.GetSeatMatrixRequest
+	(*GetSeatMatrixResponse)(nil),                   // 73: moviedb_service.GetSeatMatrixResponse
+	(*UpdateSeatMatrixRequest)(nil),                 // 74: moviedb_service.UpdateSeatMatrixRequest
+	(*UpdateSeatMatrixResponse)(nil),                // 75: moviedb_service.UpdateSeatMatrixResponse
+	(*DeleteSeatMatrixRequest)(nil),                 // 76: moviedb_service.DeleteSeatMatrixRequest
+	(*DeleteSeatMatrixResponse)(nil),                // 77: moviedb_service.DeleteSeatMatrixResponse
+	(*DeleteEntireSeatMatrixRequest)(nil),           // 78: moviedb_service.DeleteEntireSeatMatrixRequest
+	(*DeleteEntireSeatMatrixResponse)(nil),          // 79: moviedb_service.DeleteEntireSeatMatrixResponse
+	(*ApplySeatMatrixToShowsRequest)(nil),           // 80: moviedb_service.ApplySeatMatrixToShowsRequest
+	(*ShowSeatChanges)(nil),                         // 81: moviedb_service.ShowSeatChanges
+	(*ApplySeatMatrixToShowsResponse)(nil),          // 82: moviedb_service.ApplySeatMatrixToShowsResponse
+	(*PriceRule)(nil),                               // 83: moviedb_service.PriceRule
+	(*AddPriceRuleRequest)(nil),                     // 84: moviedb_service.AddPriceRuleRequest
+	(*PriceRuleResponse)(nil),                       // 85: moviedb_service.PriceRuleResponse
+	(*GetPriceRulesRequest)(nil),                    // 86: moviedb_service.GetPriceRulesRequest
+	(*GetPriceRulesResponse)(nil),                   // 87: moviedb_service.GetPriceRulesResponse
+	(*DeletePriceRuleRequest)(nil),                  // 88: moviedb_service.DeletePriceRuleRequest
+	(*DeletePriceRuleResponse)(nil),                 // 89: moviedb_service.DeletePriceRuleResponse
+	(*TaxRule)(nil),                                 // 90: moviedb_service.TaxRule
+	(*AddTaxRuleRequest)(nil),                       // 91: moviedb_service.AddTaxRuleRequest
+	(*TaxRuleResponse)(nil),                         // 92: moviedb_service.TaxRuleResponse
+	(*GetTaxRulesRequest)(nil),                      // 93: moviedb_service.GetTaxRulesRequest
+	(*GetTaxRulesResponse)(nil),                     // 94: moviedb_service.GetTaxRulesResponse
+	(*DeleteTaxRuleRequest)(nil),                    // 95: moviedb_service.DeleteTaxRuleRequest
+	(*DeleteTaxRuleResponse)(nil),                   // 96: moviedb_service.DeleteTaxRuleResponse
+	(*PriceAdjustment)(nil),                         // 97: moviedb_service.PriceAdjustment
+	(*TaxAmount)(nil),                               // 98: moviedb_service.TaxAmount
+	(*QuoteSeat)(nil),                               // 99: moviedb_service.QuoteSeat
+	(*BookingQuote)(nil),                            // 100: moviedb_service.BookingQuote
+	(*GetBookingQuoteRequest)(nil),                  // 101: moviedb_service.GetBookingQuoteRequest
+	(*GetBookingQuoteResponse)(nil),                 // 102: moviedb_service.GetBookingQuoteResponse
+	(*AddSingleSeatMatrixInput)(nil),                // 103: moviedb_service.AddSingleSeatMatrixInput
+	(*AddSingleSeatMatrixResponse)(nil),             // 104: moviedb_service.AddSingleSeatMatrixResponse
+	(*BookedSeats)(nil),                             // 105: moviedb_service.BookedSeats
+	(*BookSeatsRequest)(nil),                        // 106: moviedb_service.BookSeatsRequest
+	(*BookSeatsResponse)(nil),                       // 107: moviedb_service.BookSeatsResponse
+	(*GetBookedSeatsRequest)(nil),                   // 108: moviedb_service.GetBookedSeatsRequest
+	(*GetBookedSeatsResponse)(nil),                  // 109: moviedb_service.GetBookedSeatsResponse
+	(*GetBookedSeatsDetailsRequest)(nil),            // 110: moviedb_service.GetBookedSeatsDetailsRequest
+	(*GetBookedSeatsDetailsResponse)(nil),           // 111: moviedb_service.GetBookedSeatsDetailsResponse
+	(*IsValidToCommitSeatsForBooking_Request)(nil),  // 112: moviedb_service.IsValidToCommitSeatsForBooking_Request
+	(*IsValidToCommitSeatsForBooking_Response)(nil), // 113: moviedb_service.IsValidToCommitSeatsForBooking_Response
+	(*CreateTicketRequest)(nil),                     // 114: moviedb_service.CreateTicketRequest
+	(*CreateRequestResponse)(nil),                   // 115: moviedb_service.CreateRequestResponse
+	(*ReleaseExpiredSeatLocksRequest)(nil),          // 116: moviedb_service.ReleaseExpiredSeatLocksRequest
+	(*ReleaseExpiredSeatLocksResponse)(nil),         // 117: moviedb_service.ReleaseExpiredSeatLocksResponse
+	(*ReleaseSeatLocksRequest)(nil),                 // 118: moviedb_service.ReleaseSeatLocksRequest
+	(*ReleaseSeatLocksResponse)(nil),                // 119: moviedb_service.ReleaseSeatLocksResponse
+	(*GetMovieShowtimesRequest)(nil),                // 120: moviedb_service.GetMovieShowtimesRequest
+	(*GetMovieShowtimesResponse)(nil),               // 121: moviedb_service.GetMovieShowtimesResponse
+	(*ShowSeat)(nil),                                // 122: moviedb_service.ShowSeat
+	(*SeatBlock)(nil),                               // 123: moviedb_service.SeatBlock
+	(*BlockSeatsRequest)(nil),                       // 124: moviedb_service.BlockSeatsRequest
+	(*UnblockSeatsRequest)(nil),                     // 125: moviedb_service.UnblockSeatsRequest
+	(*UnblockSeatsResponse)(nil),                    // 126: moviedb_service.UnblockSeatsResponse
+	(*GetSeatBlocksRequest)(nil),                    // 127: moviedb_service.GetSeatBlocksRequest
+	(*SeatBlocksResponse)(nil),                      // 128: moviedb_service.SeatBlocksResponse
+	(*GetShowSeatLayoutRequest)(nil),                // 129: moviedb_service.GetShowSeatLayoutRequest
+	(*GetShowSeatLayoutResponse)(nil),               // 130: moviedb_service.GetShowSeatLayoutResponse
+	(*LayoutCell)(nil),                              // 131: moviedb_service.LayoutCell
+	(*LayoutRow)(nil),                               // 132: moviedb_service.LayoutRow
+	(*SeatSection)(nil),                             // 133: moviedb_service.SeatSection
+	(*GetVenueLayoutRequest)(nil),                   // 134: moviedb_service.GetVenueLayoutRequest
+	(*SetVenueLayoutRequest)(nil),                   // 135: moviedb_service.SetVenueLayoutRequest
+	(*VenueLayoutResponse)(nil),                     // 136: moviedb_service.VenueLayoutResponse
+	(*ImportSeatGridRequest)(nil),                   // 137: moviedb_service.ImportSeatGridRequest
+	(*ExportSeatGridRequest)(nil),                   // 138: moviedb_service.ExportSeatGridRequest
+	(*ExportSeatGridResponse)(nil),                  // 139: moviedb_service.ExportSeatGridResponse
+	(*LayoutTemplateVersion)(nil),                   // 140: moviedb_service.LayoutTemplateVersion
+	(*LayoutTemplate)(nil),                          // 141: moviedb_service.LayoutTemplate
+	(*CreateLayoutTemplateRequest)(nil),             // 142: moviedb_service.CreateLayoutTemplateRequest
+	(*UpdateLayoutTemplateRequest)(nil),             // 143: moviedb_service.UpdateLayoutTemplateRequest
+	(*GetLayoutTemplateRequest)(nil),                // 144: moviedb_service.GetLayoutTemplateRequest
+	(*LayoutTemplateResponse)(nil),                  // 145: moviedb_service.LayoutTemplateResponse
+	(*ApplyLayoutTemplateRequest)(nil),              // 146: moviedb_service.ApplyLayoutTemplateRequest
+	(*CloneSeatLayoutRequest)(nil),                  // 147: moviedb_service.CloneSeatLayoutRequest
+	(*SuggestSeatsRequest)(nil),                     // 148: moviedb_service.SuggestSeatsRequest
+	(*SuggestSeatsResponse)(nil),                    // 149: moviedb_service.SuggestSeatsResponse
+	(*ExtendSeatHoldRequest)(nil),                   // 150: moviedb_service.ExtendSeatHoldRequest
+	(*ExtendSeatHoldResponse)(nil),                  // 151: moviedb_service.ExtendSeatHoldResponse
+	(*SearchMoviesRequest)(nil),                     // 152: moviedb_service.SearchMoviesRequest
+	(*MovieSearchResult)(nil),                       // 153: moviedb_service.MovieSearchResult
+	(*SearchMoviesResponse)(nil),                    // 154: moviedb_service.SearchMoviesResponse
+	nil,                                             // 155: moviedb_service.ImportSeatGridRequest.PricesEntry
+	nil,                                             // 156: moviedb_service.ExportSeatGridResponse.PricesEntry
+	nil,                                             // 157: moviedb_service.LayoutTemplateVersion.PricesEntry
+	nil,                                             // 158: moviedb_service.CreateLayoutTemplateRequest.PricesEntry
+	nil,                                             // 159: moviedb_service.UpdateLayoutTemplateRequest.PricesEntry
 }
 var file_moviedb_service_proto_depIdxs = []int32{
-	0,  // 0: moviedb_service.SeatMatrix.type:type_name -> moviedb_service.SeatType
-	5,  // 1: moviedb_service.AddSeatMatrixInput.seats:type_name -> moviedb_service.SeatMatrix
-	1,  // 2: moviedb_service.CastAndCrew.type:type_name -> moviedb_service.CastAndCrewType
-	0,  // 3: moviedb_service.MovieTimeSlot.movie_format:type_name -> moviedb_service.SeatType
-	8,  // 4: moviedb_service.Movie.cast_crew:type_name -> moviedb_service.CastAndCrew
-	11, // 5: moviedb_service.Movie.venues:type_name -> moviedb_service.Venue
-	2,  // 6: moviedb_service.Venue.type:type_name -> moviedb_service.VenueType
-	5,  // 7: moviedb_service.Venue.seats:type_name -> moviedb_service.SeatMatrix
-	9,  // 8: moviedb_service.Venue.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
-	10, // 9: moviedb_service.Venue.movies:type_name -> moviedb_service.Movie
-	10, // 10: moviedb_service.MovieList.movies:type_name -> moviedb_service.Movie
-	10, // 11: moviedb_service.MovieResponse.movie:type_name -> moviedb_service.Movie
-	12, // 12: moviedb_service.MovieListResponse.movie_list:type_name -> moviedb_service.MovieList
-	11, // 13: moviedb_service.VenueResponse.Venue:type_name -> moviedb_service.Venue
-	10, // 14: moviedb_service.GetUpcomingMovieResponse.movie_list:type_name -> moviedb_service.Movie
-	20, // 15: moviedb_service.ReviewResponse.review:type_name -> moviedb_service.Review
-	20, // 16: moviedb_service.ReviewList.reviews:type_name -> moviedb_service.Review
-	24, // 17: moviedb_service.ReviewListResponse.review_list:type_name -> moviedb_service.ReviewList
-	3,  // 18: moviedb_service.GetAllMovieReviewsRequest.sortBy:type_name -> moviedb_service.SortBy
-	4,  // 19: moviedb_service.GetAllMovieReviewsRequest.filterBy:type_name -> moviedb_service.FilterBy
-	9,  // 20: moviedb_service.GetMovieTimeSlotResponse.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
-	11, // 21: moviedb_service.GetMovieTimeSlotResponse.venues:type_name -> moviedb_service.Venue
-	9,  // 22: moviedb_service.MovieTimeSlotUpdateResponse.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
-	0,  // 23: moviedb_service.MovieTimeSlotUpdate.movie_format:type_name -> moviedb_service.SeatType
-	5,  // 24: moviedb_service.GetSeatMatrixResponse.seats:type_name -> moviedb_service.SeatMatrix
-	5,  // 25: moviedb_service.UpdateSeatMatrixRequest.seats:type_name -> moviedb_service.SeatMatrix
-	5,  // 26: moviedb_service.DeleteSeatMatrixRequest.seats:type_name -> moviedb_service.SeatMatrix
-	5,  // 27: moviedb_service.AddSingleSeatMatrixInput.seat:type_name -> moviedb_service.SeatMatrix
-	9,  // 28: moviedb_service.BookSeatsRequest.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
-	43, // 29: moviedb_service.BookSeatsRequest.seats:type_name -> moviedb_service.BookedSeats
-	43, // 30: moviedb_service.GetBookedSeatsResponse.booked_seats:type_name -> moviedb_service.BookedSeats
-	43, // 31: moviedb_service.GetBookedSeatsDetailsResponse.booked_seats:type_name -> moviedb_service.BookedSeats
-	43, // 32: moviedb_service.IsValidToCommitSeatsForBooking_Response.toBeBookedSeats:type_name -> moviedb_service.BookedSeats
-	10, // 33: moviedb_service.MovieDBService.AddMovie:input_type -> moviedb_service.Movie
-	13, // 34: moviedb_service.MovieDBService.GetMovie:input_type -> moviedb_service.MovieRequest
-	54, // 35: moviedb_service.MovieDBService.GetAllMovies:input_type -> google.protobuf.Empty
-	10, // 36: moviedb_service.MovieDBService.UpdateMovie:input_type -> moviedb_service.Movie
-	13, // 37: moviedb_service.MovieDBService.DeleteMovie:input_type -> moviedb_service.MovieRequest
-	11, // 38: moviedb_service.MovieDBService.AddVenue:input_type -> moviedb_service.Venue
-	13, // 39: moviedb_service.MovieDBService.GetVenue:input_type -> moviedb_service.MovieRequest
-	54, // 40: moviedb_service.MovieDBService.GetAllVenues:input_type -> google.protobuf.Empty
-	11, // 41: moviedb_service.MovieDBService.UpdateVenue:input_type -> moviedb_service.Venue
-	13, // 42: moviedb_service.MovieDBService.DeleteVenue:input_type -> moviedb_service.MovieRequest
-	17, // 43: moviedb_service.MovieDBService.GetUpcomingMovies:input_type -> moviedb_service.GetUpcomingMovieRequest
-	19, // 44: moviedb_service.MovieDBService.GetNowPlayingMovies:input_type -> moviedb_service.GetNowPlayingMovieRequest
-	20, // 45: moviedb_service.MovieDBService.AddReview:input_type -> moviedb_service.Review
-	23, // 46: moviedb_service.MovieDBService.GetReview:input_type -> moviedb_service.ReviewRequest
-	21, // 47: moviedb_service.MovieDBService.UpdateReview:input_type -> moviedb_service.ReviewUpdateRequest
-	23, // 48: moviedb_service.MovieDBService.DeleteReview:input_type -> moviedb_service.ReviewRequest
-	26, // 49: moviedb_service.MovieDBService.GetAllMovieReviews:input_type -> moviedb_service.GetAllMovieReviewsRequest
-	27, // 50: moviedb_service.MovieDBService.GetMovieTimeSlots:input_type -> moviedb_service.GetMovieTimeSlotRequest
-	9,  // 51: moviedb_service.MovieDBService.AddMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlot
-	31, // 52: moviedb_service.MovieDBService.UpdateMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlotUpdate
-	32, // 53: moviedb_service.MovieDBService.DeleteMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlotDelete
-	6,  // 54: moviedb_service.MovieDBService.AddSeatMatrix:input_type -> moviedb_service.AddSeatMatrixInput
-	41, // 55: moviedb_service.MovieDBService.AddSingleSeatMatrix:input_type -> moviedb_service.AddSingleSeatMatrixInput
-	33, // 56: moviedb_service.MovieDBService.GetSeatMatrix:input_type -> moviedb_service.GetSeatMatrixRequest
-	35, // 57: moviedb_service.MovieDBService.UpdateSeatMatrix:input_type -> moviedb_service.UpdateSeatMatrixRequest
-	37, // 58: moviedb_service.MovieDBService.DeleteSeatMatrix:input_type -> moviedb_service.DeleteSeatMatrixRequest
-	39, // 59: moviedb_service.MovieDBService.DeleteEntireSeatMatrix:input_type -> moviedb_service.DeleteEntireSeatMatrixRequest
-	44, // 60: moviedb_service.MovieDBService.BookSeats:input_type -> moviedb_service.BookSeatsRequest
-	46, // 61: moviedb_service.MovieDBService.GetBookedSeats:input_type -> moviedb_service.GetBookedSeatsRequest
-	50, // 62: moviedb_service.MovieDBService.IsValidToCommitSeatsForBooking:input_type -> moviedb_service.IsValidToCommitSeatsForBooking_Request
-	48, // 63: moviedb_service.MovieDBService.LockBookedSeats:input_type -> moviedb_service.GetBookedSeatsDetailsRequest
-	52, // 64: moviedb_service.MovieDBService.CreateTicket:input_type -> moviedb_service.CreateTicketRequest
-	14, // 65: moviedb_service.MovieDBService.AddMovie:output_type -> moviedb_service.MovieResponse
-	14, // 66: moviedb_service.MovieDBService.GetMovie:output_type -> moviedb_service.MovieResponse
-	15, // 67: moviedb_service.MovieDBService.GetAllMovies:output_type -> moviedb_service.MovieListResponse
-	14, // 68: moviedb_service.MovieDBService.UpdateMovie:output_type -> moviedb_service.MovieResponse
-	14, // 69: moviedb_service.MovieDBService.DeleteMovie:output_type -> moviedb_service.MovieResponse
-	16, // 70: moviedb_service.MovieDBService.AddVenue:output_type -> moviedb_service.VenueResponse
-	16, // 71: moviedb_service.MovieDBService.GetVenue:output_type -> moviedb_service.VenueResponse
-	15, // 72: moviedb_service.MovieDBService.GetAllVenues:output_type -> moviedb_service.MovieListResponse
-	16, // 73: moviedb_service.MovieDBService.UpdateVenue:output_type -> moviedb_service.VenueResponse
-	14, // 74: moviedb_service.MovieDBService.DeleteVenue:output_type -> moviedb_service.MovieResponse
-	18, // 75: moviedb_service.MovieDBService.GetUpcomingMovies:output_type -> moviedb_service.GetUpcomingMovieResponse
-	18, // 76: moviedb_service.MovieDBService.GetNowPlayingMovies:output_type -> moviedb_service.GetUpcomingMovieResponse
-	22, // 77: moviedb_service.MovieDBService.AddReview:output_type -> moviedb_service.ReviewResponse
-	22, // 78: moviedb_service.MovieDBService.GetReview:output_type -> moviedb_service.ReviewResponse
-	22, // 79: moviedb_service.MovieDBService.UpdateReview:output_type -> moviedb_service.ReviewResponse
-	22, // 80: moviedb_service.MovieDBService.DeleteReview:output_type -> moviedb_service.ReviewResponse
-	25, // 81: moviedb_service.MovieDBService.GetAllMovieReviews:output_type -> moviedb_service.ReviewListResponse
-	28, // 82: moviedb_service.MovieDBService.GetMovieTimeSlots:output_type -> moviedb_service.GetMovieTimeSlotResponse
-	29, // 83: moviedb_service.MovieDBService.AddMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotResponse
-	30, // 84: moviedb_service.MovieDBService.UpdateMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotUpdateResponse
-	29, // 85: moviedb_service.MovieDBService.DeleteMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotResponse
-	7,  // 86: moviedb_service.MovieDBService.AddSeatMatrix:output_type -> moviedb_service.AddSeatMatrixResponse
-	42, // 87: moviedb_service.MovieDBService.AddSingleSeatMatrix:output_type -> moviedb_service.AddSingleSeatMatrixResponse
-	34, // 88: moviedb_service.MovieDBService.GetSeatMatrix:output_type -> moviedb_service.GetSeatMatrixResponse
-	36, // 89: moviedb_service.MovieDBService.UpdateSeatMatrix:output_type -> moviedb_service.UpdateSeatMatrixResponse
-	38, // 90: moviedb_service.MovieDBService.DeleteSeatMatrix:output_type -> moviedb_service.DeleteSeatMatrixResponse
-	40, // 91: moviedb_service.MovieDBService.DeleteEntireSeatMatrix:output_type -> moviedb_service.DeleteEntireSeatMatrixResponse
-	45, // 92: moviedb_service.MovieDBService.BookSeats:output_type -> moviedb_service.BookSeatsResponse
-	47, // 93: moviedb_service.MovieDBService.GetBookedSeats:output_type -> moviedb_service.GetBookedSeatsResponse
-	51, // 94: moviedb_service.MovieDBService.IsValidToCommitSeatsForBooking:output_type -> moviedb_service.IsValidToCommitSeatsForBooking_Response
-	49, // 95: moviedb_service.MovieDBService.LockBookedSeats:output_type -> moviedb_service.GetBookedSeatsDetailsResponse
-	53, // 96: moviedb_service.MovieDBService.CreateTicket:output_type -> moviedb_service.CreateRequestResponse
-	65, // [65:97] is the sub-list for method output_type
-	33, // [33:65] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	0,   // 0: moviedb_service.SeatMatrix.type:type_name -> moviedb_service.SeatType
+	16,  // 1: moviedb_service.SeatMatrix.price_money:type_name -> moviedb_service.Money
+	17,  // 2: moviedb_service.AddSeatMatrixInput.seats:type_name -> moviedb_service.SeatMatrix
+	3,   // 3: moviedb_service.CastAndCrew.type:type_name -> moviedb_service.CastAndCrewType
+	1,   // 4: moviedb_service.MovieTimeSlot.movie_format:type_name -> moviedb_service.MovieFormat
+	20,  // 5: moviedb_service.Movie.cast_crew:type_name -> moviedb_service.CastAndCrew
+	23,  // 6: moviedb_service.Movie.venues:type_name -> moviedb_service.Venue
+	4,   // 7: moviedb_service.Venue.type:type_name -> moviedb_service.VenueType
+	17,  // 8: moviedb_service.Venue.seats:type_name -> moviedb_service.SeatMatrix
+	21,  // 9: moviedb_service.Venue.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
+	22,  // 10: moviedb_service.Venue.movies:type_name -> moviedb_service.Movie
+	2,   // 11: moviedb_service.Venue.rounding_mode:type_name -> moviedb_service.RoundingMode
+	16,  // 12: moviedb_service.Venue.convenience_fee:type_name -> moviedb_service.Money
+	23,  // 13: moviedb_service.Cinema.screens:type_name -> moviedb_service.Venue
+	24,  // 14: moviedb_service.CinemaResponse.cinema:type_name -> moviedb_service.Cinema
+	24,  // 15: moviedb_service.CinemaListResponse.cinemas:type_name -> moviedb_service.Cinema
+	1,   // 16: moviedb_service.ShowSchedule.movie_format:type_name -> moviedb_service.MovieFormat
+	31,  // 17: moviedb_service.ShowScheduleRequest.show_schedule:type_name -> moviedb_service.ShowSchedule
+	21,  // 18: moviedb_service.ScheduledShowConflict.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	64,  // 19: moviedb_service.ScheduledShowConflict.conflict:type_name -> moviedb_service.ScheduleConflict
+	31,  // 20: moviedb_service.ShowScheduleResponse.show_schedule:type_name -> moviedb_service.ShowSchedule
+	21,  // 21: moviedb_service.ShowScheduleResponse.created:type_name -> moviedb_service.MovieTimeSlot
+	21,  // 22: moviedb_service.ShowScheduleResponse.updated:type_name -> moviedb_service.MovieTimeSlot
+	21,  // 23: moviedb_service.ShowScheduleResponse.cancelled:type_name -> moviedb_service.MovieTimeSlot
+	34,  // 24: moviedb_service.ShowScheduleResponse.conflicts:type_name -> moviedb_service.ScheduledShowConflict
+	36,  // 25: moviedb_service.PlanProgrammeRequest.movies:type_name -> moviedb_service.PlannedMovie
+	21,  // 26: moviedb_service.PlanProgrammeResponse.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
+	38,  // 27: moviedb_service.PlanProgrammeResponse.movies:type_name -> moviedb_service.PlannedMovieShows
+	39,  // 28: moviedb_service.PlanProgrammeResponse.screens:type_name -> moviedb_service.ScreenUse
+	64,  // 29: moviedb_service.PlanProgrammeResponse.conflict:type_name -> moviedb_service.ScheduleConflict
+	22,  // 30: moviedb_service.MovieList.movies:type_name -> moviedb_service.Movie
+	22,  // 31: moviedb_service.MovieResponse.movie:type_name -> moviedb_service.Movie
+	41,  // 32: moviedb_service.MovieListResponse.movie_list:type_name -> moviedb_service.MovieList
+	23,  // 33: moviedb_service.VenueListResponse.venues:type_name -> moviedb_service.Venue
+	23,  // 34: moviedb_service.VenueResponse.Venue:type_name -> moviedb_service.Venue
+	22,  // 35: moviedb_service.GetUpcomingMovieResponse.movie_list:type_name -> moviedb_service.Movie
+	5,   // 36: moviedb_service.Location.radius_unit:type_name -> moviedb_service.DistanceUnit
+	51,  // 37: moviedb_service.GetNowPlayingMovieRequest.location:type_name -> moviedb_service.Location
+	53,  // 38: moviedb_service.ReviewResponse.review:type_name -> moviedb_service.Review
+	53,  // 39: moviedb_service.ReviewList.reviews:type_name -> moviedb_service.Review
+	57,  // 40: moviedb_service.ReviewListResponse.review_list:type_name -> moviedb_service.ReviewList
+	6,   // 41: moviedb_service.GetAllMovieReviewsRequest.sortBy:type_name -> moviedb_service.SortBy
+	7,   // 42: moviedb_service.GetAllMovieReviewsRequest.filterBy:type_name -> moviedb_service.FilterBy
+	51,  // 43: moviedb_service.GetMovieTimeSlotRequest.location:type_name -> moviedb_service.Location
+	23,  // 44: moviedb_service.ScreenShowtimes.venue:type_name -> moviedb_service.Venue
+	21,  // 45: moviedb_service.ScreenShowtimes.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
+	24,  // 46: moviedb_service.CinemaShowtimes.cinema:type_name -> moviedb_service.Cinema
+	61,  // 47: moviedb_service.CinemaShowtimes.screens:type_name -> moviedb_service.ScreenShowtimes
+	21,  // 48: moviedb_service.GetMovieTimeSlotResponse.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
+	23,  // 49: moviedb_service.GetMovieTimeSlotResponse.venues:type_name -> moviedb_service.Venue
+	62,  // 50: moviedb_service.GetMovieTimeSlotResponse.cinemas:type_name -> moviedb_service.CinemaShowtimes
+	21,  // 51: moviedb_service.ScheduleConflict.conflicting_movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	64,  // 52: moviedb_service.MovieTimeSlotResponse.conflict:type_name -> moviedb_service.ScheduleConflict
+	21,  // 53: moviedb_service.MovieTimeSlotUpdateResponse.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	64,  // 54: moviedb_service.MovieTimeSlotUpdateResponse.conflict:type_name -> moviedb_service.ScheduleConflict
+	1,   // 55: moviedb_service.MovieTimeSlotUpdate.movie_format:type_name -> moviedb_service.MovieFormat
+	21,  // 56: moviedb_service.CancelShowtimeResponse.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	17,  // 57: moviedb_service.GetSeatMatrixResponse.seats:type_name -> moviedb_service.SeatMatrix
+	17,  // 58: moviedb_service.UpdateSeatMatrixRequest.seats:type_name -> moviedb_service.SeatMatrix
+	8,   // 59: moviedb_service.UpdateSeatMatrixRequest.mode:type_name -> moviedb_service.SeatChangeMode
+	17,  // 60: moviedb_service.DeleteSeatMatrixRequest.seats:type_name -> moviedb_service.SeatMatrix
+	8,   // 61: moviedb_service.DeleteSeatMatrixRequest.mode:type_name -> moviedb_service.SeatChangeMode
+	8,   // 62: moviedb_service.DeleteEntireSeatMatrixRequest.mode:type_name -> moviedb_service.SeatChangeMode
+	81,  // 63: moviedb_service.ApplySeatMatrixToShowsResponse.shows:type_name -> moviedb_service.ShowSeatChanges
+	9,   // 64: moviedb_service.PriceRule.kind:type_name -> moviedb_service.PriceRuleKind
+	16,  // 65: moviedb_service.PriceRule.amount:type_name -> moviedb_service.Money
+	83,  // 66: moviedb_service.AddPriceRuleRequest.rule:type_name -> moviedb_service.PriceRule
+	83,  // 67: moviedb_service.PriceRuleResponse.rule:type_name -> moviedb_service.PriceRule
+	83,  // 68: moviedb_service.GetPriceRulesResponse.rules:type_name -> moviedb_service.PriceRule
+	10,  // 69: moviedb_service.TaxRule.applies_to:type_name -> moviedb_service.TaxAppliesTo
+	90,  // 70: moviedb_service.AddTaxRuleRequest.rule:type_name -> moviedb_service.TaxRule
+	90,  // 71: moviedb_service.TaxRuleResponse.rule:type_name -> moviedb_service.TaxRule
+	90,  // 72: moviedb_service.GetTaxRulesResponse.rules:type_name -> moviedb_service.TaxRule
+	16,  // 73: moviedb_service.PriceAdjustment.amount:type_name -> moviedb_service.Money
+	10,  // 74: moviedb_service.TaxAmount.applies_to:type_name -> moviedb_service.TaxAppliesTo
+	16,  // 75: moviedb_service.TaxAmount.amount:type_name -> moviedb_service.Money
+	16,  // 76: moviedb_service.QuoteSeat.base:type_name -> moviedb_service.Money
+	97,  // 77: moviedb_service.QuoteSeat.surcharges:type_name -> moviedb_service.PriceAdjustment
+	97,  // 78: moviedb_service.QuoteSeat.discounts:type_name -> moviedb_service.PriceAdjustment
+	16,  // 79: moviedb_service.QuoteSeat.rounding:type_name -> moviedb_service.Money
+	16,  // 80: moviedb_service.QuoteSeat.price:type_name -> moviedb_service.Money
+	16,  // 81: moviedb_service.QuoteSeat.convenience_fee:type_name -> moviedb_service.Money
+	98,  // 82: moviedb_service.QuoteSeat.taxes:type_name -> moviedb_service.TaxAmount
+	16,  // 83: moviedb_service.QuoteSeat.total:type_name -> moviedb_service.Money
+	99,  // 84: moviedb_service.BookingQuote.seats:type_name -> moviedb_service.QuoteSeat
+	16,  // 85: moviedb_service.BookingQuote.base:type_name -> moviedb_service.Money
+	16,  // 86: moviedb_service.BookingQuote.surcharges:type_name -> moviedb_service.Money
+	16,  // 87: moviedb_service.BookingQuote.discounts:type_name -> moviedb_service.Money
+	16,  // 88: moviedb_service.BookingQuote.rounding:type_name -> moviedb_service.Money
+	16,  // 89: moviedb_service.BookingQuote.convenience_fees:type_name -> moviedb_service.Money
+	98,  // 90: moviedb_service.BookingQuote.taxes:type_name -> moviedb_service.TaxAmount
+	16,  // 91: moviedb_service.BookingQuote.total:type_name -> moviedb_service.Money
+	100, // 92: moviedb_service.GetBookingQuoteResponse.quote:type_name -> moviedb_service.BookingQuote
+	17,  // 93: moviedb_service.AddSingleSeatMatrixInput.seat:type_name -> moviedb_service.SeatMatrix
+	16,  // 94: moviedb_service.BookedSeats.price_money:type_name -> moviedb_service.Money
+	21,  // 95: moviedb_service.BookSeatsRequest.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	105, // 96: moviedb_service.BookSeatsRequest.seats:type_name -> moviedb_service.BookedSeats
+	105, // 97: moviedb_service.GetBookedSeatsResponse.booked_seats:type_name -> moviedb_service.BookedSeats
+	105, // 98: moviedb_service.GetBookedSeatsDetailsResponse.booked_seats:type_name -> moviedb_service.BookedSeats
+	105, // 99: moviedb_service.IsValidToCommitSeatsForBooking_Response.toBeBookedSeats:type_name -> moviedb_service.BookedSeats
+	100, // 100: moviedb_service.IsValidToCommitSeatsForBooking_Response.quote:type_name -> moviedb_service.BookingQuote
+	21,  // 101: moviedb_service.GetMovieShowtimesResponse.movie_time_slots:type_name -> moviedb_service.MovieTimeSlot
+	0,   // 102: moviedb_service.ShowSeat.type:type_name -> moviedb_service.SeatType
+	11,  // 103: moviedb_service.ShowSeat.status:type_name -> moviedb_service.SeatStatus
+	16,  // 104: moviedb_service.ShowSeat.price_money:type_name -> moviedb_service.Money
+	12,  // 105: moviedb_service.SeatBlock.reason:type_name -> moviedb_service.SeatBlockReason
+	12,  // 106: moviedb_service.BlockSeatsRequest.reason:type_name -> moviedb_service.SeatBlockReason
+	123, // 107: moviedb_service.SeatBlocksResponse.blocks:type_name -> moviedb_service.SeatBlock
+	21,  // 108: moviedb_service.GetShowSeatLayoutResponse.movie_time_slot:type_name -> moviedb_service.MovieTimeSlot
+	23,  // 109: moviedb_service.GetShowSeatLayoutResponse.venue:type_name -> moviedb_service.Venue
+	122, // 110: moviedb_service.GetShowSeatLayoutResponse.seats:type_name -> moviedb_service.ShowSeat
+	13,  // 111: moviedb_service.LayoutCell.kind:type_name -> moviedb_service.LayoutCellKind
+	17,  // 112: moviedb_service.LayoutCell.seat:type_name -> moviedb_service.SeatMatrix
+	131, // 113: moviedb_service.LayoutRow.cells:type_name -> moviedb_service.LayoutCell
+	14,  // 114: moviedb_service.SetVenueLayoutRequest.screen_position:type_name -> moviedb_service.ScreenPosition
+	131, // 115: moviedb_service.SetVenueLayoutRequest.cells:type_name -> moviedb_service.LayoutCell
+	133, // 116: moviedb_service.SetVenueLayoutRequest.sections:type_name -> moviedb_service.SeatSection
+	23,  // 117: moviedb_service.VenueLayoutResponse.venue:type_name -> moviedb_service.Venue
+	14,  // 118: moviedb_service.VenueLayoutResponse.screen_position:type_name -> moviedb_service.ScreenPosition
+	132, // 119: moviedb_service.VenueLayoutResponse.rows:type_name -> moviedb_service.LayoutRow
+	133, // 120: moviedb_service.VenueLayoutResponse.sections:type_name -> moviedb_service.SeatSection
+	15,  // 121: moviedb_service.ImportSeatGridRequest.format:type_name -> moviedb_service.SeatGridFormat
+	155, // 122: moviedb_service.ImportSeatGridRequest.prices:type_name -> moviedb_service.ImportSeatGridRequest.PricesEntry
+	15,  // 123: moviedb_service.ExportSeatGridRequest.format:type_name -> moviedb_service.SeatGridFormat
+	156, // 124: moviedb_service.ExportSeatGridResponse.prices:type_name -> moviedb_service.ExportSeatGridResponse.PricesEntry
+	157, // 125: moviedb_service.LayoutTemplateVersion.prices:type_name -> moviedb_service.LayoutTemplateVersion.PricesEntry
+	140, // 126: moviedb_service.LayoutTemplate.versions:type_name -> moviedb_service.LayoutTemplateVersion
+	15,  // 127: moviedb_service.CreateLayoutTemplateRequest.format:type_name -> moviedb_service.SeatGridFormat
+	158, // 128: moviedb_service.CreateLayoutTemplateRequest.prices:type_name -> moviedb_service.CreateLayoutTemplateRequest.PricesEntry
+	15,  // 129: moviedb_service.UpdateLayoutTemplateRequest.format:type_name -> moviedb_service.SeatGridFormat
+	159, // 130: moviedb_service.UpdateLayoutTemplateRequest.prices:type_name -> moviedb_service.UpdateLayoutTemplateRequest.PricesEntry
+	141, // 131: moviedb_service.LayoutTemplateResponse.template:type_name -> moviedb_service.LayoutTemplate
+	122, // 132: moviedb_service.SuggestSeatsResponse.seats:type_name -> moviedb_service.ShowSeat
+	22,  // 133: moviedb_service.MovieSearchResult.movie:type_name -> moviedb_service.Movie
+	153, // 134: moviedb_service.SearchMoviesResponse.results:type_name -> moviedb_service.MovieSearchResult
+	22,  // 135: moviedb_service.MovieDBService.AddMovie:input_type -> moviedb_service.Movie
+	42,  // 136: moviedb_service.MovieDBService.GetMovie:input_type -> moviedb_service.MovieRequest
+	45,  // 137: moviedb_service.MovieDBService.GetAllMovies:input_type -> moviedb_service.GetAllMoviesRequest
+	22,  // 138: moviedb_service.MovieDBService.UpdateMovie:input_type -> moviedb_service.Movie
+	42,  // 139: moviedb_service.MovieDBService.DeleteMovie:input_type -> moviedb_service.MovieRequest
+	23,  // 140: moviedb_service.MovieDBService.AddVenue:input_type -> moviedb_service.Venue
+	42,  // 141: moviedb_service.MovieDBService.GetVenue:input_type -> moviedb_service.MovieRequest
+	46,  // 142: moviedb_service.MovieDBService.GetAllVenues:input_type -> moviedb_service.GetAllVenuesRequest
+	23,  // 143: moviedb_service.MovieDBService.UpdateVenue:input_type -> moviedb_service.Venue
+	42,  // 144: moviedb_service.MovieDBService.DeleteVenue:input_type -> moviedb_service.MovieRequest
+	49,  // 145: moviedb_service.MovieDBService.GetUpcomingMovies:input_type -> moviedb_service.GetUpcomingMovieRequest
+	52,  // 146: moviedb_service.MovieDBService.GetNowPlayingMovies:input_type -> moviedb_service.GetNowPlayingMovieRequest
+	53,  // 147: moviedb_service.MovieDBService.AddReview:input_type -> moviedb_service.Review
+	56,  // 148: moviedb_service.MovieDBService.GetReview:input_type -> moviedb_service.ReviewRequest
+	54,  // 149: moviedb_service.MovieDBService.UpdateReview:input_type -> moviedb_service.ReviewUpdateRequest
+	56,  // 150: moviedb_service.MovieDBService.DeleteReview:input_type -> moviedb_service.ReviewRequest
+	59,  // 151: moviedb_service.MovieDBService.GetAllMovieReviews:input_type -> moviedb_service.GetAllMovieReviewsRequest
+	60,  // 152: moviedb_service.MovieDBService.GetMovieTimeSlots:input_type -> moviedb_service.GetMovieTimeSlotRequest
+	21,  // 153: moviedb_service.MovieDBService.AddMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlot
+	67,  // 154: moviedb_service.MovieDBService.UpdateMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlotUpdate
+	68,  // 155: moviedb_service.MovieDBService.DeleteMovieTimeSlot:input_type -> moviedb_service.MovieTimeSlotDelete
+	18,  // 156: moviedb_service.MovieDBService.AddSeatMatrix:input_type -> moviedb_service.AddSeatMatrixInput
+	103, // 157: moviedb_service.MovieDBService.AddSingleSeatMatrix:input_type -> moviedb_service.AddSingleSeatMatrixInput
+	72,  // 158: moviedb_service.MovieDBService.GetSeatMatrix:input_type -> moviedb_service.GetSeatMatrixRequest
+	74,  // 159: moviedb_service.MovieDBService.UpdateSeatMatrix:input_type -> moviedb_service.UpdateSeatMatrixRequest
+	76,  // 160: moviedb_service.MovieDBService.DeleteSeatMatrix:input_type -> moviedb_service.DeleteSeatMatrixRequest
+	78,  // 161: moviedb_service.MovieDBService.DeleteEntireSeatMatrix:input_type -> moviedb_service.DeleteEntireSeatMatrixRequest
+	80,  // 162: moviedb_service.MovieDBService.ApplySeatMatrixToShows:input_type -> moviedb_service.ApplySeatMatrixToShowsRequest
+	124, // 163: moviedb_service.MovieDBService.BlockSeats:input_type -> moviedb_service.BlockSeatsRequest
+	125, // 164: moviedb_service.MovieDBService.UnblockSeats:input_type -> moviedb_service.UnblockSeatsRequest
+	127, // 165: moviedb_service.MovieDBService.GetSeatBlocks:input_type -> moviedb_service.GetSeatBlocksRequest
+	84,  // 166: moviedb_service.MovieDBService.AddPriceRule:input_type -> moviedb_service.AddPriceRuleRequest
+	86,  // 167: moviedb_service.MovieDBService.GetPriceRules:input_type -> moviedb_service.GetPriceRulesRequest
+	88,  // 168: moviedb_service.MovieDBService.DeletePriceRule:input_type -> moviedb_service.DeletePriceRuleRequest
+	91,  // 169: moviedb_service.MovieDBService.AddTaxRule:input_type -> moviedb_service.AddTaxRuleRequest
+	93,  // 170: moviedb_service.MovieDBService.GetTaxRules:input_type -> moviedb_service.GetTaxRulesRequest
+	95,  // 171: moviedb_service.MovieDBService.DeleteTaxRule:input_type -> moviedb_service.DeleteTaxRuleRequest
+	101, // 172: moviedb_service.MovieDBService.GetBookingQuote:input_type -> moviedb_service.GetBookingQuoteRequest
+	106, // 173: moviedb_service.MovieDBService.BookSeats:input_type -> moviedb_service.BookSeatsRequest
+	108, // 174: moviedb_service.MovieDBService.GetBookedSeats:input_type -> moviedb_service.GetBookedSeatsRequest
+	112, // 175: moviedb_service.MovieDBService.IsValidToCommitSeatsForBooking:input_type -> moviedb_service.IsValidToCommitSeatsForBooking_Request
+	110, // 176: moviedb_service.MovieDBService.LockBookedSeats:input_type -> moviedb_service.GetBookedSeatsDetailsRequest
+	114, // 177: moviedb_service.MovieDBService.CreateTicket:input_type -> moviedb_service.CreateTicketRequest
+	116, // 178: moviedb_service.MovieDBService.ReleaseExpiredSeatLocks:input_type -> moviedb_service.ReleaseExpiredSeatLocksRequest
+	118, // 179: moviedb_service.MovieDBService.ReleaseSeatLocks:input_type -> moviedb_service.ReleaseSeatLocksRequest
+	150, // 180: moviedb_service.MovieDBService.ExtendSeatHold:input_type -> moviedb_service.ExtendSeatHoldRequest
+	120, // 181: moviedb_service.MovieDBService.GetMovieShowtimes:input_type -> moviedb_service.GetMovieShowtimesRequest
+	129, // 182: moviedb_service.MovieDBService.GetShowSeatLayout:input_type -> moviedb_service.GetShowSeatLayoutRequest
+	148, // 183: moviedb_service.MovieDBService.SuggestSeats:input_type -> moviedb_service.SuggestSeatsRequest
+	134, // 184: moviedb_service.MovieDBService.GetVenueLayout:input_type -> moviedb_service.GetVenueLayoutRequest
+	135, // 185: moviedb_service.MovieDBService.SetVenueLayout:input_type -> moviedb_service.SetVenueLayoutRequest
+	137, // 186: moviedb_service.MovieDBService.ImportSeatGrid:input_type -> moviedb_service.ImportSeatGridRequest
+	138, // 187: moviedb_service.MovieDBService.ExportSeatGrid:input_type -> moviedb_service.ExportSeatGridRequest
+	142, // 188: moviedb_service.MovieDBService.CreateLayoutTemplate:input_type -> moviedb_service.CreateLayoutTemplateRequest
+	143, // 189: moviedb_service.MovieDBService.UpdateLayoutTemplate:input_type -> moviedb_service.UpdateLayoutTemplateRequest
+	144, // 190: moviedb_service.MovieDBService.GetLayoutTemplate:input_type -> moviedb_service.GetLayoutTemplateRequest
+	146, // 191: moviedb_service.MovieDBService.ApplyLayoutTemplate:input_type -> moviedb_service.ApplyLayoutTemplateRequest
+	147, // 192: moviedb_service.MovieDBService.CloneSeatLayout:input_type -> moviedb_service.CloneSeatLayoutRequest
+	152, // 193: moviedb_service.MovieDBService.SearchMovies:input_type -> moviedb_service.SearchMoviesRequest
+	24,  // 194: moviedb_service.MovieDBService.AddCinema:input_type -> moviedb_service.Cinema
+	25,  // 195: moviedb_service.MovieDBService.GetCinema:input_type -> moviedb_service.CinemaRequest
+	27,  // 196: moviedb_service.MovieDBService.GetAllCinemas:input_type -> moviedb_service.GetAllCinemasRequest
+	24,  // 197: moviedb_service.MovieDBService.UpdateCinema:input_type -> moviedb_service.Cinema
+	25,  // 198: moviedb_service.MovieDBService.DeleteCinema:input_type -> moviedb_service.CinemaRequest
+	29,  // 199: moviedb_service.MovieDBService.MigrateVenuesToCinemas:input_type -> moviedb_service.MigrateVenuesToCinemasRequest
+	32,  // 200: moviedb_service.MovieDBService.CreateShowSchedule:input_type -> moviedb_service.ShowScheduleRequest
+	32,  // 201: moviedb_service.MovieDBService.UpdateShowSchedule:input_type -> moviedb_service.ShowScheduleRequest
+	33,  // 202: moviedb_service.MovieDBService.CancelShowSchedule:input_type -> moviedb_service.CancelShowScheduleRequest
+	37,  // 203: moviedb_service.MovieDBService.PlanProgramme:input_type -> moviedb_service.PlanProgrammeRequest
+	69,  // 204: moviedb_service.MovieDBService.SetShowtimeStatus:input_type -> moviedb_service.SetShowtimeStatusRequest
+	70,  // 205: moviedb_service.MovieDBService.CancelShowtime:input_type -> moviedb_service.CancelShowtimeRequest
+	43,  // 206: moviedb_service.MovieDBService.AddMovie:output_type -> moviedb_service.MovieResponse
+	43,  // 207: moviedb_service.MovieDBService.GetMovie:output_type -> moviedb_service.MovieResponse
+	44,  // 208: moviedb_service.MovieDBService.GetAllMovies:output_type -> moviedb_service.MovieListResponse
+	43,  // 209: moviedb_service.MovieDBService.UpdateMovie:output_type -> moviedb_service.MovieResponse
+	43,  // 210: moviedb_service.MovieDBService.DeleteMovie:output_type -> moviedb_service.MovieResponse
+	48,  // 211: moviedb_service.MovieDBService.AddVenue:output_type -> moviedb_service.VenueResponse
+	48,  // 212: moviedb_service.MovieDBService.GetVenue:output_type -> moviedb_service.VenueResponse
+	47,  // 213: moviedb_service.MovieDBService.GetAllVenues:output_type -> moviedb_service.VenueListResponse
+	48,  // 214: moviedb_service.MovieDBService.UpdateVenue:output_type -> moviedb_service.VenueResponse
+	43,  // 215: moviedb_service.MovieDBService.DeleteVenue:output_type -> moviedb_service.MovieResponse
+	50,  // 216: moviedb_service.MovieDBService.GetUpcomingMovies:output_type -> moviedb_service.GetUpcomingMovieResponse
+	50,  // 217: moviedb_service.MovieDBService.GetNowPlayingMovies:output_type -> moviedb_service.GetUpcomingMovieResponse
+	55,  // 218: moviedb_service.MovieDBService.AddReview:output_type -> moviedb_service.ReviewResponse
+	55,  // 219: moviedb_service.MovieDBService.GetReview:output_type -> moviedb_service.ReviewResponse
+	55,  // 220: moviedb_service.MovieDBService.UpdateReview:output_type -> moviedb_service.ReviewResponse
+	55,  // 221: moviedb_service.MovieDBService.DeleteReview:output_type -> moviedb_service.ReviewResponse
+	58,  // 222: moviedb_service.MovieDBService.GetAllMovieReviews:output_type -> moviedb_service.ReviewListResponse
+	63,  // 223: moviedb_service.MovieDBService.GetMovieTimeSlots:output_type -> moviedb_service.GetMovieTimeSlotResponse
+	65,  // 224: moviedb_service.MovieDBService.AddMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotResponse
+	66,  // 225: moviedb_service.MovieDBService.UpdateMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotUpdateResponse
+	65,  // 226: moviedb_service.MovieDBService.DeleteMovieTimeSlot:output_type -> moviedb_service.MovieTimeSlotResponse
+	19,  // 227: moviedb_service.MovieDBService.AddSeatMatrix:output_type -> moviedb_service.AddSeatMatrixResponse
+	104, // 228: moviedb_service.MovieDBService.AddSingleSeatMatrix:output_type -> moviedb_service.AddSingleSeatMatrixResponse
+	73,  // 229: moviedb_service.MovieDBService.GetSeatMatrix:output_type -> moviedb_service.GetSeatMatrixResponse
+	75,  // 230: moviedb_service.MovieDBService.UpdateSeatMatrix:output_type -> moviedb_service.UpdateSeatMatrixResponse
+	77,  // 231: moviedb_service.MovieDBService.DeleteSeatMatrix:output_type -> moviedb_service.DeleteSeatMatrixResponse
+	79,  // 232: moviedb_service.MovieDBService.DeleteEntireSeatMatrix:output_type -> moviedb_service.DeleteEntireSeatMatrixResponse
+	82,  // 233: moviedb_service.MovieDBService.ApplySeatMatrixToShows:output_type -> moviedb_service.ApplySeatMatrixToShowsResponse
+	128, // 234: moviedb_service.MovieDBService.BlockSeats:output_type -> moviedb_service.SeatBlocksResponse
+	126, // 235: moviedb_service.MovieDBService.UnblockSeats:output_type -> moviedb_service.UnblockSeatsResponse
+	128, // 236: moviedb_service.MovieDBService.GetSeatBlocks:output_type -> moviedb_service.SeatBlocksResponse
+	85,  // 237: moviedb_service.MovieDBService.AddPriceRule:output_type -> moviedb_service.PriceRuleResponse
+	87,  // 238: moviedb_service.MovieDBService.GetPriceRules:output_type -> moviedb_service.GetPriceRulesResponse
+	89,  // 239: moviedb_service.MovieDBService.DeletePriceRule:output_type -> moviedb_service.DeletePriceRuleResponse
+	92,  // 240: moviedb_service.MovieDBService.AddTaxRule:output_type -> moviedb_service.TaxRuleResponse
+	94,  // 241: moviedb_service.MovieDBService.GetTaxRules:output_type -> moviedb_service.GetTaxRulesResponse
+	96,  // 242: moviedb_service.MovieDBService.DeleteTaxRule:output_type -> moviedb_service.DeleteTaxRuleResponse
+	102, // 243: moviedb_service.MovieDBService.GetBookingQuote:output_type -> moviedb_service.GetBookingQuoteResponse
+	107, // 244: moviedb_service.MovieDBService.BookSeats:output_type -> moviedb_service.BookSeatsResponse
+	109, // 245: moviedb_service.MovieDBService.GetBookedSeats:output_type -> moviedb_service.GetBookedSeatsResponse
+	113, // 246: moviedb_service.MovieDBService.IsValidToCommitSeatsForBooking:output_type -> moviedb_service.IsValidToCommitSeatsForBooking_Response
+	111, // 247: moviedb_service.MovieDBService.LockBookedSeats:output_type -> moviedb_service.GetBookedSeatsDetailsResponse
+	115, // 248: moviedb_service.MovieDBService.CreateTicket:output_type -> moviedb_service.CreateRequestResponse
+	117, // 249: moviedb_service.MovieDBService.ReleaseExpiredSeatLocks:output_type -> moviedb_service.ReleaseExpiredSeatLocksResponse
+	119, // 250: moviedb_service.MovieDBService.ReleaseSeatLocks:output_type -> moviedb_service.ReleaseSeatLocksResponse
+	151, // 251: moviedb_service.MovieDBService.ExtendSeatHold:output_type -> moviedb_service.ExtendSeatHoldResponse
+	121, // 252: moviedb_service.MovieDBService.GetMovieShowtimes:output_type -> moviedb_service.GetMovieShowtimesResponse
+	130, // 253: moviedb_service.MovieDBService.GetShowSeatLayout:output_type -> moviedb_service.GetShowSeatLayoutResponse
+	149, // 254: moviedb_service.MovieDBService.SuggestSeats:output_type -> moviedb_service.SuggestSeatsResponse
+	136, // 255: moviedb_service.MovieDBService.GetVenueLayout:output_type -> moviedb_service.VenueLayoutResponse
+	136, // 256: moviedb_service.MovieDBService.SetVenueLayout:output_type -> moviedb_service.VenueLayoutResponse
+	136, // 257: moviedb_service.MovieDBService.ImportSeatGrid:output_type -> moviedb_service.VenueLayoutResponse
+	139, // 258: moviedb_service.MovieDBService.ExportSeatGrid:output_type -> moviedb_service.ExportSeatGridResponse
+	145, // 259: moviedb_service.MovieDBService.CreateLayoutTemplate:output_type -> moviedb_service.LayoutTemplateResponse
+	145, // 260: moviedb_service.MovieDBService.UpdateLayoutTemplate:output_type -> moviedb_service.LayoutTemplateResponse
+	145, // 261: moviedb_service.MovieDBService.GetLayoutTemplate:output_type -> moviedb_service.LayoutTemplateResponse
+	136, // 262: moviedb_service.MovieDBService.ApplyLayoutTemplate:output_type -> moviedb_service.VenueLayoutResponse
+	136, // 263: moviedb_service.MovieDBService.CloneSeatLayout:output_type -> moviedb_service.VenueLayoutResponse
+	154, // 264: moviedb_service.MovieDBService.SearchMovies:output_type -> moviedb_service.SearchMoviesResponse
+	26,  // 265: moviedb_service.MovieDBService.AddCinema:output_type -> moviedb_service.CinemaResponse
+	26,  // 266: moviedb_service.MovieDBService.GetCinema:output_type -> moviedb_service.CinemaResponse
+	28,  // 267: moviedb_service.MovieDBService.GetAllCinemas:output_type -> moviedb_service.CinemaListResponse
+	26,  // 268: moviedb_service.MovieDBService.UpdateCinema:output_type -> moviedb_service.CinemaResponse
+	26,  // 269: moviedb_service.MovieDBService.DeleteCinema:output_type -> moviedb_service.CinemaResponse
+	30,  // 270: moviedb_service.MovieDBService.MigrateVenuesToCinemas:output_type -> moviedb_service.MigrateVenuesToCinemasResponse
+	35,  // 271: moviedb_service.MovieDBService.CreateShowSchedule:output_type -> moviedb_service.ShowScheduleResponse
+	35,  // 272: moviedb_service.MovieDBService.UpdateShowSchedule:output_type -> moviedb_service.ShowScheduleResponse
+	35,  // 273: moviedb_service.MovieDBService.CancelShowSchedule:output_type -> moviedb_service.ShowScheduleResponse
+	40,  // 274: moviedb_service.MovieDBService.PlanProgramme:output_type -> moviedb_service.PlanProgrammeResponse
+	66,  // 275: moviedb_service.MovieDBService.SetShowtimeStatus:output_type -> moviedb_service.MovieTimeSlotUpdateResponse
+	71,  // 276: moviedb_service.MovieDBService.CancelShowtime:output_type -> moviedb_service.CancelShowtimeResponse
+	206, // [206:277] is the sub-list for method output_type
+	135, // [135:206] is the sub-list for method input_type
+	135, // [135:135] is the sub-list for extension type_name
+	135, // [135:135] is the sub-list for extension extendee
+	0,   // [0:135] is the sub-list for field type_name
 }
 
 func init() { file_moviedb_service_proto_init() }
@@ -4216,8 +12707,8 @@ func file_moviedb_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_moviedb_service_proto_rawDesc), len(file_moviedb_service_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   49,
+			NumEnums:      16,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,7 +8,6 @@ package moviedb
 
 import (
 	context "context"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -47,11 +46,50 @@ const (
 	MovieDBService_UpdateSeatMatrix_FullMethodName               = "/moviedb_service.MovieDBService/UpdateSeatMatrix"
 	MovieDBService_DeleteSeatMatrix_FullMethodName               = "/moviedb_service.MovieDBService/DeleteSeatMatrix"
 	MovieDBService_DeleteEntireSeatMatrix_FullMethodName         = "/moviedb_service.MovieDBService/DeleteEntireSeatMatrix"
+	MovieDBService_ApplySeatMatrixToShows_FullMethodName         = "/moviedb_service.MovieDBService/ApplySeatMatrixToShows"
+	MovieDBService_BlockSeats_FullMethodName                     = "/moviedb_service.MovieDBService/BlockSeats"
+	MovieDBService_UnblockSeats_FullMethodName                   = "/moviedb_service.MovieDBService/UnblockSeats"
+	MovieDBService_GetSeatBlocks_FullMethodName                  = "/moviedb_service.MovieDBService/GetSeatBlocks"
+	MovieDBService_AddPriceRule_FullMethodName                   = "/moviedb_service.MovieDBService/AddPriceRule"
+	MovieDBService_GetPriceRules_FullMethodName                  = "/moviedb_service.MovieDBService/GetPriceRules"
+	MovieDBService_DeletePriceRule_FullMethodName                = "/moviedb_service.MovieDBService/DeletePriceRule"
+	MovieDBService_AddTaxRule_FullMethodName                     = "/moviedb_service.MovieDBService/AddTaxRule"
+	MovieDBService_GetTaxRules_FullMethodName                    = "/moviedb_service.MovieDBService/GetTaxRules"
+	MovieDBService_DeleteTaxRule_FullMethodName                  = "/moviedb_service.MovieDBService/DeleteTaxRule"
+	MovieDBService_GetBookingQuote_FullMethodName                = "/moviedb_service.MovieDBService/GetBookingQuote"
 	MovieDBService_BookSeats_FullMethodName                      = "/moviedb_service.MovieDBService/BookSeats"
 	MovieDBService_GetBookedSeats_FullMethodName                 = "/moviedb_service.MovieDBService/GetBookedSeats"
 	MovieDBService_IsValidToCommitSeatsForBooking_FullMethodName = "/moviedb_service.MovieDBService/IsValidToCommitSeatsForBooking"
 	MovieDBService_LockBookedSeats_FullMethodName                = "/moviedb_service.MovieDBService/LockBookedSeats"
 	MovieDBService_CreateTicket_FullMethodName                   = "/moviedb_service.MovieDBService/CreateTicket"
+	MovieDBService_ReleaseExpiredSeatLocks_FullMethodName        = "/moviedb_service.MovieDBService/ReleaseExpiredSeatLocks"
+	MovieDBService_ReleaseSeatLocks_FullMethodName               = "/moviedb_service.MovieDBService/ReleaseSeatLocks"
+	MovieDBService_ExtendSeatHold_FullMethodName                 = "/moviedb_service.MovieDBService/ExtendSeatHold"
+	MovieDBService_GetMovieShowtimes_FullMethodName              = "/moviedb_service.MovieDBService/GetMovieShowtimes"
+	MovieDBService_GetShowSeatLayout_FullMethodName              = "/moviedb_service.MovieDBService/GetShowSeatLayout"
+	MovieDBService_SuggestSeats_FullMethodName                   = "/moviedb_service.MovieDBService/SuggestSeats"
+	MovieDBService_GetVenueLayout_FullMethodName                 = "/moviedb_service.MovieDBService/GetVenueLayout"
+	MovieDBService_SetVenueLayout_FullMethodName                 = "/moviedb_service.MovieDBService/SetVenueLayout"
+	MovieDBService_ImportSeatGrid_FullMethodName                 = "/moviedb_service.MovieDBService/ImportSeatGrid"
+	MovieDBService_ExportSeatGrid_FullMethodName                 = "/moviedb_service.MovieDBService/ExportSeatGrid"
+	MovieDBService_CreateLayoutTemplate_FullMethodName           = "/moviedb_service.MovieDBService/CreateLayoutTemplate"
+	MovieDBService_UpdateLayoutTemplate_FullMethodName           = "/moviedb_service.MovieDBService/UpdateLayoutTemplate"
+	MovieDBService_GetLayoutTemplate_FullMethodName              = "/moviedb_service.MovieDBService/GetLayoutTemplate"
+	MovieDBService_ApplyLayoutTemplate_FullMethodName            = "/moviedb_service.MovieDBService/ApplyLayoutTemplate"
+	MovieDBService_CloneSeatLayout_FullMethodName                = "/moviedb_service.MovieDBService/CloneSeatLayout"
+	MovieDBService_SearchMovies_FullMethodName                   = "/moviedb_service.MovieDBService/SearchMovies"
+	MovieDBService_AddCinema_FullMethodName                      = "/moviedb_service.MovieDBService/AddCinema"
+	MovieDBService_GetCinema_FullMethodName                      = "/moviedb_service.MovieDBService/GetCinema"
+	MovieDBService_GetAllCinemas_FullMethodName                  = "/moviedb_service.MovieDBService/GetAllCinemas"
+	MovieDBService_UpdateCinema_FullMethodName                   = "/moviedb_service.MovieDBService/UpdateCinema"
+	MovieDBService_DeleteCinema_FullMethodName                   = "/moviedb_service.MovieDBService/DeleteCinema"
+	MovieDBService_MigrateVenuesToCinemas_FullMethodName         = "/moviedb_service.MovieDBService/MigrateVenuesToCinemas"
+	MovieDBService_CreateShowSchedule_FullMethodName             = "/moviedb_service.MovieDBService/CreateShowSchedule"
+	MovieDBService_UpdateShowSchedule_FullMethodName             = "/moviedb_service.MovieDBService/UpdateShowSchedule"
+	MovieDBService_CancelShowSchedule_FullMethodName             = "/moviedb_service.MovieDBService/CancelShowSchedule"
+	MovieDBService_PlanProgramme_FullMethodName                  = "/moviedb_service.MovieDBService/PlanProgramme"
+	MovieDBService_SetShowtimeStatus_FullMethodName              = "/moviedb_service.MovieDBService/SetShowtimeStatus"
+	MovieDBService_CancelShowtime_FullMethodName                 = "/moviedb_service.MovieDBService/CancelShowtime"
 )
 
 // MovieDBServiceClient is the client API for MovieDBService service.
@@ -60,12 +98,12 @@ const (
 type MovieDBServiceClient interface {
 	AddMovie(ctx context.Context, in *Movie, opts ...grpc.CallOption) (*MovieResponse, error)
 	GetMovie(ctx context.Context, in *MovieRequest, opts ...grpc.CallOption) (*MovieResponse, error)
-	GetAllMovies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MovieListResponse, error)
+	GetAllMovies(ctx context.Context, in *GetAllMoviesRequest, opts ...grpc.CallOption) (*MovieListResponse, error)
 	UpdateMovie(ctx context.Context, in *Movie, opts ...grpc.CallOption) (*MovieResponse, error)
 	DeleteMovie(ctx context.Context, in *MovieRequest, opts ...grpc.CallOption) (*MovieResponse, error)
 	AddVenue(ctx context.Context, in *Venue, opts ...grpc.CallOption) (*VenueResponse, error)
 	GetVenue(ctx context.Context, in *MovieRequest, opts ...grpc.CallOption) (*VenueResponse, error)
-	GetAllVenues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MovieListResponse, error)
+	GetAllVenues(ctx context.Context, in *GetAllVenuesRequest, opts ...grpc.CallOption) (*VenueListResponse, error)
 	UpdateVenue(ctx context.Context, in *Venue, opts ...grpc.CallOption) (*VenueResponse, error)
 	DeleteVenue(ctx context.Context, in *MovieRequest, opts ...grpc.CallOption) (*MovieResponse, error)
 	GetUpcomingMovies(ctx context.Context, in *GetUpcomingMovieRequest, opts ...grpc.CallOption) (*GetUpcomingMovieResponse, error)
@@ -85,12 +123,51 @@ type MovieDBServiceClient interface {
 	UpdateSeatMatrix(ctx context.Context, in *UpdateSeatMatrixRequest, opts ...grpc.CallOption) (*UpdateSeatMatrixResponse, error)
 	DeleteSeatMatrix(ctx context.Context, in *DeleteSeatMatrixRequest, opts ...grpc.CallOption) (*DeleteSeatMatrixResponse, error)
 	DeleteEntireSeatMatrix(ctx context.Context, in *DeleteEntireSeatMatrixRequest, opts ...grpc.CallOption) (*DeleteEntireSeatMatrixResponse, error)
+	ApplySeatMatrixToShows(ctx context.Context, in *ApplySeatMatrixToShowsRequest, opts ...grpc.CallOption) (*ApplySeatMatrixToShowsResponse, error)
+	BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*SeatBlocksResponse, error)
+	UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error)
+	GetSeatBlocks(ctx context.Context, in *GetSeatBlocksRequest, opts ...grpc.CallOption) (*SeatBlocksResponse, error)
+	AddPriceRule(ctx context.Context, in *AddPriceRuleRequest, opts ...grpc.CallOption) (*PriceRuleResponse, error)
+	GetPriceRules(ctx context.Context, in *GetPriceRulesRequest, opts ...grpc.CallOption) (*GetPriceRulesResponse, error)
+	DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleResponse, error)
+	AddTaxRule(ctx context.Context, in *AddTaxRuleRequest, opts ...grpc.CallOption) (*TaxRuleResponse, error)
+	GetTaxRules(ctx context.Context, in *GetTaxRulesRequest, opts ...grpc.CallOption) (*GetTaxRulesResponse, error)
+	DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error)
+	GetBookingQuote(ctx context.Context, in *GetBookingQuoteRequest, opts ...grpc.CallOption) (*GetBookingQuoteResponse, error)
 	BookSeats(ctx context.Context, in *BookSeatsRequest, opts ...grpc.CallOption) (*BookSeatsResponse, error)
 	GetBookedSeats(ctx context.Context, in *GetBookedSeatsRequest, opts ...grpc.CallOption) (*GetBookedSeatsResponse, error)
 	// rpc GetBookedSeatsDetails(GetBookedSeatsDetailsRequest) returns (GetBookedSeatsDetailsResponse);
 	IsValidToCommitSeatsForBooking(ctx context.Context, in *IsValidToCommitSeatsForBooking_Request, opts ...grpc.CallOption) (*IsValidToCommitSeatsForBooking_Response, error)
 	LockBookedSeats(ctx context.Context, in *GetBookedSeatsDetailsRequest, opts ...grpc.CallOption) (*GetBookedSeatsDetailsResponse, error)
 	CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateRequestResponse, error)
+	ReleaseExpiredSeatLocks(ctx context.Context, in *ReleaseExpiredSeatLocksRequest, opts ...grpc.CallOption) (*ReleaseExpiredSeatLocksResponse, error)
+	ReleaseSeatLocks(ctx context.Context, in *ReleaseSeatLocksRequest, opts ...grpc.CallOption) (*ReleaseSeatLocksResponse, error)
+	ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldResponse, error)
+	GetMovieShowtimes(ctx context.Context, in *GetMovieShowtimesRequest, opts ...grpc.CallOption) (*GetMovieShowtimesResponse, error)
+	GetShowSeatLayout(ctx context.Context, in *GetShowSeatLayoutRequest, opts ...grpc.CallOption) (*GetShowSeatLayoutResponse, error)
+	SuggestSeats(ctx context.Context, in *SuggestSeatsRequest, opts ...grpc.CallOption) (*SuggestSeatsResponse, error)
+	GetVenueLayout(ctx context.Context, in *GetVenueLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error)
+	SetVenueLayout(ctx context.Context, in *SetVenueLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error)
+	ImportSeatGrid(ctx context.Context, in *ImportSeatGridRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error)
+	ExportSeatGrid(ctx context.Context, in *ExportSeatGridRequest, opts ...grpc.CallOption) (*ExportSeatGridResponse, error)
+	CreateLayoutTemplate(ctx context.Context, in *CreateLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error)
+	UpdateLayoutTemplate(ctx context.Context, in *UpdateLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error)
+	GetLayoutTemplate(ctx context.Context, in *GetLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error)
+	ApplyLayoutTemplate(ctx context.Context, in *ApplyLayoutTemplateRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error)
+	CloneSeatLayout(ctx context.Context, in *CloneSeatLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error)
+	SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error)
+	AddCinema(ctx context.Context, in *Cinema, opts ...grpc.CallOption) (*CinemaResponse, error)
+	GetCinema(ctx context.Context, in *CinemaRequest, opts ...grpc.CallOption) (*CinemaResponse, error)
+	GetAllCinemas(ctx context.Context, in *GetAllCinemasRequest, opts ...grpc.CallOption) (*CinemaListResponse, error)
+	UpdateCinema(ctx context.Context, in *Cinema, opts ...grpc.CallOption) (*CinemaResponse, error)
+	DeleteCinema(ctx context.Context, in *CinemaRequest, opts ...grpc.CallOption) (*CinemaResponse, error)
+	MigrateVenuesToCinemas(ctx context.Context, in *MigrateVenuesToCinemasRequest, opts ...grpc.CallOption) (*MigrateVenuesToCinemasResponse, error)
+	CreateShowSchedule(ctx context.Context, in *ShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error)
+	UpdateShowSchedule(ctx context.Context, in *ShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error)
+	CancelShowSchedule(ctx context.Context, in *CancelShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error)
+	PlanProgramme(ctx context.Context, in *PlanProgrammeRequest, opts ...grpc.CallOption) (*PlanProgrammeResponse, error)
+	SetShowtimeStatus(ctx context.Context, in *SetShowtimeStatusRequest, opts ...grpc.CallOption) (*MovieTimeSlotUpdateResponse, error)
+	CancelShowtime(ctx context.Context, in *CancelShowtimeRequest, opts ...grpc.CallOption) (*CancelShowtimeResponse, error)
 }
 
 type movieDBServiceClient struct {
@@ -121,7 +198,7 @@ func (c *movieDBServiceClient) GetMovie(ctx context.Context, in *MovieRequest, o
 	return out, nil
 }
 
-func (c *movieDBServiceClient) GetAllMovies(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MovieListResponse, error) {
+func (c *movieDBServiceClient) GetAllMovies(ctx context.Context, in *GetAllMoviesRequest, opts ...grpc.CallOption) (*MovieListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MovieListResponse)
 	err := c.cc.Invoke(ctx, MovieDBService_GetAllMovies_FullMethodName, in, out, cOpts...)
@@ -171,9 +248,9 @@ func (c *movieDBServiceClient) GetVenue(ctx context.Context, in *MovieRequest, o
 	return out, nil
 }
 
-func (c *movieDBServiceClient) GetAllVenues(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MovieListResponse, error) {
+func (c *movieDBServiceClient) GetAllVenues(ctx context.Context, in *GetAllVenuesRequest, opts ...grpc.CallOption) (*VenueListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MovieListResponse)
+	out := new(VenueListResponse)
 	err := c.cc.Invoke(ctx, MovieDBService_GetAllVenues_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -371,6 +448,116 @@ func (c *movieDBServiceClient) DeleteEntireSeatMatrix(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *movieDBServiceClient) ApplySeatMatrixToShows(ctx context.Context, in *ApplySeatMatrixToShowsRequest, opts ...grpc.CallOption) (*ApplySeatMatrixToShowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplySeatMatrixToShowsResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ApplySeatMatrixToShows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*SeatBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatBlocksResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_BlockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockSeatsResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_UnblockSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) GetSeatBlocks(ctx context.Context, in *GetSeatBlocksRequest, opts ...grpc.CallOption) (*SeatBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatBlocksResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetSeatBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) AddPriceRule(ctx context.Context, in *AddPriceRuleRequest, opts ...grpc.CallOption) (*PriceRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceRuleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_AddPriceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) GetPriceRules(ctx context.Context, in *GetPriceRulesRequest, opts ...grpc.CallOption) (*GetPriceRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceRulesResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetPriceRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) DeletePriceRule(ctx context.Context, in *DeletePriceRuleRequest, opts ...grpc.CallOption) (*DeletePriceRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePriceRuleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_DeletePriceRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) AddTaxRule(ctx context.Context, in *AddTaxRuleRequest, opts ...grpc.CallOption) (*TaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxRuleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_AddTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) GetTaxRules(ctx context.Context, in *GetTaxRulesRequest, opts ...grpc.CallOption) (*GetTaxRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaxRulesResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetTaxRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaxRuleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_DeleteTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) GetBookingQuote(ctx context.Context, in *GetBookingQuoteRequest, opts ...grpc.CallOption) (*GetBookingQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingQuoteResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetBookingQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieDBServiceClient) BookSeats(ctx context.Context, in *BookSeatsRequest, opts ...grpc.CallOption) (*BookSeatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BookSeatsResponse)
@@ -421,100 +608,419 @@ func (c *movieDBServiceClient) CreateTicket(ctx context.Context, in *CreateTicke
 	return out, nil
 }
 
-// MovieDBServiceServer is the server API for MovieDBService service.
-// All implementations must embed UnimplementedMovieDBServiceServer
-// for forward compatibility.
-type MovieDBServiceServer interface {
-	AddMovie(context.Context, *Movie) (*MovieResponse, error)
-	GetMovie(context.Context, *MovieRequest) (*MovieResponse, error)
-	GetAllMovies(context.Context, *empty.Empty) (*MovieListResponse, error)
-	UpdateMovie(context.Context, *Movie) (*MovieResponse, error)
-	DeleteMovie(context.Context, *MovieRequest) (*MovieResponse, error)
-	AddVenue(context.Context, *Venue) (*VenueResponse, error)
-	GetVenue(context.Context, *MovieRequest) (*VenueResponse, error)
-	GetAllVenues(context.Context, *empty.Empty) (*MovieListResponse, error)
-	UpdateVenue(context.Context, *Venue) (*VenueResponse, error)
-	DeleteVenue(context.Context, *MovieRequest) (*MovieResponse, error)
-	GetUpcomingMovies(context.Context, *GetUpcomingMovieRequest) (*GetUpcomingMovieResponse, error)
-	GetNowPlayingMovies(context.Context, *GetNowPlayingMovieRequest) (*GetUpcomingMovieResponse, error)
-	AddReview(context.Context, *Review) (*ReviewResponse, error)
-	GetReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
-	UpdateReview(context.Context, *ReviewUpdateRequest) (*ReviewResponse, error)
-	DeleteReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
-	GetAllMovieReviews(context.Context, *GetAllMovieReviewsRequest) (*ReviewListResponse, error)
-	GetMovieTimeSlots(context.Context, *GetMovieTimeSlotRequest) (*GetMovieTimeSlotResponse, error)
-	AddMovieTimeSlot(context.Context, *MovieTimeSlot) (*MovieTimeSlotResponse, error)
-	UpdateMovieTimeSlot(context.Context, *MovieTimeSlotUpdate) (*MovieTimeSlotUpdateResponse, error)
-	DeleteMovieTimeSlot(context.Context, *MovieTimeSlotDelete) (*MovieTimeSlotResponse, error)
-	AddSeatMatrix(context.Context, *AddSeatMatrixInput) (*AddSeatMatrixResponse, error)
-	AddSingleSeatMatrix(context.Context, *AddSingleSeatMatrixInput) (*AddSingleSeatMatrixResponse, error)
-	GetSeatMatrix(context.Context, *GetSeatMatrixRequest) (*GetSeatMatrixResponse, error)
-	UpdateSeatMatrix(context.Context, *UpdateSeatMatrixRequest) (*UpdateSeatMatrixResponse, error)
-	DeleteSeatMatrix(context.Context, *DeleteSeatMatrixRequest) (*DeleteSeatMatrixResponse, error)
-	DeleteEntireSeatMatrix(context.Context, *DeleteEntireSeatMatrixRequest) (*DeleteEntireSeatMatrixResponse, error)
-	BookSeats(context.Context, *BookSeatsRequest) (*BookSeatsResponse, error)
-	GetBookedSeats(context.Context, *GetBookedSeatsRequest) (*GetBookedSeatsResponse, error)
-	// rpc GetBookedSeatsDetails(GetBookedSeatsDetailsRequest) returns (GetBookedSeatsDetailsResponse);
-	IsValidToCommitSeatsForBooking(context.Context, *IsValidToCommitSeatsForBooking_Request) (*IsValidToCommitSeatsForBooking_Response, error)
-	LockBookedSeats(context.Context, *GetBookedSeatsDetailsRequest) (*GetBookedSeatsDetailsResponse, error)
-	CreateTicket(context.Context, *CreateTicketRequest) (*CreateRequestResponse, error)
-	mustEmbedUnimplementedMovieDBServiceServer()
+func (c *movieDBServiceClient) ReleaseExpiredSeatLocks(ctx context.Context, in *ReleaseExpiredSeatLocksRequest, opts ...grpc.CallOption) (*ReleaseExpiredSeatLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseExpiredSeatLocksResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ReleaseExpiredSeatLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMovieDBServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMovieDBServiceServer struct{}
+func (c *movieDBServiceClient) ReleaseSeatLocks(ctx context.Context, in *ReleaseSeatLocksRequest, opts ...grpc.CallOption) (*ReleaseSeatLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSeatLocksResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ReleaseSeatLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedMovieDBServiceServer) AddMovie(context.Context, *Movie) (*MovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMovie not implemented")
+func (c *movieDBServiceClient) ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendSeatHoldResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ExtendSeatHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetMovie(context.Context, *MovieRequest) (*MovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMovie not implemented")
+
+func (c *movieDBServiceClient) GetMovieShowtimes(ctx context.Context, in *GetMovieShowtimesRequest, opts ...grpc.CallOption) (*GetMovieShowtimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovieShowtimesResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetMovieShowtimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetAllMovies(context.Context, *empty.Empty) (*MovieListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllMovies not implemented")
+
+func (c *movieDBServiceClient) GetShowSeatLayout(ctx context.Context, in *GetShowSeatLayoutRequest, opts ...grpc.CallOption) (*GetShowSeatLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShowSeatLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetShowSeatLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) UpdateMovie(context.Context, *Movie) (*MovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
+
+func (c *movieDBServiceClient) SuggestSeats(ctx context.Context, in *SuggestSeatsRequest, opts ...grpc.CallOption) (*SuggestSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSeatsResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_SuggestSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) DeleteMovie(context.Context, *MovieRequest) (*MovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
+
+func (c *movieDBServiceClient) GetVenueLayout(ctx context.Context, in *GetVenueLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VenueLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetVenueLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) AddVenue(context.Context, *Venue) (*VenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVenue not implemented")
+
+func (c *movieDBServiceClient) SetVenueLayout(ctx context.Context, in *SetVenueLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VenueLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_SetVenueLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetVenue(context.Context, *MovieRequest) (*VenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVenue not implemented")
+
+func (c *movieDBServiceClient) ImportSeatGrid(ctx context.Context, in *ImportSeatGridRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VenueLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ImportSeatGrid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetAllVenues(context.Context, *empty.Empty) (*MovieListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllVenues not implemented")
+
+func (c *movieDBServiceClient) ExportSeatGrid(ctx context.Context, in *ExportSeatGridRequest, opts ...grpc.CallOption) (*ExportSeatGridResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSeatGridResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ExportSeatGrid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) UpdateVenue(context.Context, *Venue) (*VenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVenue not implemented")
+
+func (c *movieDBServiceClient) CreateLayoutTemplate(ctx context.Context, in *CreateLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LayoutTemplateResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_CreateLayoutTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) DeleteVenue(context.Context, *MovieRequest) (*MovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
+
+func (c *movieDBServiceClient) UpdateLayoutTemplate(ctx context.Context, in *UpdateLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LayoutTemplateResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_UpdateLayoutTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetUpcomingMovies(context.Context, *GetUpcomingMovieRequest) (*GetUpcomingMovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingMovies not implemented")
+
+func (c *movieDBServiceClient) GetLayoutTemplate(ctx context.Context, in *GetLayoutTemplateRequest, opts ...grpc.CallOption) (*LayoutTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LayoutTemplateResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetLayoutTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetNowPlayingMovies(context.Context, *GetNowPlayingMovieRequest) (*GetUpcomingMovieResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNowPlayingMovies not implemented")
+
+func (c *movieDBServiceClient) ApplyLayoutTemplate(ctx context.Context, in *ApplyLayoutTemplateRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VenueLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_ApplyLayoutTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) AddReview(context.Context, *Review) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddReview not implemented")
+
+func (c *movieDBServiceClient) CloneSeatLayout(ctx context.Context, in *CloneSeatLayoutRequest, opts ...grpc.CallOption) (*VenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VenueLayoutResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_CloneSeatLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) GetReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+
+func (c *movieDBServiceClient) SearchMovies(ctx context.Context, in *SearchMoviesRequest, opts ...grpc.CallOption) (*SearchMoviesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMoviesResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_SearchMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) UpdateReview(context.Context, *ReviewUpdateRequest) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+
+func (c *movieDBServiceClient) AddCinema(ctx context.Context, in *Cinema, opts ...grpc.CallOption) (*CinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CinemaResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_AddCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMovieDBServiceServer) DeleteReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+
+func (c *movieDBServiceClient) GetCinema(ctx context.Context, in *CinemaRequest, opts ...grpc.CallOption) (*CinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CinemaResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) GetAllCinemas(ctx context.Context, in *GetAllCinemasRequest, opts ...grpc.CallOption) (*CinemaListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CinemaListResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_GetAllCinemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) UpdateCinema(ctx context.Context, in *Cinema, opts ...grpc.CallOption) (*CinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CinemaResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_UpdateCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) DeleteCinema(ctx context.Context, in *CinemaRequest, opts ...grpc.CallOption) (*CinemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CinemaResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_DeleteCinema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) MigrateVenuesToCinemas(ctx context.Context, in *MigrateVenuesToCinemasRequest, opts ...grpc.CallOption) (*MigrateVenuesToCinemasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateVenuesToCinemasResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_MigrateVenuesToCinemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) CreateShowSchedule(ctx context.Context, in *ShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShowScheduleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_CreateShowSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) UpdateShowSchedule(ctx context.Context, in *ShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShowScheduleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_UpdateShowSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) CancelShowSchedule(ctx context.Context, in *CancelShowScheduleRequest, opts ...grpc.CallOption) (*ShowScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShowScheduleResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_CancelShowSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) PlanProgramme(ctx context.Context, in *PlanProgrammeRequest, opts ...grpc.CallOption) (*PlanProgrammeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanProgrammeResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_PlanProgramme_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) SetShowtimeStatus(ctx context.Context, in *SetShowtimeStatusRequest, opts ...grpc.CallOption) (*MovieTimeSlotUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieTimeSlotUpdateResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_SetShowtimeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieDBServiceClient) CancelShowtime(ctx context.Context, in *CancelShowtimeRequest, opts ...grpc.CallOption) (*CancelShowtimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelShowtimeResponse)
+	err := c.cc.Invoke(ctx, MovieDBService_CancelShowtime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MovieDBServiceServer is the server API for MovieDBService service.
+// All implementations must embed UnimplementedMovieDBServiceServer
+// for forward compatibility.
+type MovieDBServiceServer interface {
+	AddMovie(context.Context, *Movie) (*MovieResponse, error)
+	GetMovie(context.Context, *MovieRequest) (*MovieResponse, error)
+	GetAllMovies(context.Context, *GetAllMoviesRequest) (*MovieListResponse, error)
+	UpdateMovie(context.Context, *Movie) (*MovieResponse, error)
+	DeleteMovie(context.Context, *MovieRequest) (*MovieResponse, error)
+	AddVenue(context.Context, *Venue) (*VenueResponse, error)
+	GetVenue(context.Context, *MovieRequest) (*VenueResponse, error)
+	GetAllVenues(context.Context, *GetAllVenuesRequest) (*VenueListResponse, error)
+	UpdateVenue(context.Context, *Venue) (*VenueResponse, error)
+	DeleteVenue(context.Context, *MovieRequest) (*MovieResponse, error)
+	GetUpcomingMovies(context.Context, *GetUpcomingMovieRequest) (*GetUpcomingMovieResponse, error)
+	GetNowPlayingMovies(context.Context, *GetNowPlayingMovieRequest) (*GetUpcomingMovieResponse, error)
+	AddReview(context.Context, *Review) (*ReviewResponse, error)
+	GetReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	UpdateReview(context.Context, *ReviewUpdateRequest) (*ReviewResponse, error)
+	DeleteReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	GetAllMovieReviews(context.Context, *GetAllMovieReviewsRequest) (*ReviewListResponse, error)
+	GetMovieTimeSlots(context.Context, *GetMovieTimeSlotRequest) (*GetMovieTimeSlotResponse, error)
+	AddMovieTimeSlot(context.Context, *MovieTimeSlot) (*MovieTimeSlotResponse, error)
+	UpdateMovieTimeSlot(context.Context, *MovieTimeSlotUpdate) (*MovieTimeSlotUpdateResponse, error)
+	DeleteMovieTimeSlot(context.Context, *MovieTimeSlotDelete) (*MovieTimeSlotResponse, error)
+	AddSeatMatrix(context.Context, *AddSeatMatrixInput) (*AddSeatMatrixResponse, error)
+	AddSingleSeatMatrix(context.Context, *AddSingleSeatMatrixInput) (*AddSingleSeatMatrixResponse, error)
+	GetSeatMatrix(context.Context, *GetSeatMatrixRequest) (*GetSeatMatrixResponse, error)
+	UpdateSeatMatrix(context.Context, *UpdateSeatMatrixRequest) (*UpdateSeatMatrixResponse, error)
+	DeleteSeatMatrix(context.Context, *DeleteSeatMatrixRequest) (*DeleteSeatMatrixResponse, error)
+	DeleteEntireSeatMatrix(context.Context, *DeleteEntireSeatMatrixRequest) (*DeleteEntireSeatMatrixResponse, error)
+	ApplySeatMatrixToShows(context.Context, *ApplySeatMatrixToShowsRequest) (*ApplySeatMatrixToShowsResponse, error)
+	BlockSeats(context.Context, *BlockSeatsRequest) (*SeatBlocksResponse, error)
+	UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error)
+	GetSeatBlocks(context.Context, *GetSeatBlocksRequest) (*SeatBlocksResponse, error)
+	AddPriceRule(context.Context, *AddPriceRuleRequest) (*PriceRuleResponse, error)
+	GetPriceRules(context.Context, *GetPriceRulesRequest) (*GetPriceRulesResponse, error)
+	DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleResponse, error)
+	AddTaxRule(context.Context, *AddTaxRuleRequest) (*TaxRuleResponse, error)
+	GetTaxRules(context.Context, *GetTaxRulesRequest) (*GetTaxRulesResponse, error)
+	DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error)
+	GetBookingQuote(context.Context, *GetBookingQuoteRequest) (*GetBookingQuoteResponse, error)
+	BookSeats(context.Context, *BookSeatsRequest) (*BookSeatsResponse, error)
+	GetBookedSeats(context.Context, *GetBookedSeatsRequest) (*GetBookedSeatsResponse, error)
+	// rpc GetBookedSeatsDetails(GetBookedSeatsDetailsRequest) returns (GetBookedSeatsDetailsResponse);
+	IsValidToCommitSeatsForBooking(context.Context, *IsValidToCommitSeatsForBooking_Request) (*IsValidToCommitSeatsForBooking_Response, error)
+	LockBookedSeats(context.Context, *GetBookedSeatsDetailsRequest) (*GetBookedSeatsDetailsResponse, error)
+	CreateTicket(context.Context, *CreateTicketRequest) (*CreateRequestResponse, error)
+	ReleaseExpiredSeatLocks(context.Context, *ReleaseExpiredSeatLocksRequest) (*ReleaseExpiredSeatLocksResponse, error)
+	ReleaseSeatLocks(context.Context, *ReleaseSeatLocksRequest) (*ReleaseSeatLocksResponse, error)
+	ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldResponse, error)
+	GetMovieShowtimes(context.Context, *GetMovieShowtimesRequest) (*GetMovieShowtimesResponse, error)
+	GetShowSeatLayout(context.Context, *GetShowSeatLayoutRequest) (*GetShowSeatLayoutResponse, error)
+	SuggestSeats(context.Context, *SuggestSeatsRequest) (*SuggestSeatsResponse, error)
+	GetVenueLayout(context.Context, *GetVenueLayoutRequest) (*VenueLayoutResponse, error)
+	SetVenueLayout(context.Context, *SetVenueLayoutRequest) (*VenueLayoutResponse, error)
+	ImportSeatGrid(context.Context, *ImportSeatGridRequest) (*VenueLayoutResponse, error)
+	ExportSeatGrid(context.Context, *ExportSeatGridRequest) (*ExportSeatGridResponse, error)
+	CreateLayoutTemplate(context.Context, *CreateLayoutTemplateRequest) (*LayoutTemplateResponse, error)
+	UpdateLayoutTemplate(context.Context, *UpdateLayoutTemplateRequest) (*LayoutTemplateResponse, error)
+	GetLayoutTemplate(context.Context, *GetLayoutTemplateRequest) (*LayoutTemplateResponse, error)
+	ApplyLayoutTemplate(context.Context, *ApplyLayoutTemplateRequest) (*VenueLayoutResponse, error)
+	CloneSeatLayout(context.Context, *CloneSeatLayoutRequest) (*VenueLayoutResponse, error)
+	SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error)
+	AddCinema(context.Context, *Cinema) (*CinemaResponse, error)
+	GetCinema(context.Context, *CinemaRequest) (*CinemaResponse, error)
+	GetAllCinemas(context.Context, *GetAllCinemasRequest) (*CinemaListResponse, error)
+	UpdateCinema(context.Context, *Cinema) (*CinemaResponse, error)
+	DeleteCinema(context.Context, *CinemaRequest) (*CinemaResponse, error)
+	MigrateVenuesToCinemas(context.Context, *MigrateVenuesToCinemasRequest) (*MigrateVenuesToCinemasResponse, error)
+	CreateShowSchedule(context.Context, *ShowScheduleRequest) (*ShowScheduleResponse, error)
+	UpdateShowSchedule(context.Context, *ShowScheduleRequest) (*ShowScheduleResponse, error)
+	CancelShowSchedule(context.Context, *CancelShowScheduleRequest) (*ShowScheduleResponse, error)
+	PlanProgramme(context.Context, *PlanProgrammeRequest) (*PlanProgrammeResponse, error)
+	SetShowtimeStatus(context.Context, *SetShowtimeStatusRequest) (*MovieTimeSlotUpdateResponse, error)
+	CancelShowtime(context.Context, *CancelShowtimeRequest) (*CancelShowtimeResponse, error)
+	mustEmbedUnimplementedMovieDBServiceServer()
+}
+
+// UnimplementedMovieDBServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMovieDBServiceServer struct{}
+
+func (UnimplementedMovieDBServiceServer) AddMovie(context.Context, *Movie) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMovie not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetMovie(context.Context, *MovieRequest) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovie not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetAllMovies(context.Context, *GetAllMoviesRequest) (*MovieListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllMovies not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateMovie(context.Context, *Movie) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMovie not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeleteMovie(context.Context, *MovieRequest) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovie not implemented")
+}
+func (UnimplementedMovieDBServiceServer) AddVenue(context.Context, *Venue) (*VenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVenue not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetVenue(context.Context, *MovieRequest) (*VenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenue not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetAllVenues(context.Context, *GetAllVenuesRequest) (*VenueListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllVenues not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateVenue(context.Context, *Venue) (*VenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVenue not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeleteVenue(context.Context, *MovieRequest) (*MovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetUpcomingMovies(context.Context, *GetUpcomingMovieRequest) (*GetUpcomingMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingMovies not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetNowPlayingMovies(context.Context, *GetNowPlayingMovieRequest) (*GetUpcomingMovieResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNowPlayingMovies not implemented")
+}
+func (UnimplementedMovieDBServiceServer) AddReview(context.Context, *Review) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReview not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateReview(context.Context, *ReviewUpdateRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeleteReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
 }
 func (UnimplementedMovieDBServiceServer) GetAllMovieReviews(context.Context, *GetAllMovieReviewsRequest) (*ReviewListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllMovieReviews not implemented")
@@ -549,6 +1055,39 @@ func (UnimplementedMovieDBServiceServer) DeleteSeatMatrix(context.Context, *Dele
 func (UnimplementedMovieDBServiceServer) DeleteEntireSeatMatrix(context.Context, *DeleteEntireSeatMatrixRequest) (*DeleteEntireSeatMatrixResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntireSeatMatrix not implemented")
 }
+func (UnimplementedMovieDBServiceServer) ApplySeatMatrixToShows(context.Context, *ApplySeatMatrixToShowsRequest) (*ApplySeatMatrixToShowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySeatMatrixToShows not implemented")
+}
+func (UnimplementedMovieDBServiceServer) BlockSeats(context.Context, *BlockSeatsRequest) (*SeatBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeats not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeats not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetSeatBlocks(context.Context, *GetSeatBlocksRequest) (*SeatBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatBlocks not implemented")
+}
+func (UnimplementedMovieDBServiceServer) AddPriceRule(context.Context, *AddPriceRuleRequest) (*PriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceRule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetPriceRules(context.Context, *GetPriceRulesRequest) (*GetPriceRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceRules not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeletePriceRule(context.Context, *DeletePriceRuleRequest) (*DeletePriceRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceRule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) AddTaxRule(context.Context, *AddTaxRuleRequest) (*TaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTaxRule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetTaxRules(context.Context, *GetTaxRulesRequest) (*GetTaxRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxRules not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxRule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetBookingQuote(context.Context, *GetBookingQuoteRequest) (*GetBookingQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingQuote not implemented")
+}
 func (UnimplementedMovieDBServiceServer) BookSeats(context.Context, *BookSeatsRequest) (*BookSeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BookSeats not implemented")
 }
@@ -564,6 +1103,90 @@ func (UnimplementedMovieDBServiceServer) LockBookedSeats(context.Context, *GetBo
 func (UnimplementedMovieDBServiceServer) CreateTicket(context.Context, *CreateTicketRequest) (*CreateRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTicket not implemented")
 }
+func (UnimplementedMovieDBServiceServer) ReleaseExpiredSeatLocks(context.Context, *ReleaseExpiredSeatLocksRequest) (*ReleaseExpiredSeatLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseExpiredSeatLocks not implemented")
+}
+func (UnimplementedMovieDBServiceServer) ReleaseSeatLocks(context.Context, *ReleaseSeatLocksRequest) (*ReleaseSeatLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSeatLocks not implemented")
+}
+func (UnimplementedMovieDBServiceServer) ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendSeatHold not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetMovieShowtimes(context.Context, *GetMovieShowtimesRequest) (*GetMovieShowtimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieShowtimes not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetShowSeatLayout(context.Context, *GetShowSeatLayoutRequest) (*GetShowSeatLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShowSeatLayout not implemented")
+}
+func (UnimplementedMovieDBServiceServer) SuggestSeats(context.Context, *SuggestSeatsRequest) (*SuggestSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSeats not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetVenueLayout(context.Context, *GetVenueLayoutRequest) (*VenueLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenueLayout not implemented")
+}
+func (UnimplementedMovieDBServiceServer) SetVenueLayout(context.Context, *SetVenueLayoutRequest) (*VenueLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVenueLayout not implemented")
+}
+func (UnimplementedMovieDBServiceServer) ImportSeatGrid(context.Context, *ImportSeatGridRequest) (*VenueLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSeatGrid not implemented")
+}
+func (UnimplementedMovieDBServiceServer) ExportSeatGrid(context.Context, *ExportSeatGridRequest) (*ExportSeatGridResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSeatGrid not implemented")
+}
+func (UnimplementedMovieDBServiceServer) CreateLayoutTemplate(context.Context, *CreateLayoutTemplateRequest) (*LayoutTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLayoutTemplate not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateLayoutTemplate(context.Context, *UpdateLayoutTemplateRequest) (*LayoutTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLayoutTemplate not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetLayoutTemplate(context.Context, *GetLayoutTemplateRequest) (*LayoutTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLayoutTemplate not implemented")
+}
+func (UnimplementedMovieDBServiceServer) ApplyLayoutTemplate(context.Context, *ApplyLayoutTemplateRequest) (*VenueLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyLayoutTemplate not implemented")
+}
+func (UnimplementedMovieDBServiceServer) CloneSeatLayout(context.Context, *CloneSeatLayoutRequest) (*VenueLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSeatLayout not implemented")
+}
+func (UnimplementedMovieDBServiceServer) SearchMovies(context.Context, *SearchMoviesRequest) (*SearchMoviesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMovies not implemented")
+}
+func (UnimplementedMovieDBServiceServer) AddCinema(context.Context, *Cinema) (*CinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCinema not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetCinema(context.Context, *CinemaRequest) (*CinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCinema not implemented")
+}
+func (UnimplementedMovieDBServiceServer) GetAllCinemas(context.Context, *GetAllCinemasRequest) (*CinemaListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCinemas not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateCinema(context.Context, *Cinema) (*CinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCinema not implemented")
+}
+func (UnimplementedMovieDBServiceServer) DeleteCinema(context.Context, *CinemaRequest) (*CinemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCinema not implemented")
+}
+func (UnimplementedMovieDBServiceServer) MigrateVenuesToCinemas(context.Context, *MigrateVenuesToCinemasRequest) (*MigrateVenuesToCinemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVenuesToCinemas not implemented")
+}
+func (UnimplementedMovieDBServiceServer) CreateShowSchedule(context.Context, *ShowScheduleRequest) (*ShowScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShowSchedule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) UpdateShowSchedule(context.Context, *ShowScheduleRequest) (*ShowScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShowSchedule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) CancelShowSchedule(context.Context, *CancelShowScheduleRequest) (*ShowScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShowSchedule not implemented")
+}
+func (UnimplementedMovieDBServiceServer) PlanProgramme(context.Context, *PlanProgrammeRequest) (*PlanProgrammeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanProgramme not implemented")
+}
+func (UnimplementedMovieDBServiceServer) SetShowtimeStatus(context.Context, *SetShowtimeStatusRequest) (*MovieTimeSlotUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShowtimeStatus not implemented")
+}
+func (UnimplementedMovieDBServiceServer) CancelShowtime(context.Context, *CancelShowtimeRequest) (*CancelShowtimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShowtime not implemented")
+}
 func (UnimplementedMovieDBServiceServer) mustEmbedUnimplementedMovieDBServiceServer() {}
 func (UnimplementedMovieDBServiceServer) testEmbeddedByValue()                        {}
 
@@ -582,581 +1205,1283 @@ func RegisterMovieDBServiceServer(s grpc.ServiceRegistrar, srv MovieDBServiceSer
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&MovieDBService_ServiceDesc, srv)
+	s.RegisterService(&MovieDBService_ServiceDesc, srv)
+}
+
+func _MovieDBService_AddMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Movie)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddMovie(ctx, req.(*Movie))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetMovie(ctx, req.(*MovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetAllMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetAllMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetAllMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetAllMovies(ctx, req.(*GetAllMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Movie)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UpdateMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UpdateMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UpdateMovie(ctx, req.(*Movie))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteMovie(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteMovie_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteMovie(ctx, req.(*MovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Venue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddVenue(ctx, req.(*Venue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetVenue(ctx, req.(*MovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetAllVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetAllVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetAllVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetAllVenues(ctx, req.(*GetAllVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UpdateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Venue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UpdateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UpdateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UpdateVenue(ctx, req.(*Venue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteVenue(ctx, req.(*MovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetUpcomingMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpcomingMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetUpcomingMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetUpcomingMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetUpcomingMovies(ctx, req.(*GetUpcomingMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetNowPlayingMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNowPlayingMovieRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetNowPlayingMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetNowPlayingMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetNowPlayingMovies(ctx, req.(*GetNowPlayingMovieRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Review)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddReview(ctx, req.(*Review))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UpdateReview(ctx, req.(*ReviewUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetAllMovieReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllMovieReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetAllMovieReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetAllMovieReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetAllMovieReviews(ctx, req.(*GetAllMovieReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetMovieTimeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieTimeSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetMovieTimeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetMovieTimeSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetMovieTimeSlots(ctx, req.(*GetMovieTimeSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieTimeSlot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddMovieTimeSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddMovieTimeSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddMovieTimeSlot(ctx, req.(*MovieTimeSlot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UpdateMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieTimeSlotUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UpdateMovieTimeSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UpdateMovieTimeSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UpdateMovieTimeSlot(ctx, req.(*MovieTimeSlotUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovieTimeSlotDelete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteMovieTimeSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteMovieTimeSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteMovieTimeSlot(ctx, req.(*MovieTimeSlotDelete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSeatMatrixInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddSeatMatrix(ctx, req.(*AddSeatMatrixInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddSingleSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSingleSeatMatrixInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddSingleSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddSingleSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddSingleSeatMatrix(ctx, req.(*AddSingleSeatMatrixInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetSeatMatrix(ctx, req.(*GetSeatMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UpdateSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeatMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UpdateSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UpdateSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UpdateSeatMatrix(ctx, req.(*UpdateSeatMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeatMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteSeatMatrix(ctx, req.(*DeleteSeatMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteEntireSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntireSeatMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteEntireSeatMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteEntireSeatMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteEntireSeatMatrix(ctx, req.(*DeleteEntireSeatMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_ApplySeatMatrixToShows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySeatMatrixToShowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).ApplySeatMatrixToShows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_ApplySeatMatrixToShows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).ApplySeatMatrixToShows(ctx, req.(*ApplySeatMatrixToShowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_BlockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).BlockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_BlockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).BlockSeats(ctx, req.(*BlockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_UnblockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).UnblockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_UnblockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).UnblockSeats(ctx, req.(*UnblockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetSeatBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetSeatBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetSeatBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetSeatBlocks(ctx, req.(*GetSeatBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddPriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddPriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddPriceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddPriceRule(ctx, req.(*AddPriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetPriceRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetPriceRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetPriceRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetPriceRules(ctx, req.(*GetPriceRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeletePriceRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeletePriceRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeletePriceRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeletePriceRule(ctx, req.(*DeletePriceRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_AddTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).AddTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_AddTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).AddTaxRule(ctx, req.(*AddTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetTaxRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetTaxRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetTaxRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetTaxRules(ctx, req.(*GetTaxRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_DeleteTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).DeleteTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_DeleteTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).DeleteTaxRule(ctx, req.(*DeleteTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_GetBookingQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).GetBookingQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_GetBookingQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).GetBookingQuote(ctx, req.(*GetBookingQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieDBService_BookSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieDBServiceServer).BookSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieDBService_BookSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieDBServiceServer).BookSeats(ctx, req.(*BookSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Movie)
+func _MovieDBService_GetBookedSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookedSeatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddMovie(ctx, in)
+		return srv.(MovieDBServiceServer).GetBookedSeats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddMovie_FullMethodName,
+		FullMethod: MovieDBService_GetBookedSeats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddMovie(ctx, req.(*Movie))
+		return srv.(MovieDBServiceServer).GetBookedSeats(ctx, req.(*GetBookedSeatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieRequest)
+func _MovieDBService_IsValidToCommitSeatsForBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsValidToCommitSeatsForBooking_Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetMovie(ctx, in)
+		return srv.(MovieDBServiceServer).IsValidToCommitSeatsForBooking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetMovie_FullMethodName,
+		FullMethod: MovieDBService_IsValidToCommitSeatsForBooking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetMovie(ctx, req.(*MovieRequest))
+		return srv.(MovieDBServiceServer).IsValidToCommitSeatsForBooking(ctx, req.(*IsValidToCommitSeatsForBooking_Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetAllMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _MovieDBService_LockBookedSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookedSeatsDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetAllMovies(ctx, in)
+		return srv.(MovieDBServiceServer).LockBookedSeats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetAllMovies_FullMethodName,
+		FullMethod: MovieDBService_LockBookedSeats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetAllMovies(ctx, req.(*empty.Empty))
+		return srv.(MovieDBServiceServer).LockBookedSeats(ctx, req.(*GetBookedSeatsDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_UpdateMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Movie)
+func _MovieDBService_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).UpdateMovie(ctx, in)
+		return srv.(MovieDBServiceServer).CreateTicket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_UpdateMovie_FullMethodName,
+		FullMethod: MovieDBService_CreateTicket_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).UpdateMovie(ctx, req.(*Movie))
+		return srv.(MovieDBServiceServer).CreateTicket(ctx, req.(*CreateTicketRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteMovie_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieRequest)
+func _MovieDBService_ReleaseExpiredSeatLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseExpiredSeatLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteMovie(ctx, in)
+		return srv.(MovieDBServiceServer).ReleaseExpiredSeatLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteMovie_FullMethodName,
+		FullMethod: MovieDBService_ReleaseExpiredSeatLocks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteMovie(ctx, req.(*MovieRequest))
+		return srv.(MovieDBServiceServer).ReleaseExpiredSeatLocks(ctx, req.(*ReleaseExpiredSeatLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Venue)
+func _MovieDBService_ReleaseSeatLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeatLocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddVenue(ctx, in)
+		return srv.(MovieDBServiceServer).ReleaseSeatLocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddVenue_FullMethodName,
+		FullMethod: MovieDBService_ReleaseSeatLocks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddVenue(ctx, req.(*Venue))
+		return srv.(MovieDBServiceServer).ReleaseSeatLocks(ctx, req.(*ReleaseSeatLocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieRequest)
+func _MovieDBService_ExtendSeatHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendSeatHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetVenue(ctx, in)
+		return srv.(MovieDBServiceServer).ExtendSeatHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetVenue_FullMethodName,
+		FullMethod: MovieDBService_ExtendSeatHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetVenue(ctx, req.(*MovieRequest))
+		return srv.(MovieDBServiceServer).ExtendSeatHold(ctx, req.(*ExtendSeatHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetAllVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _MovieDBService_GetMovieShowtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieShowtimesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetAllVenues(ctx, in)
+		return srv.(MovieDBServiceServer).GetMovieShowtimes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetAllVenues_FullMethodName,
+		FullMethod: MovieDBService_GetMovieShowtimes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetAllVenues(ctx, req.(*empty.Empty))
+		return srv.(MovieDBServiceServer).GetMovieShowtimes(ctx, req.(*GetMovieShowtimesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_UpdateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Venue)
+func _MovieDBService_GetShowSeatLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShowSeatLayoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).UpdateVenue(ctx, in)
+		return srv.(MovieDBServiceServer).GetShowSeatLayout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_UpdateVenue_FullMethodName,
+		FullMethod: MovieDBService_GetShowSeatLayout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).UpdateVenue(ctx, req.(*Venue))
+		return srv.(MovieDBServiceServer).GetShowSeatLayout(ctx, req.(*GetShowSeatLayoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieRequest)
+func _MovieDBService_SuggestSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSeatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteVenue(ctx, in)
+		return srv.(MovieDBServiceServer).SuggestSeats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteVenue_FullMethodName,
+		FullMethod: MovieDBService_SuggestSeats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteVenue(ctx, req.(*MovieRequest))
+		return srv.(MovieDBServiceServer).SuggestSeats(ctx, req.(*SuggestSeatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetUpcomingMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUpcomingMovieRequest)
+func _MovieDBService_GetVenueLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenueLayoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetUpcomingMovies(ctx, in)
+		return srv.(MovieDBServiceServer).GetVenueLayout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetUpcomingMovies_FullMethodName,
+		FullMethod: MovieDBService_GetVenueLayout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetUpcomingMovies(ctx, req.(*GetUpcomingMovieRequest))
+		return srv.(MovieDBServiceServer).GetVenueLayout(ctx, req.(*GetVenueLayoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetNowPlayingMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNowPlayingMovieRequest)
+func _MovieDBService_SetVenueLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVenueLayoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetNowPlayingMovies(ctx, in)
+		return srv.(MovieDBServiceServer).SetVenueLayout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetNowPlayingMovies_FullMethodName,
+		FullMethod: MovieDBService_SetVenueLayout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetNowPlayingMovies(ctx, req.(*GetNowPlayingMovieRequest))
+		return srv.(MovieDBServiceServer).SetVenueLayout(ctx, req.(*SetVenueLayoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Review)
+func _MovieDBService_ImportSeatGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSeatGridRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddReview(ctx, in)
+		return srv.(MovieDBServiceServer).ImportSeatGrid(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddReview_FullMethodName,
+		FullMethod: MovieDBService_ImportSeatGrid_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddReview(ctx, req.(*Review))
+		return srv.(MovieDBServiceServer).ImportSeatGrid(ctx, req.(*ImportSeatGridRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _MovieDBService_ExportSeatGrid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSeatGridRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetReview(ctx, in)
+		return srv.(MovieDBServiceServer).ExportSeatGrid(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetReview_FullMethodName,
+		FullMethod: MovieDBService_ExportSeatGrid_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetReview(ctx, req.(*ReviewRequest))
+		return srv.(MovieDBServiceServer).ExportSeatGrid(ctx, req.(*ExportSeatGridRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewUpdateRequest)
+func _MovieDBService_CreateLayoutTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLayoutTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).UpdateReview(ctx, in)
+		return srv.(MovieDBServiceServer).CreateLayoutTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_UpdateReview_FullMethodName,
+		FullMethod: MovieDBService_CreateLayoutTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).UpdateReview(ctx, req.(*ReviewUpdateRequest))
+		return srv.(MovieDBServiceServer).CreateLayoutTemplate(ctx, req.(*CreateLayoutTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewRequest)
+func _MovieDBService_UpdateLayoutTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLayoutTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteReview(ctx, in)
+		return srv.(MovieDBServiceServer).UpdateLayoutTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteReview_FullMethodName,
+		FullMethod: MovieDBService_UpdateLayoutTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteReview(ctx, req.(*ReviewRequest))
+		return srv.(MovieDBServiceServer).UpdateLayoutTemplate(ctx, req.(*UpdateLayoutTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetAllMovieReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllMovieReviewsRequest)
+func _MovieDBService_GetLayoutTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLayoutTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetAllMovieReviews(ctx, in)
+		return srv.(MovieDBServiceServer).GetLayoutTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetAllMovieReviews_FullMethodName,
+		FullMethod: MovieDBService_GetLayoutTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetAllMovieReviews(ctx, req.(*GetAllMovieReviewsRequest))
+		return srv.(MovieDBServiceServer).GetLayoutTemplate(ctx, req.(*GetLayoutTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetMovieTimeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMovieTimeSlotRequest)
+func _MovieDBService_ApplyLayoutTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyLayoutTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetMovieTimeSlots(ctx, in)
+		return srv.(MovieDBServiceServer).ApplyLayoutTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetMovieTimeSlots_FullMethodName,
+		FullMethod: MovieDBService_ApplyLayoutTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetMovieTimeSlots(ctx, req.(*GetMovieTimeSlotRequest))
+		return srv.(MovieDBServiceServer).ApplyLayoutTemplate(ctx, req.(*ApplyLayoutTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieTimeSlot)
+func _MovieDBService_CloneSeatLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSeatLayoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddMovieTimeSlot(ctx, in)
+		return srv.(MovieDBServiceServer).CloneSeatLayout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddMovieTimeSlot_FullMethodName,
+		FullMethod: MovieDBService_CloneSeatLayout_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddMovieTimeSlot(ctx, req.(*MovieTimeSlot))
+		return srv.(MovieDBServiceServer).CloneSeatLayout(ctx, req.(*CloneSeatLayoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_UpdateMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieTimeSlotUpdate)
+func _MovieDBService_SearchMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMoviesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).UpdateMovieTimeSlot(ctx, in)
+		return srv.(MovieDBServiceServer).SearchMovies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_UpdateMovieTimeSlot_FullMethodName,
+		FullMethod: MovieDBService_SearchMovies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).UpdateMovieTimeSlot(ctx, req.(*MovieTimeSlotUpdate))
+		return srv.(MovieDBServiceServer).SearchMovies(ctx, req.(*SearchMoviesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteMovieTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovieTimeSlotDelete)
+func _MovieDBService_AddCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cinema)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteMovieTimeSlot(ctx, in)
+		return srv.(MovieDBServiceServer).AddCinema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteMovieTimeSlot_FullMethodName,
+		FullMethod: MovieDBService_AddCinema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteMovieTimeSlot(ctx, req.(*MovieTimeSlotDelete))
+		return srv.(MovieDBServiceServer).AddCinema(ctx, req.(*Cinema))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSeatMatrixInput)
+func _MovieDBService_GetCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CinemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).GetCinema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_GetCinema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddSeatMatrix(ctx, req.(*AddSeatMatrixInput))
+		return srv.(MovieDBServiceServer).GetCinema(ctx, req.(*CinemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_AddSingleSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSingleSeatMatrixInput)
+func _MovieDBService_GetAllCinemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCinemasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).AddSingleSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).GetAllCinemas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_AddSingleSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_GetAllCinemas_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).AddSingleSeatMatrix(ctx, req.(*AddSingleSeatMatrixInput))
+		return srv.(MovieDBServiceServer).GetAllCinemas(ctx, req.(*GetAllCinemasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSeatMatrixRequest)
+func _MovieDBService_UpdateCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Cinema)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).UpdateCinema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_UpdateCinema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetSeatMatrix(ctx, req.(*GetSeatMatrixRequest))
+		return srv.(MovieDBServiceServer).UpdateCinema(ctx, req.(*Cinema))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_UpdateSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSeatMatrixRequest)
+func _MovieDBService_DeleteCinema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CinemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).UpdateSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).DeleteCinema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_UpdateSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_DeleteCinema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).UpdateSeatMatrix(ctx, req.(*UpdateSeatMatrixRequest))
+		return srv.(MovieDBServiceServer).DeleteCinema(ctx, req.(*CinemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSeatMatrixRequest)
+func _MovieDBService_MigrateVenuesToCinemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateVenuesToCinemasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).MigrateVenuesToCinemas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_MigrateVenuesToCinemas_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteSeatMatrix(ctx, req.(*DeleteSeatMatrixRequest))
+		return srv.(MovieDBServiceServer).MigrateVenuesToCinemas(ctx, req.(*MigrateVenuesToCinemasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_DeleteEntireSeatMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteEntireSeatMatrixRequest)
+func _MovieDBService_CreateShowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).DeleteEntireSeatMatrix(ctx, in)
+		return srv.(MovieDBServiceServer).CreateShowSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_DeleteEntireSeatMatrix_FullMethodName,
+		FullMethod: MovieDBService_CreateShowSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).DeleteEntireSeatMatrix(ctx, req.(*DeleteEntireSeatMatrixRequest))
+		return srv.(MovieDBServiceServer).CreateShowSchedule(ctx, req.(*ShowScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_BookSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BookSeatsRequest)
+func _MovieDBService_UpdateShowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).BookSeats(ctx, in)
+		return srv.(MovieDBServiceServer).UpdateShowSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_BookSeats_FullMethodName,
+		FullMethod: MovieDBService_UpdateShowSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).BookSeats(ctx, req.(*BookSeatsRequest))
+		return srv.(MovieDBServiceServer).UpdateShowSchedule(ctx, req.(*ShowScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_GetBookedSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookedSeatsRequest)
+func _MovieDBService_CancelShowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShowScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).GetBookedSeats(ctx, in)
+		return srv.(MovieDBServiceServer).CancelShowSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_GetBookedSeats_FullMethodName,
+		FullMethod: MovieDBService_CancelShowSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).GetBookedSeats(ctx, req.(*GetBookedSeatsRequest))
+		return srv.(MovieDBServiceServer).CancelShowSchedule(ctx, req.(*CancelShowScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_IsValidToCommitSeatsForBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsValidToCommitSeatsForBooking_Request)
+func _MovieDBService_PlanProgramme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanProgrammeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).IsValidToCommitSeatsForBooking(ctx, in)
+		return srv.(MovieDBServiceServer).PlanProgramme(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_IsValidToCommitSeatsForBooking_FullMethodName,
+		FullMethod: MovieDBService_PlanProgramme_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).IsValidToCommitSeatsForBooking(ctx, req.(*IsValidToCommitSeatsForBooking_Request))
+		return srv.(MovieDBServiceServer).PlanProgramme(ctx, req.(*PlanProgrammeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_LockBookedSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookedSeatsDetailsRequest)
+func _MovieDBService_SetShowtimeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShowtimeStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).LockBookedSeats(ctx, in)
+		return srv.(MovieDBServiceServer).SetShowtimeStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_LockBookedSeats_FullMethodName,
+		FullMethod: MovieDBService_SetShowtimeStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).LockBookedSeats(ctx, req.(*GetBookedSeatsDetailsRequest))
+		return srv.(MovieDBServiceServer).SetShowtimeStatus(ctx, req.(*SetShowtimeStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MovieDBService_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTicketRequest)
+func _MovieDBService_CancelShowtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShowtimeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MovieDBServiceServer).CreateTicket(ctx, in)
+		return srv.(MovieDBServiceServer).CancelShowtime(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MovieDBService_CreateTicket_FullMethodName,
+		FullMethod: MovieDBService_CancelShowtime_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MovieDBServiceServer).CreateTicket(ctx, req.(*CreateTicketRequest))
+		return srv.(MovieDBServiceServer).CancelShowtime(ctx, req.(*CancelShowtimeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1276,6 +2601,50 @@ var MovieDBService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntireSeatMatrix",
 			Handler:    _MovieDBService_DeleteEntireSeatMatrix_Handler,
 		},
+		{
+			MethodName: "ApplySeatMatrixToShows",
+			Handler:    _MovieDBService_ApplySeatMatrixToShows_Handler,
+		},
+		{
+			MethodName: "BlockSeats",
+			Handler:    _MovieDBService_BlockSeats_Handler,
+		},
+		{
+			MethodName: "UnblockSeats",
+			Handler:    _MovieDBService_UnblockSeats_Handler,
+		},
+		{
+			MethodName: "GetSeatBlocks",
+			Handler:    _MovieDBService_GetSeatBlocks_Handler,
+		},
+		{
+			MethodName: "AddPriceRule",
+			Handler:    _MovieDBService_AddPriceRule_Handler,
+		},
+		{
+			MethodName: "GetPriceRules",
+			Handler:    _MovieDBService_GetPriceRules_Handler,
+		},
+		{
+			MethodName: "DeletePriceRule",
+			Handler:    _MovieDBService_DeletePriceRule_Handler,
+		},
+		{
+			MethodName: "AddTaxRule",
+			Handler:    _MovieDBService_AddTaxRule_Handler,
+		},
+		{
+			MethodName: "GetTaxRules",
+			Handler:    _MovieDBService_GetTaxRules_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRule",
+			Handler:    _MovieDBService_DeleteTaxRule_Handler,
+		},
+		{
+			MethodName: "GetBookingQuote",
+			Handler:    _MovieDBService_GetBookingQuote_Handler,
+		},
 		{
 			MethodName: "BookSeats",
 			Handler:    _MovieDBService_BookSeats_Handler,
@@ -1296,6 +2665,118 @@ var MovieDBService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTicket",
 			Handler:    _MovieDBService_CreateTicket_Handler,
 		},
+		{
+			MethodName: "ReleaseExpiredSeatLocks",
+			Handler:    _MovieDBService_ReleaseExpiredSeatLocks_Handler,
+		},
+		{
+			MethodName: "ReleaseSeatLocks",
+			Handler:    _MovieDBService_ReleaseSeatLocks_Handler,
+		},
+		{
+			MethodName: "ExtendSeatHold",
+			Handler:    _MovieDBService_ExtendSeatHold_Handler,
+		},
+		{
+			MethodName: "GetMovieShowtimes",
+			Handler:    _MovieDBService_GetMovieShowtimes_Handler,
+		},
+		{
+			MethodName: "GetShowSeatLayout",
+			Handler:    _MovieDBService_GetShowSeatLayout_Handler,
+		},
+		{
+			MethodName: "SuggestSeats",
+			Handler:    _MovieDBService_SuggestSeats_Handler,
+		},
+		{
+			MethodName: "GetVenueLayout",
+			Handler:    _MovieDBService_GetVenueLayout_Handler,
+		},
+		{
+			MethodName: "SetVenueLayout",
+			Handler:    _MovieDBService_SetVenueLayout_Handler,
+		},
+		{
+			MethodName: "ImportSeatGrid",
+			Handler:    _MovieDBService_ImportSeatGrid_Handler,
+		},
+		{
+			MethodName: "ExportSeatGrid",
+			Handler:    _MovieDBService_ExportSeatGrid_Handler,
+		},
+		{
+			MethodName: "CreateLayoutTemplate",
+			Handler:    _MovieDBService_CreateLayoutTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateLayoutTemplate",
+			Handler:    _MovieDBService_UpdateLayoutTemplate_Handler,
+		},
+		{
+			MethodName: "GetLayoutTemplate",
+			Handler:    _MovieDBService_GetLayoutTemplate_Handler,
+		},
+		{
+			MethodName: "ApplyLayoutTemplate",
+			Handler:    _MovieDBService_ApplyLayoutTemplate_Handler,
+		},
+		{
+			MethodName: "CloneSeatLayout",
+			Handler:    _MovieDBService_CloneSeatLayout_Handler,
+		},
+		{
+			MethodName: "SearchMovies",
+			Handler:    _MovieDBService_SearchMovies_Handler,
+		},
+		{
+			MethodName: "AddCinema",
+			Handler:    _MovieDBService_AddCinema_Handler,
+		},
+		{
+			MethodName: "GetCinema",
+			Handler:    _MovieDBService_GetCinema_Handler,
+		},
+		{
+			MethodName: "GetAllCinemas",
+			Handler:    _MovieDBService_GetAllCinemas_Handler,
+		},
+		{
+			MethodName: "UpdateCinema",
+			Handler:    _MovieDBService_UpdateCinema_Handler,
+		},
+		{
+			MethodName: "DeleteCinema",
+			Handler:    _MovieDBService_DeleteCinema_Handler,
+		},
+		{
+			MethodName: "MigrateVenuesToCinemas",
+			Handler:    _MovieDBService_MigrateVenuesToCinemas_Handler,
+		},
+		{
+			MethodName: "CreateShowSchedule",
+			Handler:    _MovieDBService_CreateShowSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateShowSchedule",
+			Handler:    _MovieDBService_UpdateShowSchedule_Handler,
+		},
+		{
+			MethodName: "CancelShowSchedule",
+			Handler:    _MovieDBService_CancelShowSchedule_Handler,
+		},
+		{
+			MethodName: "PlanProgramme",
+			Handler:    _MovieDBService_PlanProgramme_Handler,
+		},
+		{
+			MethodName: "SetShowtimeStatus",
+			Handler:    _MovieDBService_SetShowtimeStatus_Handler,
+		},
+		{
+			MethodName: "CancelShowtime",
+			Handler:    _MovieDBService_CancelShowtime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "moviedb_service.proto",
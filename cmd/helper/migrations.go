@@ -0,0 +1,106 @@
+package helper
+
+import (
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"gorm.io/gorm"
+)
+
+// MovieSearchDocument is the weighted full-text document of a movie, queries have to use the exact same
+// expression for Postgres to pick the idx_movies_search index
+const MovieSearchDocument = `(setweight(to_tsvector('english', coalesce(movies.title, '')), 'A') || setweight(to_tsvector('english', coalesce(movies.description, '')), 'B'))`
+
+// CastSearchDocument is the full-text document of a cast or crew member, used with the idx_cast_and_crews_search index
+const CastSearchDocument = `to_tsvector('simple', coalesce(cast_and_crews.name, ''))`
+
+// migrations hold what AutoMigrate cannot express, every statement has to be safe to run again
+var migrations = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	`CREATE INDEX IF NOT EXISTS idx_movies_search ON movies USING GIN (` + MovieSearchDocument + `)`,
+	`CREATE INDEX IF NOT EXISTS idx_movies_title_trgm ON movies USING GIN (title gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS idx_cast_and_crews_search ON cast_and_crews USING GIN (` + CastSearchDocument + `)`,
+	`CREATE INDEX IF NOT EXISTS idx_cast_and_crews_name_trgm ON cast_and_crews USING GIN (name gin_trgm_ops)`,
+	`CREATE EXTENSION IF NOT EXISTS postgis`,
+	`ALTER TABLE venues ADD COLUMN IF NOT EXISTS location geography(Point, 4326)
+		GENERATED ALWAYS AS (ST_SetSRID(ST_MakePoint(longitude, latitude), 4326)::geography) STORED`,
+	`CREATE INDEX IF NOT EXISTS idx_venues_location ON venues USING GIST (location)`,
+	// Screens added before venues had a time zone take the one of their cinema
+	`UPDATE venues SET timezone = cinemas.timezone FROM cinemas
+		WHERE venues.cinema_id = cinemas.id AND venues.timezone IS DISTINCT FROM cinemas.timezone`,
+}
+
+// conversions run before AutoMigrate, while the schema still has what they convert from. Each one checks it is needed
+var conversions = []string{
+	// Prices were whole rupees until venues had a currency, they are in its minor units since. The currency column is
+	// added in the same statement, so the prices are converted exactly once even if a later step fails
+	`DO $$ BEGIN
+		IF to_regclass('venues') IS NOT NULL AND NOT EXISTS (
+			SELECT 1 FROM information_schema.columns WHERE table_name = 'venues' AND column_name = 'currency'
+		) THEN
+			IF to_regclass('seat_matrices') IS NOT NULL THEN
+				UPDATE seat_matrices SET price = price * 100;
+			END IF;
+
+			IF to_regclass('price_rules') IS NOT NULL THEN
+				UPDATE price_rules SET amount = amount * 100;
+			END IF;
+
+			IF to_regclass('layout_template_versions') IS NOT NULL THEN
+				UPDATE layout_template_versions SET prices = ARRAY(
+					SELECT split_part(p, '=', 1) || '=' || (split_part(p, '=', 2)::bigint * 100) FROM unnest(prices) AS p
+				);
+			END IF;
+
+			ALTER TABLE venues ADD COLUMN currency text NOT NULL DEFAULT 'INR';
+		END IF;
+	END $$`,
+	// idx_unique_seat had the price in it and covered removed seats, AutoMigrate creates it again for the seats in use
+	`DO $$ BEGIN
+		IF EXISTS (SELECT 1 FROM pg_indexes WHERE indexname = 'idx_unique_seat' AND indexdef NOT LIKE '%WHERE%') THEN
+			DROP INDEX idx_unique_seat;
+		END IF;
+	END $$`,
+}
+
+// Migrate brings the schema up to date, it is run on every start of the service
+func Migrate(db *gorm.DB) error {
+	for _, statement := range conversions {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	err := db.AutoMigrate(
+		&models.Movie{},
+		&models.CastAndCrew{},
+		&models.Cinema{},
+		&models.Venue{},
+		&models.SeatMatrix{},
+		&models.LayoutCell{},
+		&models.SeatSection{},
+		&models.LayoutTemplate{},
+		&models.LayoutTemplateVersion{},
+		&models.SeatBlock{},
+		&models.PriceRule{},
+		&models.TaxRule{},
+		&models.BookingQuote{},
+		&models.ShowSchedule{},
+		&models.MovieTimeSlot{},
+		&models.BookedSeats{},
+		&models.Review{},
+		&models.User{},
+		&models.Ticket{},
+		&models.Idempotent{},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range migrations {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
-	"math"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
@@ -19,6 +22,20 @@ func GenerateEcdsaKey() (*ecdsa.PrivateKey, error) {
 	return key, nil
 }
 
+// GenerateHoldToken returns a random token identifying a seat hold
+func GenerateHoldToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GenerateQuoteID returns a random ID for a booking quote, it cannot be guessed from other quotes
+func GenerateQuoteID() (string, error) {
+	return GenerateHoldToken()
+}
+
 func HashPassword(password string) ([]byte, error) {
 	// Hash the password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -28,24 +45,45 @@ func HashPassword(password string) ([]byte, error) {
 	return hashedPassword, nil
 }
 
-/*
-Haversine formula to calculate the distance between two points
+func EndOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, t.Location())
+}
+
+// EncodePageToken turns the ID of the last row of a page into an opaque token for the next page
+func EncodePageToken(lastID uint) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(lastID), 10)))
+}
 
-Return value is in km
-*/
-func Haversine(lat1, lon1, lat2, lon2 float64) float64 {
-	const R = 6371 // Earth radius in kilometers
-	dLat := (lat2 - lat1) * (math.Pi / 180.0)
-	dLon := (lon2 - lon1) * (math.Pi / 180.0)
-	lat1 = lat1 * (math.Pi / 180.0)
-	lat2 = lat2 * (math.Pi / 180.0)
+// DecodePageToken returns the ID of the last row of the previous page, an empty token means the first page
+func DecodePageToken(token string) (uint, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
 
-	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
-		math.Sin(dLon/2)*math.Sin(dLon/2)*math.Cos(lat1)*math.Cos(lat2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-	return R * c
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+
+	id, err := strconv.ParseUint(string(b), 10, 64)
+
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+
+	return uint(id), nil
 }
 
-func EndOfDay(t time.Time) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, t.Location())
+// PageSize applies the default and maximum page size
+func PageSize(pageSize int) int {
+	if pageSize <= 0 {
+		return 20
+	}
+
+	if pageSize > 100 {
+		return 100
+	}
+
+	return pageSize
 }
@@ -18,14 +18,108 @@ type CastAndCrew struct {
 
 type SeatMatrix struct {
 	gorm.Model
-	SeatNumber string `json:"seat_number" gorm:"not null;uniqueIndex:idx_unique_seat"`
+	SeatNumber string `json:"seat_number" gorm:"not null;uniqueIndex:idx_unique_seat,where:deleted_at IS NULL"`
 	Row        int    `json:"row" gorm:"not null;uniqueIndex:idx_unique_seat"`
 	Column     int    `json:"column" gorm:"not null;uniqueIndex:idx_unique_seat"`
-	Price      int    `json:"price" gorm:"not null;uniqueIndex:idx_unique_seat"`
+	Price      int    `json:"price" gorm:"not null"` // Default price in minor units of the currency of the venue, e.g. 25000 for ₹250, price rules override it
 	VenueID    uint   `json:"venue_id" gorm:"not null;uniqueIndex:idx_unique_seat"`
 	Type       string `json:"type"`
 }
 
+// LayoutCell is a cell of the seat map of a venue that is not a seat, e.g. an aisle or a wheelchair space
+type LayoutCell struct {
+	gorm.Model
+	VenueID uint   `json:"venue_id" gorm:"not null;uniqueIndex:idx_unique_layout_cell"`
+	Row     int    `json:"row" gorm:"not null;uniqueIndex:idx_unique_layout_cell"`
+	Column  int    `json:"column" gorm:"not null;uniqueIndex:idx_unique_layout_cell"`
+	Kind    string `json:"kind" gorm:"not null"` // AISLE, STAIRS, PILLAR, WHEELCHAIR or EMPTY
+}
+
+// LayoutTemplate is a seat map that can be applied to any venue, a change to it is written as a new version
+type LayoutTemplate struct {
+	gorm.Model
+	Name        string `json:"name" gorm:"not null;uniqueIndex" validate:"required"`
+	Description string `json:"description"`
+	Version     int    `json:"version" gorm:"not null"`              // Latest version
+	Currency    string `json:"currency" gorm:"not null;default:INR"` // ISO 4217, the prices of every version are in it
+
+	// Relationships
+	Versions []LayoutTemplateVersion `json:"versions" gorm:"foreignKey:TemplateID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// LayoutTemplateVersion is one version of a layout template, a version is never changed once written
+type LayoutTemplateVersion struct {
+	gorm.Model
+	TemplateID uint           `json:"template_id" gorm:"not null;uniqueIndex:idx_template_version"`
+	Version    int            `json:"version" gorm:"not null;uniqueIndex:idx_template_version"`
+	Grid       string         `json:"grid" gorm:"type:text;not null"`     // Seat grid in the TEXT format of api.ParseSeatGrid
+	Prices     pq.StringArray `json:"prices" gorm:"type:text[];not null"` // TYPE=PRICE in minor units, e.g. VIP=45000
+}
+
+// SeatSection groups a rectangle of the seat map under a name, e.g. Recliners
+type SeatSection struct {
+	gorm.Model
+	VenueID     uint   `json:"venue_id" gorm:"not null;index"`
+	Name        string `json:"name" gorm:"not null"`
+	FirstRow    int    `json:"first_row" gorm:"not null"`
+	LastRow     int    `json:"last_row" gorm:"not null"`
+	FirstColumn int    `json:"first_column" gorm:"not null"`
+	LastColumn  int    `json:"last_column" gorm:"not null"`
+}
+
+// SeatBlock takes a seat off sale, for every show of the venue between StartTime and EndTime or for one show
+type SeatBlock struct {
+	gorm.Model
+	VenueID         uint       `json:"venue_id" gorm:"not null;index"`
+	SeatMatrixID    uint       `json:"seat_matrix_id" gorm:"not null;index"`
+	MovieTimeSlotID *uint      `json:"movie_time_slot_id" gorm:"index"` // Only this show, nil for every show of the venue
+	Reason          string     `json:"reason" gorm:"not null"`          // BROKEN, HOUSE, DISTANCING or CAMERA
+	Note            string     `json:"note"`
+	StartTime       *time.Time `json:"start_time"` // Covers the shows that end after it, nil for no start
+	EndTime         *time.Time `json:"end_time"`   // Covers the shows that start before it, nil until the block is removed
+}
+
+// PriceRule sets the price of seats or adds a surcharge to it, a condition left empty matches every show, see api.PriceSeat
+type PriceRule struct {
+	gorm.Model
+	Name            string        `json:"name" gorm:"not null"`
+	Kind            string        `json:"kind" gorm:"not null"`                 // PRICE replaces the price of the seat, SURCHARGE is added to it
+	Amount          int           `json:"amount" gorm:"not null"`               // In minor units of Currency
+	Currency        string        `json:"currency" gorm:"not null;default:INR"` // ISO 4217, the rule only applies to venues charging in it
+	VenueID         *uint         `json:"venue_id" gorm:"index"`                // nil for every venue
+	MovieTimeSlotID *uint         `json:"movie_time_slot_id" gorm:"index"`      // Only this show
+	SeatType        string        `json:"seat_type"`                            // e.g. VIP
+	MovieFormat     string        `json:"movie_format"`                         // e.g. IMAX
+	DaysOfWeek      pq.Int32Array `json:"days_of_week" gorm:"type:integer[]"`   // Days the show starts on in the time zone of the venue, 0 is Sunday
+	FromTime        string        `json:"from_time"`                            // HH:MM, shows starting at or after it in the time zone of the venue
+	ToTime          string        `json:"to_time"`                              // HH:MM, shows starting before it. At or before FromTime means the next day
+}
+
+// TaxRule is a tax such as GST or VAT charged on tickets or on the convenience fee, see api.QuoteTicket
+type TaxRule struct {
+	gorm.Model
+	Name        string         `json:"name" gorm:"not null"`            // e.g. GST
+	Currency    string         `json:"currency" gorm:"not null;index"`  // ISO 4217, the rule only applies to venues charging in it
+	RegionCodes pq.StringArray `json:"region_codes" gorm:"type:text[]"` // Regions of the venues it applies to, empty for every region
+	AppliesTo   string         `json:"applies_to" gorm:"not null"`      // TICKET or FEE
+	Rate        int            `json:"rate" gorm:"not null"`            // In basis points, 1800 is 18%
+	Inclusive   bool           `json:"inclusive"`                       // Already part of the price like VAT usually is, shown but not added
+	MinAmount   int            `json:"min_amount"`                      // Applies to amounts above it in minor units, e.g. tickets over ₹100
+	MaxAmount   int            `json:"max_amount"`                      // and at or below it, 0 for no limit
+}
+
+// BookingQuote is the price a customer was shown for held seats, CreateTicket charges it whatever the prices are by then
+type BookingQuote struct {
+	gorm.Model
+	QuoteID         string        `json:"quote_id" gorm:"not null;uniqueIndex"`
+	MovieTimeSlotID uint          `json:"movie_time_slot_id" gorm:"not null;index"`
+	HoldToken       string        `json:"-" gorm:"not null;index"` // Hold the seats were quoted for
+	SeatMatrixIDs   pq.Int32Array `json:"seat_matrix_ids" gorm:"type:integer[];not null"`
+	Currency        string        `json:"currency" gorm:"not null"`
+	Total           int64         `json:"total" gorm:"not null"`                // Grand total in minor units
+	Breakdown       string        `json:"breakdown" gorm:"type:jsonb;not null"` // api.Quote as JSON
+}
+
 // BookedSeats to track booked seats
 type BookedSeats struct {
 	gorm.Model
@@ -35,7 +129,10 @@ type BookedSeats struct {
 	IsBooked        bool       `json:"is_booked"`
 	Email           *string    `json:"email" validate:"required,email"`
 	PhoneNumber     string     `json:"phone_number" validate:"required,e164"`
-	LockedUntil     *time.Time `json:"locked_until"` // Optional field to lock the seat for a certain period
+	LockedUntil     *time.Time `json:"locked_until" gorm:"index"` // Optional field to lock the seat for a certain period
+	LockedBy        *string    `json:"locked_by" gorm:"index"`    // Idempotency key of the checkout holding the lock
+	HoldToken       *string    `json:"-" gorm:"index"`            // Token returned to the lock owner, required to book the seat
+	HoldExtensions  int        `json:"hold_extensions"`           // Number of times the current hold was extended
 }
 
 // Booked Seats need to added when a time slot is added
@@ -52,13 +149,38 @@ type Review struct {
 
 type MovieTimeSlot struct {
 	gorm.Model
-	StartTime   time.Time `json:"start_time" gorm:"not null"`
-	EndTime     time.Time `json:"end_time" gorm:"not null"`
-	Duration    int       `json:"duration" gorm:"not null"` // in minutes
-	MovieID     uint      `json:"movie_id"`
-	Date        time.Time `json:"date" gorm:"not null"`
-	MovieFormat string    `json:"movie_format" gorm:"not null"` // movie format (e.g., 2D, 3D)
-	VenueID     uint      `json:"venue_id"`
+	StartTime          time.Time      `json:"start_time" gorm:"not null"`
+	EndTime            time.Time      `json:"end_time" gorm:"not null"`
+	Duration           int            `json:"duration" gorm:"not null"` // in minutes
+	MovieID            uint           `json:"movie_id"`
+	Date               time.Time      `json:"date" gorm:"not null"`
+	MovieFormat        string         `json:"movie_format" gorm:"not null"` // movie format (e.g., 2D, 3D)
+	VenueID            uint           `json:"venue_id"`
+	ShowScheduleID     *uint          `json:"show_schedule_id" gorm:"index"`                // Schedule that generated the show, nil for a show added on its own
+	Status             string         `json:"status" gorm:"not null;default:ON_SALE;index"` // SCHEDULED, ON_SALE, SOLD_OUT, CANCELLED or COMPLETED
+	CancelledAt        *time.Time     `json:"cancelled_at"`
+	CancellationReason string         `json:"cancellation_reason"`
+	Language           string         `json:"language" gorm:"index"` // audio language of the show, one of the languages of the movie
+	Subtitles          pq.StringArray `json:"subtitles" gorm:"type:text[]"`
+}
+
+// ShowSchedule generates the shows of a movie on a screen for the given days and start times
+type ShowSchedule struct {
+	gorm.Model
+	MovieID     uint           `json:"movie_id" gorm:"not null;index"`
+	VenueID     uint           `json:"venue_id" gorm:"not null;index"`
+	MovieFormat string         `json:"movie_format" gorm:"not null"`
+	StartDate   time.Time      `json:"start_date" gorm:"type:date;not null"`
+	EndDate     time.Time      `json:"end_date" gorm:"type:date;not null"`
+	DaysOfWeek  pq.Int32Array  `json:"days_of_week" gorm:"type:integer[];not null"` // 0 is Sunday like time.Weekday
+	StartTimes  pq.StringArray `json:"start_times" gorm:"type:text[];not null"`     // HH:MM in the time zone of the cinema
+	Duration    int            `json:"duration" gorm:"not null"`                    // in minutes
+	Status      string         `json:"status" gorm:"not null;default:ACTIVE"`
+	Language    string         `json:"language"`
+	Subtitles   pq.StringArray `json:"subtitles" gorm:"type:text[]"`
+
+	// Relationships
+	MovieTimeSlots []MovieTimeSlot `json:"movie_time_slots" gorm:"foreignKey:ShowScheduleID"`
 }
 
 // Movie model
@@ -83,22 +205,58 @@ type Movie struct {
 	Reviews         []Review       `json:"reviews" gorm:"foreignKey:MovieID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 }
 
-// Venue model
+// Cinema is a site, e.g. a multiplex, owning one or more screens
+type Cinema struct {
+	gorm.Model
+	Name        string         `json:"name" gorm:"not null" validate:"required"`
+	Address     string         `json:"address" gorm:"not null" validate:"required"`
+	City        string         `json:"city"`
+	RegionCode  string         `json:"region_code" gorm:"index"`
+	Longitude   float64        `json:"longitude" gorm:"not null" validate:"longitude"`
+	Latitude    float64        `json:"latitude" gorm:"not null" validate:"latitude"`
+	Timezone    string         `json:"timezone" gorm:"not null;default:UTC"` // IANA time zone, e.g. Asia/Kolkata
+	Amenities   pq.StringArray `json:"amenities" gorm:"type:text[]"`
+	PhoneNumber string         `json:"phone_number" validate:"omitempty,e164"`
+	Email       string         `json:"email" validate:"omitempty,email"`
+	Website     string         `json:"website" validate:"omitempty,url"`
+
+	// Relationships
+	Screens []Venue `json:"screens" gorm:"foreignKey:CinemaID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+}
+
+// Venue model, a single screen. Screens of the same site belong to a Cinema
 type Venue struct {
 	gorm.Model
+	CinemaID             *uint          `json:"cinema_id" gorm:"uniqueIndex:idx_cinema_screen"`
 	Name                 string         `json:"name" gorm:"not null"`
 	Type                 string         `json:"type" gorm:"not null"`
 	Address              string         `json:"address" gorm:"not null"`
 	Rows                 int            `json:"rows" gorm:"not null"`
 	Columns              int            `json:"columns" gorm:"not null"`
-	ScreenNumber         int            `json:"screen_number" gorm:"not null;unique"`
-	Longitude            float64        `json:"longitude" gorm:"not null"`
+	ScreenNumber         int            `json:"screen_number" gorm:"not null;uniqueIndex:idx_cinema_screen"` // Unique within the cinema
+	Longitude            float64        `json:"longitude" gorm:"not null"`                                   // The indexed location column is generated from the coordinates, see helper.Migrate
 	Latitude             float64        `json:"latitude" gorm:"not null"`
 	MovieFormatSupported pq.StringArray `json:"movie_format_supported" gorm:"type:text[];not null"`
 	LanguagesSupported   pq.StringArray `json:"languages_supported" gorm:"type:text[];not null"`
+	RegionCode           string         `json:"region_code" gorm:"index"`                                                          // City or region the venue belongs to, e.g. MUM
+	SeatHoldMinutes      int            `json:"seat_hold_minutes"`                                                                 // How long seats are held during checkout, 0 uses the server default
+	OpeningTime          string         `json:"opening_time" validate:"omitempty,datetime=15:04" gorm:"not null;default:'09:00'"`  // HH:MM in the time zone of the cinema, the first show starts after it
+	ClosingTime          string         `json:"closing_time" validate:"omitempty,datetime=15:04" gorm:"not null;default:'00:00'"`  // The last show ends before it, at or before the opening time means the next day
+	Timezone             string         `json:"timezone" validate:"omitempty,timezone" gorm:"not null;default:UTC"`                // IANA time zone, dates of shows are local dates in it. A screen of a cinema takes the zone of the cinema
+	ScreenPosition       string         `json:"screen_position" validate:"omitempty,oneof=TOP BOTTOM" gorm:"not null;default:TOP"` // Side of the seat map the screen is on, row 1 is closest to it
+	CurvedRows           bool           `json:"curved_rows"`                                                                       // Rows are drawn as arcs around the screen
+	RowLabels            pq.StringArray `json:"row_labels" gorm:"type:text[]"`                                                     // Labels from row 1 on, rows without one are lettered A, B, C
+	LayoutTemplateID     *uint          `json:"layout_template_id" gorm:"index"`                                                   // Template the seats were last copied from, nil when they were entered by hand
+	LayoutVersion        int            `json:"layout_version"`                                                                    // Version of the template the seats were copied from
+	Currency             string         `json:"currency" validate:"omitempty,iso4217" gorm:"not null;default:INR"`                 // ISO 4217, prices of the seats and rules of the venue are in its minor units
+	PriceRounding        int            `json:"price_rounding" validate:"gte=0"`                                                   // Prices charged are a multiple of it in minor units, e.g. 100 for whole rupees. 0 does not round
+	RoundingMode         string         `json:"rounding_mode" validate:"omitempty,oneof=HALF_UP UP DOWN" gorm:"not null;default:HALF_UP"`
+	ConvenienceFee       int            `json:"convenience_fee" validate:"gte=0"` // Charged per ticket on top of its price, in minor units
 
 	// Relationships
 	Seats          []SeatMatrix    `json:"seats" gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	LayoutCells    []LayoutCell    `json:"layout_cells" gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Sections       []SeatSection   `json:"sections" gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	MovieTimeSlots []MovieTimeSlot `json:"movie_time_slots" gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 	Movies         []Movie         `json:"movies" gorm:"many2many:movie_venues;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 }
@@ -114,9 +272,13 @@ type User struct {
 type Ticket struct {
 	gorm.Model
 	MovieID       uint          `json:"movie_id" gorm:"not null"`
-	BookedSeatsID pq.Int32Array `json:"booked_seats_id" gorm:"not null"`
+	BookedSeatsID pq.Int32Array `json:"booked_seats_id" gorm:"type:integer[];not null"`
 	CustomerID    string        `json:"customer_id" gorm:"not null"`
 	TransactionID string        `json:"transaction_id" gorm:"not null;unique"`
+	RefundStatus  string        `json:"refund_status" gorm:"index"` // PENDING once the show is cancelled, empty while the ticket stands
+	QuoteID       string        `json:"quote_id" gorm:"index"`      // Quote the customer paid, see BookingQuote
+	Amount        int64         `json:"amount"`                     // Grand total of the quote in minor units of Currency
+	Currency      string        `json:"currency"`
 }
 
 type Idempotent struct {
@@ -0,0 +1,59 @@
+package producers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+type Producer struct {
+	conn *amqp091.Channel
+}
+
+func NewProducer(c *amqp091.Channel) Producer {
+	return Producer{
+		conn: c,
+	}
+}
+
+// SeatsReleasedEvent is published whenever held seats of a movie time slot are given back to the inventory
+type SeatsReleasedEvent struct {
+	MovieTimeSlotID uint      `json:"movie_time_slot_id"`
+	BookedSeatsIDs  []uint    `json:"booked_seats_ids"`
+	SeatNumbers     []string  `json:"seat_numbers"`
+	Reason          string    `json:"reason"` // e.g. LOCK_EXPIRED
+	ReleasedAt      time.Time `json:"released_at"`
+}
+
+func (p *Producer) Seats_Released_Producer(event SeatsReleasedEvent) error {
+	q, err := p.conn.QueueDeclare(
+		"seats_released_queue",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(event)
+
+	if err != nil {
+		return err
+	}
+
+	return p.conn.Publish(
+		"",
+		q.Name,
+		false,
+		false,
+		amqp091.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp091.Persistent,
+			Body:         body,
+		},
+	)
+}
@@ -0,0 +1,41 @@
+package producers
+
+import (
+	"encoding/json"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// Send_Mail_Producer queues a mail for Send_Mail_Consumer, which sends it and retries on failure
+func (p *Producer) Send_Mail_Producer(mail helper.SendMailStruct) error {
+	q, err := p.conn.QueueDeclare(
+		"send_mail_queue2",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(mail)
+
+	if err != nil {
+		return err
+	}
+
+	return p.conn.Publish(
+		"",
+		q.Name,
+		false,
+		false,
+		amqp091.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp091.Persistent,
+			Body:         body,
+		},
+	)
+}
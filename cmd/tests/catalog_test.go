@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+)
+
+func TestPageToken(t *testing.T) {
+	t.Run("Page token round trips the last ID", func(t *testing.T) {
+		token := helper.EncodePageToken(42)
+
+		id, err := helper.DecodePageToken(token)
+
+		if err != nil {
+			t.Fatal("error decoding page token", err)
+		}
+
+		if id != 42 {
+			t.Errorf("expected 42, got %d", id)
+		}
+	})
+
+	t.Run("Empty page token is the first page", func(t *testing.T) {
+		id, err := helper.DecodePageToken("")
+
+		if err != nil || id != 0 {
+			t.Errorf("expected first page, got %d %v", id, err)
+		}
+	})
+
+	t.Run("Invalid page token is rejected", func(t *testing.T) {
+		if _, err := helper.DecodePageToken("not a token!"); err == nil {
+			t.Error("expected an error for an invalid page token")
+		}
+	})
+}
+
+func TestCatalog(t *testing.T) {
+	t.Run("Get all movies walks every page once", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		createTestShow(t, m, 1)
+		createTestShow(t, m, 1)
+
+		seen := make(map[uint]bool)
+		pageToken := ""
+
+		for {
+			movies, nextPageToken, status, err := m.GetAllMovies(1, pageToken, "", "")
+
+			if status != 200 || err != nil {
+				t.Fatal("status should be 200", err)
+			}
+
+			for _, movie := range movies {
+				if seen[movie.ID] {
+					t.Fatalf("movie %d returned twice", movie.ID)
+				}
+				seen[movie.ID] = true
+			}
+
+			if nextPageToken == "" {
+				break
+			}
+
+			pageToken = nextPageToken
+		}
+
+		if len(seen) < 2 {
+			t.Errorf("expected at least 2 movies, got %d", len(seen))
+		}
+	})
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestCinemas(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	suffix := time.Now().UnixNano()
+
+	newScreen := func(screenNumber int) models.Venue {
+		return models.Venue{
+			Name:                 fmt.Sprintf("Screen %d", screenNumber),
+			Type:                 "MOVIE",
+			Rows:                 1,
+			Columns:              1,
+			ScreenNumber:         screenNumber,
+			MovieFormatSupported: pq.StringArray{"2D"},
+			LanguagesSupported:   pq.StringArray{"English"},
+		}
+	}
+
+	var cinema models.Cinema
+
+	t.Run("Screens inherit the site of their cinema", func(t *testing.T) {
+		var status int
+		var err error
+
+		cinema, status, err = m.AddCinema(models.Cinema{
+			Name:      fmt.Sprintf("Cinema test %d", suffix),
+			Address:   "1 Multiplex Road",
+			Latitude:  -41,
+			Longitude: -21,
+			Timezone:  "Asia/Kolkata",
+			Amenities: pq.StringArray{"PARKING", "FOOD_COURT"},
+			Screens:   []models.Venue{newScreen(2), newScreen(1)},
+		})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		cinema, status, err = m.GetCinema(cinema.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(cinema.Screens) != 2 || cinema.Screens[0].ScreenNumber != 1 {
+			t.Fatalf("expected 2 screens ordered by screen number, got %v", cinema.Screens)
+		}
+
+		for _, screen := range cinema.Screens {
+			if screen.Address != cinema.Address || screen.Latitude != cinema.Latitude {
+				t.Errorf("screen %d did not inherit the cinema site", screen.ScreenNumber)
+			}
+		}
+
+		if status, _ := m.DeleteCinema(cinema.ID); status != 409 {
+			t.Errorf("expected 409 deleting a cinema with screens, got %d", status)
+		}
+	})
+
+	t.Run("Invalid timezone is rejected", func(t *testing.T) {
+		_, status, _ := m.AddCinema(models.Cinema{
+			Name:     "Cinema with a bad timezone",
+			Address:  "1 Nowhere",
+			Timezone: "Mars/Olympus_Mons",
+		})
+
+		if status != 400 {
+			t.Errorf("expected 400, got %d", status)
+		}
+	})
+
+	t.Run("Legacy venues of the same site are migrated into one cinema", func(t *testing.T) {
+		address := fmt.Sprintf("%d Legacy Street", suffix)
+
+		for i := 1; i <= 2; i++ {
+			venue := newScreen(int((suffix + int64(i)) % 1000000000))
+			venue.Address = address
+			venue.Latitude = -42
+			venue.Longitude = -22
+
+			if err := m.DB.Conn.Create(&venue).Error; err != nil {
+				t.Fatal("error creating venue", err)
+			}
+		}
+
+		created, linked, status, err := m.MigrateVenuesToCinemas()
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if created < 1 || linked < 2 {
+			t.Errorf("expected at least 1 cinema and 2 venues, got %d and %d", created, linked)
+		}
+
+		var venues []models.Venue
+
+		m.DB.Conn.Where("address = ?", address).Find(&venues)
+
+		if len(venues) != 2 || venues[0].CinemaID == nil || venues[1].CinemaID == nil || *venues[0].CinemaID != *venues[1].CinemaID {
+			t.Errorf("expected both venues in the same cinema")
+		}
+	})
+
+	t.Run("Showtimes are grouped by cinema then screen", func(t *testing.T) {
+		if len(cinema.Screens) != 2 {
+			t.Skip("needs the cinema of the first subtest")
+		}
+
+		// Screen 2 is the closest, the standalone venue sits between the two screens
+		venues := []api.NearbyVenue{
+			{Venue: cinema.Screens[1], DistanceKm: 1},
+			{Venue: models.Venue{Name: "Standalone", ScreenNumber: 1}, DistanceKm: 2},
+			{Venue: cinema.Screens[0], DistanceKm: 3},
+		}
+
+		groups, status, err := m.GroupShowtimesByCinema(venues)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(groups) != 2 {
+			t.Fatalf("expected 2 groups, got %d", len(groups))
+		}
+
+		if groups[0].Cinema.ID != cinema.ID || groups[0].DistanceKm != 1 {
+			t.Errorf("expected cinema %d first at 1km", cinema.ID)
+		}
+
+		if len(groups[0].Screens) != 2 || groups[0].Screens[0].Venue.ScreenNumber != 1 {
+			t.Errorf("expected the screens of the cinema ordered by screen number")
+		}
+
+		if groups[1].Cinema.ID != 0 || groups[1].Cinema.Name != "Standalone" {
+			t.Errorf("expected the standalone venue as its own site")
+		}
+	})
+}
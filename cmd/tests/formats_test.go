@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestNormalizeMovieFormat(t *testing.T) {
+	cases := map[string]string{
+		"2D":           api.MovieFormat2D,
+		"TWO_D":        api.MovieFormat2D,
+		"FORMAT_2D":    api.MovieFormat2D,
+		"three_d":      api.MovieFormat3D,
+		"FOUR_D":       api.MovieFormat4DX,
+		"4D":           api.MovieFormat4DX,
+		"FORMAT_IMAX":  api.MovieFormatIMAX,
+		"Dolby Cinema": api.MovieFormatDolby,
+	}
+
+	for format, expected := range cases {
+		if got := api.NormalizeMovieFormat(format); got != expected {
+			t.Errorf("%s: expected %s, got %s", format, expected, got)
+		}
+	}
+}
+
+func TestShowLanguages(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	movie := models.Movie{Duration: 120, Language: pq.StringArray{"Hindi", "Tamil", "English"}}
+	venue := models.Venue{MovieFormatSupported: pq.StringArray{"2D"}, LanguagesSupported: pq.StringArray{"English", "Tamil"}}
+
+	show := func(language string, subtitles ...string) models.MovieTimeSlot {
+		return models.MovieTimeSlot{StartTime: start, EndTime: start.Add(120 * time.Minute), MovieFormat: "2D", Language: language, Subtitles: subtitles}
+	}
+
+	t.Run("Missing language is the first one the screen supports", func(t *testing.T) {
+		slot := show("")
+
+		if err := api.ValidateShowTimes(&slot, movie, venue); err != nil || slot.Language != "Tamil" {
+			t.Errorf("expected a show in Tamil, got %q %v", slot.Language, err)
+		}
+	})
+
+	t.Run("Dubbed show with subtitles", func(t *testing.T) {
+		slot := show("tamil", "English")
+
+		if err := api.ValidateShowTimes(&slot, movie, venue); err != nil {
+			t.Error("expected a valid show", err)
+		}
+	})
+
+	cases := []struct {
+		name string
+		slot models.MovieTimeSlot
+	}{
+		{"Language the screen does not support", show("Hindi")},
+		{"Language the movie is not dubbed in", show("French")},
+		{"Subtitles the screen does not support", show("English", "Hindi")},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if reason := conflictReason(t, api.ValidateShowTimes(&c.slot, movie, venue)); reason != api.ScheduleConflictLanguage {
+				t.Errorf("expected %s, got %s", api.ScheduleConflictLanguage, reason)
+			}
+		})
+	}
+}
+
+func TestShowtimeFormatFilter(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 1)
+
+	if slot.Language != "English" {
+		t.Errorf("expected the show to default to English, got %q", slot.Language)
+	}
+
+	shows, status, err := m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "TWO_D", "")
+
+	if status != 200 || err != nil || len(shows) != 1 {
+		t.Errorf("expected the 2D show by its old format name, got %d %d %v", len(shows), status, err)
+	}
+
+	shows, status, err = m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "IMAX", "")
+
+	if status != 200 || err != nil || len(shows) != 0 {
+		t.Errorf("expected no IMAX shows, got %d %d %v", len(shows), status, err)
+	}
+}
@@ -0,0 +1,247 @@
+package tests
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestNearbyRadiusKmFromEnv(t *testing.T) {
+	t.Run("Unset radius uses the default", func(t *testing.T) {
+		t.Setenv("NEARBY_RADIUS_KM", "")
+
+		if radius := api.NearbyRadiusKmFromEnv(); radius != api.DefaultNearbyRadiusKm {
+			t.Errorf("expected %d, got %g", api.DefaultNearbyRadiusKm, radius)
+		}
+	})
+
+	t.Run("Radius is read from the environment", func(t *testing.T) {
+		t.Setenv("NEARBY_RADIUS_KM", "12.5")
+
+		if radius := api.NearbyRadiusKmFromEnv(); radius != 12.5 {
+			t.Errorf("expected 12.5, got %g", radius)
+		}
+	})
+
+	t.Run("Invalid radius uses the default", func(t *testing.T) {
+		t.Setenv("NEARBY_RADIUS_KM", "-3")
+
+		if radius := api.NearbyRadiusKmFromEnv(); radius != api.DefaultNearbyRadiusKm {
+			t.Errorf("expected %d, got %g", api.DefaultNearbyRadiusKm, radius)
+		}
+	})
+}
+
+func TestLocation(t *testing.T) {
+	t.Run("Radius is converted to kilometers", func(t *testing.T) {
+		cases := []struct {
+			radius float64
+			unit   string
+			km     float64
+		}{
+			{10, "", 10},
+			{10, api.DistanceUnitKilometers, 10},
+			{10, api.DistanceUnitMiles, 16.09344},
+			{2500, api.DistanceUnitMeters, 2.5},
+		}
+
+		for _, c := range cases {
+			km, err := api.RadiusToKm(c.radius, c.unit)
+
+			if err != nil || math.Abs(km-c.km) > 1e-9 {
+				t.Errorf("%g %s: expected %gkm, got %g %v", c.radius, c.unit, c.km, km, err)
+			}
+		}
+	})
+
+	t.Run("Unknown unit is rejected", func(t *testing.T) {
+		if _, err := api.RadiusToKm(1, "FURLONGS"); err == nil {
+			t.Error("expected an error for an unknown unit")
+		}
+	})
+
+	t.Run("Out of range values are rejected", func(t *testing.T) {
+		invalid := []api.Location{
+			{Point: api.GeoPoint{Latitude: 91}},
+			{Point: api.GeoPoint{Longitude: -181}},
+			{Point: api.GeoPoint{Latitude: 10}, RadiusKm: -1},
+			{Point: api.GeoPoint{Latitude: 10}, RadiusKm: api.MaxNearbyRadiusKm + 1},
+		}
+
+		for _, location := range invalid {
+			if err := location.Validate(); err == nil {
+				t.Errorf("expected %+v to be invalid", location)
+			}
+		}
+	})
+
+	t.Run("Fractional coordinates are valid", func(t *testing.T) {
+		location := api.Location{Point: api.GeoPoint{Latitude: 19.0760, Longitude: 72.8777}, RadiusKm: 12.5}
+
+		if err := location.Validate(); err != nil {
+			t.Error("expected a valid location", err)
+		}
+	})
+}
+
+func TestNearbyVenues(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	suffix := time.Now().UnixNano()
+
+	movie := models.Movie{
+		Title:           fmt.Sprintf("Geo test %d", suffix),
+		Description:     "Movie used by the geo tests",
+		Duration:        120,
+		Language:        pq.StringArray{"English"},
+		Type:            pq.StringArray{"Drama"},
+		ReleaseDate:     time.Now().Add(-24 * time.Hour),
+		MovieResolution: pq.StringArray{"2D"},
+	}
+
+	if err := m.DB.Conn.Create(&movie).Error; err != nil {
+		t.Fatal("error creating movie", err)
+	}
+
+	// A point in the middle of the Atlantic so other test data is never close
+	customer := api.GeoPoint{Latitude: -40, Longitude: -20}
+
+	regionCode := fmt.Sprintf("GEO%d", suffix)
+
+	// About 1.1km, 5.5km and 110km north of the customer
+	offsets := []float64{0.01, 0.05, 1}
+	venues := make([]models.Venue, 0, len(offsets))
+
+	for i, offset := range offsets {
+		venue := models.Venue{
+			Name:                 fmt.Sprintf("Geo test venue %d", i),
+			Type:                 "MOVIE",
+			Address:              "1 Test Street",
+			Rows:                 1,
+			Columns:              1,
+			ScreenNumber:         int((suffix + int64(i)) % 1000000000),
+			Latitude:             customer.Latitude + offset,
+			Longitude:            customer.Longitude,
+			MovieFormatSupported: pq.StringArray{"2D"},
+			LanguagesSupported:   pq.StringArray{"English"},
+			RegionCode:           regionCode,
+			Movies:               []models.Movie{movie},
+		}
+
+		if err := m.DB.Conn.Create(&venue).Error; err != nil {
+			t.Fatal("error creating venue", err)
+		}
+
+		venues = append(venues, venue)
+	}
+
+	start := time.Now().Add(time.Hour).Truncate(time.Minute)
+
+	// Added from the farthest venue so the result order does not follow insertion
+	for i := len(venues) - 1; i >= 0; i-- {
+		_, status, err := m.AddMovieTimeSlot(models.MovieTimeSlot{
+			StartTime:   start,
+			EndTime:     start.Add(120 * time.Minute),
+			Duration:    120,
+			MovieID:     movie.ID,
+			Date:        start,
+			MovieFormat: "2D",
+			VenueID:     venues[i].ID,
+		})
+
+		if status != 200 || err != nil {
+			t.Fatal("error adding movie time slot", err)
+		}
+	}
+
+	t.Run("Nearby venues are sorted by distance within the radius", func(t *testing.T) {
+		nearby, status, err := m.NearbyVenues(api.Location{Point: customer, RadiusKm: 10})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(nearby) != 2 {
+			t.Fatalf("expected 2 venues within 10km, got %d", len(nearby))
+		}
+
+		if nearby[0].Venue.ID != venues[0].ID || nearby[1].Venue.ID != venues[1].ID {
+			t.Errorf("venues are not sorted by distance")
+		}
+
+		if nearby[0].DistanceKm < 1 || nearby[0].DistanceKm > 1.2 {
+			t.Errorf("expected about 1.1km, got %g", nearby[0].DistanceKm)
+		}
+	})
+
+	t.Run("Region code is an alternative to coordinates", func(t *testing.T) {
+		nearby, status, err := m.NearbyVenues(api.Location{RegionCode: regionCode})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(nearby) != len(venues) {
+			t.Errorf("expected %d venues in the region, got %d", len(venues), len(nearby))
+		}
+	})
+
+	t.Run("Missing location is rejected", func(t *testing.T) {
+		if _, status, _ := m.NearbyVenues(api.Location{}); status != 400 {
+			t.Errorf("expected 400, got %d", status)
+		}
+	})
+
+	t.Run("Time slots follow the distance of their venue", func(t *testing.T) {
+		date := start.Format(time.DateOnly)
+
+		nearby, slots, status, err := m.GetMovieTimeSlots(date, date, movie.ID, api.Location{Point: customer, RadiusKm: 200}, "", "")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(nearby) != 3 || len(slots) != 3 {
+			t.Fatalf("expected 3 venues and 3 slots, got %d and %d", len(nearby), len(slots))
+		}
+
+		for i := range venues {
+			if slots[i].VenueID != venues[i].ID {
+				t.Errorf("slot %d belongs to venue %d, expected %d", i, slots[i].VenueID, venues[i].ID)
+			}
+		}
+	})
+
+	t.Run("Current movies report the closest venue", func(t *testing.T) {
+		movies, status, err := m.GetCurrentMovies(api.Location{Point: customer})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(movies) != 1 || movies[0].Movie.ID != movie.ID {
+			t.Fatalf("expected only movie %d, got %d movies", movie.ID, len(movies))
+		}
+
+		if movies[0].VenueID != venues[0].ID {
+			t.Errorf("expected closest venue %d, got %d", venues[0].ID, movies[0].VenueID)
+		}
+	})
+
+	t.Run("Now playing movies report the closest venue", func(t *testing.T) {
+		movies, status, err := m.GetNowPlayingMovies(api.Location{Point: customer})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(movies) != 1 || movies[0].VenueID != venues[0].ID {
+			t.Errorf("expected movie %d at venue %d, got %v", movie.ID, venues[0].ID, movies)
+		}
+	})
+}
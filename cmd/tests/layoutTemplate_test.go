@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestLayoutTemplates(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	suffix := time.Now().UnixNano()
+
+	newVenue := func(name string) models.Venue {
+		venue := models.Venue{
+			Name:                 name,
+			Type:                 "MOVIE",
+			Address:              "1 Test Street",
+			ScreenNumber:         int(time.Now().UnixNano() % 1000000000),
+			MovieFormatSupported: pq.StringArray{"2D"},
+			LanguagesSupported:   pq.StringArray{"English"},
+		}
+
+		if err := m.DB.Conn.Create(&venue).Error; err != nil {
+			t.Fatal("error creating venue", err)
+		}
+
+		return venue
+	}
+
+	template, status, err := m.CreateLayoutTemplate(fmt.Sprintf("Template %d", suffix), "Three rows", testSeatGrid, api.SeatGridText, testSeatPrices, "")
+
+	if status != 200 || err != nil {
+		t.Fatal("creating a template should be 200", err)
+	}
+
+	if _, status, _ := m.CreateLayoutTemplate(template.Name, "", testSeatGrid, api.SeatGridText, testSeatPrices, ""); status != 409 {
+		t.Errorf("expected 409 for a duplicate template name, got %d", status)
+	}
+
+	venue := newVenue("Layout template test venue")
+
+	t.Run("Apply gives the venue the seats of the template", func(t *testing.T) {
+		layout, status, err := m.ApplyLayoutTemplate(template.ID, 0, venue.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("applying should be 200", err)
+		}
+
+		if layout.Venue.LayoutTemplateID == nil || *layout.Venue.LayoutTemplateID != template.ID || layout.Venue.LayoutVersion != 1 {
+			t.Errorf("expected the venue to be on version 1 of template %d, got %v %d", template.ID, layout.Venue.LayoutTemplateID, layout.Venue.LayoutVersion)
+		}
+
+		data, _, _, _, _ := m.ExportSeatGrid(venue.ID, api.SeatGridText)
+
+		if data != testSeatGrid {
+			t.Errorf("expected the template grid, got\n%s", data)
+		}
+	})
+
+	t.Run("A new version leaves the venue alone until it is applied", func(t *testing.T) {
+		updated := "A: V V | V V V\nB: N N | N N N\nC: W _ | N N _\n"
+
+		template, status, err = m.UpdateLayoutTemplate(template.ID, updated, api.SeatGridText, testSeatPrices, "")
+
+		if status != 200 || err != nil || template.Version != 2 {
+			t.Fatal("updating should be 200 and give version 2", err)
+		}
+
+		before, _, _ := m.GetSeatMatrix(int(venue.ID))
+
+		if data, _, _, _, _ := m.ExportSeatGrid(venue.ID, api.SeatGridText); data != testSeatGrid {
+			t.Errorf("expected the venue to keep version 1, got\n%s", data)
+		}
+
+		if _, status, err := m.ApplyLayoutTemplate(template.ID, 2, venue.ID); status != 200 || err != nil {
+			t.Fatal("applying version 2 should be 200", err)
+		}
+
+		after, _, _ := m.GetSeatMatrix(int(venue.ID))
+
+		if len(after) != len(before)+1 {
+			t.Errorf("expected one more seat, got %d then %d", len(before), len(after))
+		}
+
+		ids := make(map[string]uint, len(before))
+
+		for _, seat := range before {
+			ids[seat.SeatNumber] = seat.ID
+		}
+
+		// A seat that stays where it was keeps its ID for the shows that sold it
+		for _, seat := range after {
+			if id, ok := ids[seat.SeatNumber]; ok && id != seat.ID {
+				t.Errorf("expected seat %s to keep ID %d, got %d", seat.SeatNumber, id, seat.ID)
+			}
+		}
+
+		got, _, _ := m.GetLayoutTemplate(template.ID)
+
+		if len(got.Versions) != 2 || got.Versions[0].Grid != testSeatGrid {
+			t.Errorf("expected version 1 to be kept as it was, got %d versions", len(got.Versions))
+		}
+	})
+
+	t.Run("Clone copies the seats to another venue", func(t *testing.T) {
+		target := newVenue("Clone target test venue")
+
+		layout, status, err := m.CloneSeatLayout(venue.ID, target.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("cloning should be 200", err)
+		}
+
+		if layout.Venue.LayoutVersion != 2 {
+			t.Errorf("expected the clone to keep the template version, got %d", layout.Venue.LayoutVersion)
+		}
+
+		source, _, _, _, _ := m.ExportSeatGrid(venue.ID, api.SeatGridText)
+		data, _, _, _, _ := m.ExportSeatGrid(target.ID, api.SeatGridText)
+
+		if data != source {
+			t.Errorf("expected the same grid as the source\n%s\ngot\n%s", source, data)
+		}
+	})
+
+	t.Run("Venues charging in another currency are refused", func(t *testing.T) {
+		dollars := newVenue("Dollar test venue")
+
+		if err := m.DB.Conn.Model(&dollars).Update("currency", "USD").Error; err != nil {
+			t.Fatal("error updating venue", err)
+		}
+
+		if _, status, _ := m.ApplyLayoutTemplate(template.ID, 0, dollars.ID); status != 409 {
+			t.Errorf("expected 409 applying an INR template to a USD venue, got %d", status)
+		}
+
+		if _, status, _ := m.CloneSeatLayout(venue.ID, dollars.ID); status != 409 {
+			t.Errorf("expected 409 cloning INR seats to a USD venue, got %d", status)
+		}
+	})
+
+	t.Run("Venues with shows on sale are refused", func(t *testing.T) {
+		slot, _ := createTestShow(t, m, 2)
+
+		if _, status, _ := m.ApplyLayoutTemplate(template.ID, 0, slot.VenueID); status != 409 {
+			t.Errorf("expected 409 applying to a venue with a show on sale, got %d", status)
+		}
+
+		if _, status, _ := m.CloneSeatLayout(venue.ID, slot.VenueID); status != 409 {
+			t.Errorf("expected 409 cloning to a venue with a show on sale, got %d", status)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+)
+
+func TestParseCurrency(t *testing.T) {
+	if currency, err := api.ParseCurrency(" usd "); err != nil || currency != "USD" {
+		t.Errorf("expected USD, got %s %v", currency, err)
+	}
+
+	if currency, err := api.ParseCurrency(""); err != nil || currency != api.DefaultCurrency {
+		t.Errorf("expected the default currency, got %s %v", currency, err)
+	}
+
+	if _, err := api.ParseCurrency("RUPEES"); err == nil {
+		t.Error("expected an error for an invalid currency")
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	cases := map[string]api.Money{
+		"INR 250.50":  {Amount: 25050, Currency: "INR"},
+		"INR -0.05":   {Amount: -5, Currency: "INR"},
+		"JPY 1800":    {Amount: 1800, Currency: "JPY"},
+		"KWD 3.250":   {Amount: 3250, Currency: "KWD"},
+		"USD 1200.00": {Amount: 120000, Currency: "USD"},
+	}
+
+	for want, money := range cases {
+		if got := money.String(); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	}
+}
+
+func TestRounding(t *testing.T) {
+	cases := []struct {
+		rounding api.Rounding
+		amount   int64
+		want     int64
+	}{
+		{api.Rounding{}, 25050, 25050},
+		{api.Rounding{Step: 100}, 25050, 25100},
+		{api.Rounding{Step: 100}, 25049, 25000},
+		{api.Rounding{Step: 100, Mode: api.RoundHalfUp}, -150, -100},
+		{api.Rounding{Step: 100, Mode: api.RoundUp}, 25001, 25100},
+		{api.Rounding{Step: 100, Mode: api.RoundUp}, 25000, 25000},
+		{api.Rounding{Step: 500, Mode: api.RoundDown}, 25499, 25000},
+	}
+
+	for _, c := range cases {
+		if got := c.rounding.Round(c.amount); got != c.want {
+			t.Errorf("expected %d rounded with %+v to be %d, got %d", c.amount, c.rounding, c.want, got)
+		}
+	}
+}
@@ -679,7 +679,7 @@ func TestMovieDB(t *testing.T) {
 		}
 
 		st := time.Date(releaseDate.Year(), releaseDate.Month(), releaseDate.Day(), 18, 30, 0, 0, time.UTC)
-		et := st.Add(2*time.Hour - 30*time.Minute) // 2 hours duration
+		et := st.Add(111 * time.Minute) // Runtime of the movie, shorter shows are rejected
 
 		_, status, err := m.AddMovie(movie, []models.MovieTimeSlot{
 			{
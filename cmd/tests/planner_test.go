@@ -0,0 +1,246 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+func plannerMovie(id uint, duration int, resolution ...string) models.Movie {
+	movie := models.Movie{Duration: duration, MovieResolution: resolution}
+	movie.ID = id
+	return movie
+}
+
+func plannerScreen(id uint, opening, closing string, formats ...string) api.PlanScreen {
+	venue := models.Venue{OpeningTime: opening, ClosingTime: closing, MovieFormatSupported: formats}
+	venue.ID = id
+	return api.PlanScreen{Venue: venue}
+}
+
+func TestOpeningHours(t *testing.T) {
+	date := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	opensAt, closesAt, err := api.OpeningHours(models.Venue{}, date, time.UTC)
+
+	if err != nil || opensAt.Hour() != 9 || !closesAt.Equal(time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 09:00 to midnight by default, got %s to %s %v", opensAt, closesAt, err)
+	}
+
+	_, closesAt, _ = api.OpeningHours(models.Venue{OpeningTime: "10:00", ClosingTime: "01:30"}, date, time.UTC)
+
+	if !closesAt.Equal(time.Date(2025, 1, 11, 1, 30, 0, 0, time.UTC)) {
+		t.Errorf("closing before opening should be on the next day, got %s", closesAt)
+	}
+
+	if _, _, err := api.OpeningHours(models.Venue{OpeningTime: "9am"}, date, time.UTC); err == nil {
+		t.Error("expected an error for an invalid opening time")
+	}
+}
+
+func TestPlanWeek(t *testing.T) {
+	weekStart := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	buffer := 15 * time.Minute
+
+	t.Run("Shows fit the opening hours and never clash", func(t *testing.T) {
+		plan, err := api.PlanWeek(api.WeeklyPlanRequest{
+			WeekStart:        weekStart,
+			Days:             1,
+			Movies:           []api.PlannedMovie{{Movie: plannerMovie(1, 118, "2D")}},
+			Screens:          []api.PlanScreen{plannerScreen(1, "10:00", "23:00", "2D")},
+			TurnaroundBuffer: buffer,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 10:00, 12:15, 14:30, 16:45 and 19:00, the next one would end after 23:00
+		if len(plan.MovieTimeSlots) != 5 {
+			t.Fatalf("expected 5 shows, got %d", len(plan.MovieTimeSlots))
+		}
+
+		for i, show := range plan.MovieTimeSlots {
+			if show.StartTime.Minute()%5 != 0 {
+				t.Errorf("show %d should start on a 5 minute mark, got %s", i, show.StartTime)
+			}
+
+			if i > 0 && show.StartTime.Before(plan.MovieTimeSlots[i-1].EndTime.Add(buffer)) {
+				t.Errorf("show %d starts within the turnaround buffer of the previous show", i)
+			}
+		}
+
+		if last := plan.MovieTimeSlots[4]; last.EndTime.After(time.Date(2025, 1, 6, 23, 0, 0, 0, time.UTC)) {
+			t.Errorf("last show should end before closing, got %s", last.EndTime)
+		}
+
+		if use := plan.ScreenUse[1]; use < 0.7 || use > 1 {
+			t.Errorf("expected the screen to be well used, got %f", use)
+		}
+	})
+
+	t.Run("Target shows are spread over the week", func(t *testing.T) {
+		plan, err := api.PlanWeek(api.WeeklyPlanRequest{
+			WeekStart: weekStart,
+			Movies: []api.PlannedMovie{
+				{Movie: plannerMovie(1, 150, "2D"), TargetShows: 7, Priority: 5},
+				{Movie: plannerMovie(2, 100, "2D")},
+			},
+			Screens:          []api.PlanScreen{plannerScreen(1, "", "", "2D")},
+			TurnaroundBuffer: buffer,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		perDay := make(map[int]int)
+
+		for _, show := range plan.MovieTimeSlots {
+			if show.MovieID == 1 {
+				perDay[show.Date.Day()]++
+			}
+		}
+
+		if plan.PlannedShows[1] != 7 || len(perDay) != 7 {
+			t.Errorf("expected one show of the target movie each day, got %v", perDay)
+		}
+
+		if plan.PlannedShows[2] == 0 || len(plan.MissingShows) != 0 {
+			t.Errorf("expected the other movie to fill the screen, got %v missing %v", plan.PlannedShows, plan.MissingShows)
+		}
+	})
+
+	t.Run("Existing shows, formats and release dates are respected", func(t *testing.T) {
+		existing := models.MovieTimeSlot{
+			StartTime: time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2025, 1, 6, 14, 0, 0, 0, time.UTC),
+			VenueID:   1,
+		}
+
+		screen := plannerScreen(1, "09:00", "00:00", "2D", "IMAX")
+		screen.Shows = []models.MovieTimeSlot{existing}
+
+		unreleased := plannerMovie(3, 90, "2D")
+		unreleased.ReleaseDate = weekStart.AddDate(0, 0, 5)
+
+		plan, err := api.PlanWeek(api.WeeklyPlanRequest{
+			WeekStart: weekStart,
+			Days:      1,
+			Movies: []api.PlannedMovie{
+				{Movie: plannerMovie(1, 120, "3D")},
+				{Movie: plannerMovie(2, 120, "2D", "IMAX"), MovieFormat: "IMAX"},
+				{Movie: unreleased},
+			},
+			Screens:          []api.PlanScreen{screen},
+			TurnaroundBuffer: buffer,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, show := range plan.MovieTimeSlots {
+			if show.MovieID != 2 || show.MovieFormat != "IMAX" {
+				t.Errorf("only the IMAX movie can be shown, got movie %d in %s", show.MovieID, show.MovieFormat)
+			}
+		}
+
+		if err := api.FindScheduleConflict(existing, plan.MovieTimeSlots, buffer); err != nil {
+			t.Error("planned show clashes with the existing show", err)
+		}
+	})
+
+	t.Run("No show before the release of a movie at a screen closing at midnight", func(t *testing.T) {
+		releasing := plannerMovie(1, 120, "2D")
+		releasing.ReleaseDate = weekStart.AddDate(0, 0, 1)
+
+		plan, err := api.PlanWeek(api.WeeklyPlanRequest{
+			WeekStart:        weekStart,
+			Days:             2,
+			Movies:           []api.PlannedMovie{{Movie: releasing}},
+			Screens:          []api.PlanScreen{plannerScreen(1, "10:00", "00:00", "2D")},
+			TurnaroundBuffer: buffer,
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(plan.MovieTimeSlots) == 0 {
+			t.Fatal("expected shows on the release day")
+		}
+
+		for _, show := range plan.MovieTimeSlots {
+			if show.StartTime.Before(releasing.ReleaseDate) {
+				t.Errorf("show at %s is before the release on %s", show.StartTime, releasing.ReleaseDate)
+			}
+		}
+	})
+
+	cases := []struct {
+		name    string
+		request api.WeeklyPlanRequest
+	}{
+		{"No movies", api.WeeklyPlanRequest{Screens: []api.PlanScreen{plannerScreen(1, "", "", "2D")}}},
+		{"No screens", api.WeeklyPlanRequest{Movies: []api.PlannedMovie{{Movie: plannerMovie(1, 120, "2D")}}}},
+		{"No runtime", api.WeeklyPlanRequest{Movies: []api.PlannedMovie{{Movie: plannerMovie(1, 0, "2D")}}, Screens: []api.PlanScreen{plannerScreen(1, "", "", "2D")}}},
+		{"Too many days", api.WeeklyPlanRequest{Days: 60, Movies: []api.PlannedMovie{{Movie: plannerMovie(1, 120, "2D")}}, Screens: []api.PlanScreen{plannerScreen(1, "", "", "2D")}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := api.PlanWeek(c.request); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestPlanProgramme(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 1)
+
+	weekStart := slot.StartTime.AddDate(0, 0, 1)
+	movies := []api.PlannedMovie{{TargetShows: 3}}
+	movies[0].Movie.ID = slot.MovieID
+
+	countShows := func() int64 {
+		var count int64
+		m.DB.Conn.Model(&models.MovieTimeSlot{}).Where("venue_id = ?", slot.VenueID).Count(&count)
+		return count
+	}
+
+	t.Run("Preview does not write", func(t *testing.T) {
+		plan, status, err := m.PlanProgramme(weekStart, 3, []uint{slot.VenueID}, movies, false)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(plan.MovieTimeSlots) != 3 || countShows() != 1 {
+			t.Errorf("expected 3 planned shows and none written, got %d planned", len(plan.MovieTimeSlots))
+		}
+	})
+
+	t.Run("Commit writes the plan", func(t *testing.T) {
+		plan, status, err := m.PlanProgramme(weekStart, 3, []uint{slot.VenueID}, movies, true)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if countShows() != 4 || plan.MovieTimeSlots[0].ID == 0 {
+			t.Errorf("expected the 3 planned shows to be written, got %d shows", countShows())
+		}
+	})
+
+	t.Run("Unknown venue", func(t *testing.T) {
+		if _, status, _ := m.PlanProgramme(weekStart, 1, []uint{0}, movies, false); status != 404 {
+			t.Errorf("expected 404, got %d", status)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestPriceSeat(t *testing.T) {
+	venueID := uint(1)
+	showID := uint(9)
+	galaID := uint(10)
+
+	rule := func(id uint, r models.PriceRule) models.PriceRule {
+		r.ID = id
+		return r
+	}
+
+	rules := []models.PriceRule{
+		rule(1, models.PriceRule{Name: "VIP", Kind: api.PriceRulePrice, Amount: 400, SeatType: "VIP"}),
+		rule(2, models.PriceRule{Name: "Weekend", Kind: api.PriceRulePrice, Amount: 300, DaysOfWeek: pq.Int32Array{0, 6}}),
+		rule(3, models.PriceRule{Name: "Weekend VIP", Kind: api.PriceRulePrice, Amount: 500, SeatType: "VIP", DaysOfWeek: pq.Int32Array{0, 6}}),
+		rule(4, models.PriceRule{Name: "IMAX", Kind: api.PriceRuleSurcharge, Amount: 100, MovieFormat: "IMAX"}),
+		rule(5, models.PriceRule{Name: "Matinee", Kind: api.PriceRuleSurcharge, Amount: -50, ToTime: "12:00"}),
+		rule(6, models.PriceRule{Name: "Premiere", Kind: api.PriceRulePrice, Amount: 900, MovieTimeSlotID: &showID, VenueID: &venueID}),
+		rule(7, models.PriceRule{Name: "Dollar seats", Kind: api.PriceRulePrice, Amount: 5, Currency: "USD"}),
+		rule(8, models.PriceRule{Name: "Gala VIP", Kind: api.PriceRulePrice, Amount: 1500, SeatType: "VIP", MovieTimeSlotID: &galaID}),
+		rule(9, models.PriceRule{Name: "Gala", Kind: api.PriceRulePrice, Amount: 1000, MovieTimeSlotID: &galaID}),
+	}
+
+	inr := api.VenuePricing{Location: time.UTC, Currency: "INR"}
+
+	normal := models.SeatMatrix{Type: "NORMAL", Price: 200}
+	vip := models.SeatMatrix{Type: "VIP", Price: 250}
+
+	// 10 January 2025 is a Friday
+	show := func(id uint, start time.Time, format string) models.MovieTimeSlot {
+		slot := models.MovieTimeSlot{VenueID: venueID, StartTime: start, EndTime: start.Add(2 * time.Hour), MovieFormat: format}
+		slot.ID = id
+		return slot
+	}
+
+	friday := time.Date(2025, 1, 10, 19, 0, 0, 0, time.UTC)
+	saturdayMorning := time.Date(2025, 1, 11, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name  string
+		seat  models.SeatMatrix
+		slot  models.MovieTimeSlot
+		total int
+	}{
+		{"Seat price without a rule", normal, show(1, friday, "2D"), 200},
+		{"Seat type tier", vip, show(1, friday, "2D"), 400},
+		{"Day of week", normal, show(1, saturdayMorning.Add(8*time.Hour), "2D"), 300},
+		{"Seat type beats day of week", vip, show(1, saturdayMorning.Add(8*time.Hour), "2D"), 500},
+		{"Surcharges add up", vip, show(1, saturdayMorning, "IMAX"), 550},
+		{"Format surcharge", normal, show(1, friday, "FORMAT_IMAX"), 300},
+		{"Show beats every other rule", vip, show(showID, friday, "2D"), 900},
+		{"Show price is flat across seat types", normal, show(showID, friday, "2D"), 900},
+		{"Seat type rule of the show keeps a tier", vip, show(galaID, friday, "2D"), 1500},
+		{"Other seat types take the show price", normal, show(galaID, friday, "2D"), 1000},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if price := api.PriceSeat(c.seat, c.slot, inr, rules); price.Total != (api.Money{Amount: int64(c.total), Currency: "INR"}) {
+				t.Errorf("expected %d, got %+v", c.total, price)
+			}
+		})
+	}
+
+	t.Run("Only rules in the currency of the venue apply", func(t *testing.T) {
+		usd := api.VenuePricing{Location: time.UTC, Currency: "USD"}
+
+		if price := api.PriceSeat(vip, show(1, friday, "2D"), usd, rules); price.Total != (api.Money{Amount: 5, Currency: "USD"}) {
+			t.Errorf("expected USD 0.05, got %+v", price.Total)
+		}
+	})
+
+	t.Run("Totals are rounded the way the venue rounds", func(t *testing.T) {
+		rounded := inr
+		rounded.Rounding = api.Rounding{Step: 100, Mode: api.RoundUp}
+
+		if price := api.PriceSeat(vip, show(1, saturdayMorning, "IMAX"), rounded, rules); price.Total.Amount != 600 || price.Base.Amount != 500 {
+			t.Errorf("expected 550 rounded up to 600, got %+v", price)
+		}
+	})
+
+	t.Run("Days and times are local to the venue", func(t *testing.T) {
+		kolkata, err := time.LoadLocation("Asia/Kolkata")
+
+		if err != nil {
+			t.Skip("time zone data not available", err)
+		}
+
+		// 20:00 UTC on Friday is 01:30 on Saturday in Kolkata
+		if price := api.PriceSeat(normal, show(1, friday.Add(time.Hour), "2D"), api.VenuePricing{Location: kolkata, Currency: "INR"}, rules); price.Total.Amount != 250 {
+			t.Errorf("expected the Saturday matinee price 250, got %+v", price)
+		}
+	})
+}
+
+func TestValidatePriceRule(t *testing.T) {
+	valid := models.PriceRule{Name: " IMAX ", Kind: "surcharge", Amount: 100, MovieFormat: "FORMAT_IMAX", SeatType: "vip", Currency: "inr"}
+
+	if err := api.ValidatePriceRule(&valid); err != nil || valid.Kind != api.PriceRuleSurcharge || valid.MovieFormat != "IMAX" || valid.SeatType != "VIP" || valid.Currency != "INR" {
+		t.Errorf("expected a normalized rule, got %+v %v", valid, err)
+	}
+
+	invalid := []models.PriceRule{
+		{Kind: api.PriceRulePrice, Amount: 100},
+		{Name: "Free", Kind: "GIFT", Amount: 0},
+		{Name: "Negative", Kind: api.PriceRulePrice, Amount: -1},
+		{Name: "Someday", Kind: api.PriceRulePrice, Amount: 100, DaysOfWeek: pq.Int32Array{7}},
+		{Name: "Late", Kind: api.PriceRuleSurcharge, Amount: 50, FromTime: "25:00"},
+		{Name: "Gold", Kind: api.PriceRulePrice, Amount: 100, Currency: "GOLD"},
+	}
+
+	for _, rule := range invalid {
+		if err := api.ValidatePriceRule(&rule); err == nil {
+			t.Errorf("expected an error for %+v", rule)
+		}
+	}
+}
+
+func TestPriceRulesQuote(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, bookedSeats := createTestShow(t, m, 1)
+
+	if _, status, err := m.AddPriceRule(models.PriceRule{Name: "Premiere", Kind: api.PriceRulePrice, Amount: 750, MovieTimeSlotID: &slot.ID}); status != 200 || err != nil {
+		t.Fatal("adding a price rule should be 200", err)
+	}
+
+	if _, status, _ := m.AddPriceRule(models.PriceRule{Name: "Dollar premiere", Kind: api.PriceRulePrice, Amount: 10, Currency: "USD", MovieTimeSlotID: &slot.ID}); status != 400 {
+		t.Errorf("expected 400 for a rule in another currency than the venue, got %d", status)
+	}
+
+	if _, status, _ := m.AddPriceRule(models.PriceRule{Name: "Everywhere", Kind: api.PriceRuleSurcharge, Amount: 10}); status != 400 {
+		t.Errorf("expected 400 for a rule for every venue without a currency, got %d", status)
+	}
+
+	layout, _, _ := m.GetShowSeatLayout(slot.ID)
+
+	if len(layout.Seats) != 1 || layout.Seats[0].Price != (api.Money{Amount: 750, Currency: api.DefaultCurrency}) {
+		t.Errorf("expected the seat to cost 750, got %+v", layout.Seats)
+	}
+
+	hold, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, "", 0)
+
+	if status != 200 || err != nil {
+		t.Fatal("locking should be 200", err)
+	}
+
+	valid, quote, err := m.IsValidToCommitSeatsForBooking(int(slot.ID), []int32{int32(bookedSeats[0].SeatMatrixID)}, hold.HoldToken)
+
+	if !valid || err != nil || len(quote.Seats) != 1 || quote.Seats[0].Price != (api.Money{Amount: 750, Currency: api.DefaultCurrency}) {
+		t.Errorf("expected a quote of 750, got %v %+v %v", valid, quote, err)
+	}
+}
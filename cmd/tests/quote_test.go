@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestQuoteTicket(t *testing.T) {
+	inr := func(amount int64) api.Money {
+		return api.Money{Amount: amount, Currency: "INR"}
+	}
+
+	rule := func(id uint, r models.TaxRule) models.TaxRule {
+		r.ID = id
+		return r
+	}
+
+	// GST on tickets is 12% up to ₹100 and 18% above, 18% on the convenience fee
+	gst := []models.TaxRule{
+		rule(1, models.TaxRule{Name: "GST", Currency: "INR", AppliesTo: api.TaxOnTicket, Rate: 1200, MaxAmount: 10000}),
+		rule(2, models.TaxRule{Name: "GST", Currency: "INR", AppliesTo: api.TaxOnTicket, Rate: 1800, MinAmount: 10000}),
+		rule(3, models.TaxRule{Name: "GST on fee", Currency: "INR", AppliesTo: api.TaxOnFee, Rate: 1800}),
+		rule(4, models.TaxRule{Name: "Entertainment tax", Currency: "INR", RegionCodes: pq.StringArray{"MUM"}, AppliesTo: api.TaxOnTicket, Rate: 500}),
+		rule(5, models.TaxRule{Name: "VAT", Currency: "GBP", AppliesTo: api.TaxOnTicket, Rate: 2000, Inclusive: true}),
+	}
+
+	t.Run("Tax bracket by price", func(t *testing.T) {
+		cheap := api.QuoteTicket(api.SeatPrice{Base: inr(10000), Total: inr(10000)}, inr(0), "DEL", gst)
+
+		if len(cheap.Taxes) != 1 || cheap.Taxes[0].TaxRuleID != 1 || cheap.Total != inr(11200) {
+			t.Errorf("expected 12%% GST on ₹100, got %+v", cheap)
+		}
+
+		dear := api.QuoteTicket(api.SeatPrice{Base: inr(25000), Total: inr(25000)}, inr(0), "DEL", gst)
+
+		if len(dear.Taxes) != 1 || dear.Taxes[0].TaxRuleID != 2 || dear.Total != inr(29500) {
+			t.Errorf("expected 18%% GST on ₹250, got %+v", dear)
+		}
+	})
+
+	t.Run("Convenience fee and region", func(t *testing.T) {
+		seat := api.QuoteTicket(api.SeatPrice{Base: inr(20000), Total: inr(20000)}, inr(3000), "mum", gst)
+
+		// 200 + 36 GST + 10 entertainment tax + 30 fee + 5.40 GST on the fee
+		if len(seat.Taxes) != 3 || seat.Total != inr(28140) {
+			t.Errorf("expected 281.40, got %+v", seat)
+		}
+	})
+
+	t.Run("Discounts and rounding", func(t *testing.T) {
+		price := api.SeatPrice{
+			Base:       inr(20000),
+			Surcharges: []api.PriceAdjustment{{RuleID: 7, Name: "IMAX", Amount: inr(5000)}, {RuleID: 8, Name: "Matinee", Amount: inr(-3050)}},
+			Total:      inr(22000),
+		}
+
+		seat := api.QuoteTicket(price, inr(0), "DEL", nil)
+
+		if len(seat.Surcharges) != 1 || len(seat.Discounts) != 1 || seat.Rounding != inr(50) || seat.Total != inr(22000) {
+			t.Errorf("expected a surcharge, a discount and 0.50 of rounding, got %+v", seat)
+		}
+	})
+
+	t.Run("Inclusive taxes are not added", func(t *testing.T) {
+		gbp := api.Money{Amount: 1200, Currency: "GBP"}
+
+		seat := api.QuoteTicket(api.SeatPrice{Base: gbp, Total: gbp}, api.Money{Currency: "GBP"}, "LON", gst)
+
+		if len(seat.Taxes) != 1 || seat.Taxes[0].Amount.Amount != 200 || seat.Total != gbp {
+			t.Errorf("expected £2 of VAT in £12, got %+v", seat)
+		}
+	})
+}
+
+func TestValidateTaxRule(t *testing.T) {
+	valid := models.TaxRule{Name: " GST ", Currency: "inr", AppliesTo: "fee", Rate: 1800, RegionCodes: pq.StringArray{" mum"}}
+
+	if err := api.ValidateTaxRule(&valid); err != nil || valid.Name != "GST" || valid.Currency != "INR" || valid.AppliesTo != api.TaxOnFee || valid.RegionCodes[0] != "MUM" {
+		t.Errorf("expected a normalized rule, got %+v %v", valid, err)
+	}
+
+	invalid := []models.TaxRule{
+		{Currency: "INR", AppliesTo: api.TaxOnTicket, Rate: 1800},
+		{Name: "GST", AppliesTo: api.TaxOnTicket, Rate: 1800},
+		{Name: "GST", Currency: "INR", AppliesTo: "SNACKS", Rate: 1800},
+		{Name: "GST", Currency: "INR", AppliesTo: api.TaxOnTicket, Rate: 0},
+		{Name: "GST", Currency: "INR", AppliesTo: api.TaxOnTicket, Rate: 1800, MinAmount: 10000, MaxAmount: 5000},
+	}
+
+	for _, rule := range invalid {
+		if err := api.ValidateTaxRule(&rule); err == nil {
+			t.Errorf("expected an error for %+v", rule)
+		}
+	}
+}
+
+func TestBookingQuote(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, bookedSeats := createTestShow(t, m, 2)
+
+	// A region of its own keeps the tax rules of other tests away
+	region := fmt.Sprintf("Q%d", time.Now().UnixNano()%1000000)
+
+	if err := m.DB.Conn.Model(&models.Venue{}).Where("id = ?", slot.VenueID).Updates(map[string]any{"region_code": region, "convenience_fee": 3000}).Error; err != nil {
+		t.Fatal("error setting the convenience fee", err)
+	}
+
+	gst, status, err := m.AddTaxRule(models.TaxRule{Name: "GST", Currency: "INR", RegionCodes: pq.StringArray{region}, AppliesTo: api.TaxOnFee, Rate: 1800})
+
+	if status != 200 || err != nil {
+		t.Fatal("adding a tax rule should be 200", err)
+	}
+
+	defer m.DeleteTaxRule(gst.ID)
+
+	hold, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, "", 0)
+
+	if status != 200 || err != nil {
+		t.Fatal("locking should be 200", err)
+	}
+
+	valid, quote, err := m.IsValidToCommitSeatsForBooking(int(slot.ID), []int32{int32(bookedSeats[0].SeatMatrixID)}, hold.HoldToken)
+
+	// 2.00 seat, 30.00 fee and 5.40 GST on the fee
+	if !valid || err != nil || quote.QuoteID == "" || quote.Total != (api.Money{Amount: 3740, Currency: "INR"}) {
+		t.Fatalf("expected a quote of 37.40, got %v %+v %v", valid, quote, err)
+	}
+
+	if stored, status, err := m.GetBookingQuote(quote.QuoteID); status != 200 || err != nil || stored.Total != quote.Total || len(stored.Seats) != 1 {
+		t.Errorf("expected the stored quote back, got %+v %v", stored, err)
+	}
+
+	// Prices changing after the quote do not change what the ticket is charged
+
+	if err := m.DB.Conn.Model(&models.Venue{}).Where("id = ?", slot.VenueID).Update("convenience_fee", 5000).Error; err != nil {
+		t.Fatal("error changing the convenience fee", err)
+	}
+
+	key := fmt.Sprintf("quote-%d", time.Now().UnixNano())
+
+	idempotent := models.Idempotent{
+		PaymentID:     key,
+		CustomerID:    "customer",
+		IdempotentKey: key,
+		ExpiredAt:     time.Now().Add(time.Hour),
+		PaymentStatus: "SUCCESS",
+		BookedSeatsId: pq.Int32Array{int32(bookedSeats[0].ID)},
+	}
+
+	if err := m.DB.Conn.Create(&idempotent).Error; err != nil {
+		t.Fatal("error creating idempotent key", err)
+	}
+
+	if status, err := m.CreateTicket(key, "txn-"+key, hold.HoldToken, "unknown"); status != 409 {
+		t.Errorf("expected 409 for a quote of another hold, got %d %v", status, err)
+	}
+
+	if status, err := m.CreateTicket(key, "txn-"+key, hold.HoldToken, quote.QuoteID); status != 200 || err != nil {
+		t.Fatal("creating the ticket should be 200", err)
+	}
+
+	var ticket models.Ticket
+
+	if err := m.DB.Conn.Where("transaction_id = ?", "txn-"+key).First(&ticket).Error; err != nil {
+		t.Fatal("error reading ticket", err)
+	}
+
+	if ticket.QuoteID != quote.QuoteID || ticket.Amount != 3740 || ticket.Currency != "INR" {
+		t.Errorf("expected the ticket to be charged the quote, got %+v", ticket)
+	}
+}
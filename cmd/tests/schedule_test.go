@@ -0,0 +1,211 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func conflictReason(t *testing.T, err error) string {
+	t.Helper()
+
+	var conflict *api.ScheduleConflictError
+
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected a schedule conflict, got %v", err)
+	}
+
+	return conflict.Reason
+}
+
+func TestValidateShowTimes(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	movie := models.Movie{Duration: 120}
+	venue := models.Venue{MovieFormatSupported: pq.StringArray{"2D", "IMAX"}}
+
+	t.Run("Valid show", func(t *testing.T) {
+		slot := models.MovieTimeSlot{StartTime: start, EndTime: start.Add(130 * time.Minute), Duration: 130, MovieFormat: "TWO_D"}
+
+		if err := api.ValidateShowTimes(&slot, movie, venue); err != nil {
+			t.Error("expected a valid show", err)
+		}
+	})
+
+	t.Run("Missing duration is taken from the times", func(t *testing.T) {
+		slot := models.MovieTimeSlot{StartTime: start, EndTime: start.Add(125 * time.Minute), MovieFormat: "IMAX"}
+
+		if err := api.ValidateShowTimes(&slot, movie, venue); err != nil || slot.Duration != 125 {
+			t.Errorf("expected a 125 minutes show, got %d %v", slot.Duration, err)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		slot   models.MovieTimeSlot
+		reason string
+	}{
+		{"End before start", models.MovieTimeSlot{StartTime: start, EndTime: start.Add(-time.Hour), MovieFormat: "2D"}, api.ScheduleConflictInvalidTimes},
+		{"Duration does not match", models.MovieTimeSlot{StartTime: start, EndTime: start.Add(130 * time.Minute), Duration: 120, MovieFormat: "2D"}, api.ScheduleConflictDuration},
+		{"Shorter than the movie", models.MovieTimeSlot{StartTime: start, EndTime: start.Add(90 * time.Minute), Duration: 90, MovieFormat: "2D"}, api.ScheduleConflictRuntime},
+		{"Unsupported format", models.MovieTimeSlot{StartTime: start, EndTime: start.Add(120 * time.Minute), Duration: 120, MovieFormat: "4D"}, api.ScheduleConflictFormat},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if reason := conflictReason(t, api.ValidateShowTimes(&c.slot, movie, venue)); reason != c.reason {
+				t.Errorf("expected %s, got %s", c.reason, reason)
+			}
+		})
+	}
+}
+
+func TestFindScheduleConflict(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	buffer := 15 * time.Minute
+
+	existing := []models.MovieTimeSlot{
+		{StartTime: start, EndTime: start.Add(2 * time.Hour)},
+	}
+	existing[0].ID = 7
+
+	t.Run("Overlapping show names the conflicting slot", func(t *testing.T) {
+		slot := models.MovieTimeSlot{StartTime: start.Add(time.Hour), EndTime: start.Add(3 * time.Hour)}
+
+		err := api.FindScheduleConflict(slot, existing, buffer)
+
+		if reason := conflictReason(t, err); reason != api.ScheduleConflictOverlap {
+			t.Errorf("expected OVERLAP, got %s", reason)
+		}
+
+		var conflict *api.ScheduleConflictError
+
+		if errors.As(err, &conflict); conflict.MovieTimeSlot == nil || conflict.MovieTimeSlot.ID != 7 {
+			t.Errorf("expected the conflict to name slot 7")
+		}
+	})
+
+	t.Run("Show inside the buffer", func(t *testing.T) {
+		slot := models.MovieTimeSlot{StartTime: start.Add(2*time.Hour + 10*time.Minute), EndTime: start.Add(4 * time.Hour)}
+
+		if reason := conflictReason(t, api.FindScheduleConflict(slot, existing, buffer)); reason != api.ScheduleConflictTurnaround {
+			t.Errorf("expected TURNAROUND, got %s", reason)
+		}
+	})
+
+	t.Run("Show right after the buffer", func(t *testing.T) {
+		slot := models.MovieTimeSlot{StartTime: start.Add(2*time.Hour + buffer), EndTime: start.Add(4 * time.Hour)}
+
+		if err := api.FindScheduleConflict(slot, existing, buffer); err != nil {
+			t.Error("expected no conflict", err)
+		}
+	})
+
+	t.Run("Show does not conflict with itself", func(t *testing.T) {
+		slot := existing[0]
+
+		if err := api.FindScheduleConflict(slot, existing, buffer); err != nil {
+			t.Error("expected no conflict", err)
+		}
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 1)
+
+	t.Run("Overlapping show is rejected", func(t *testing.T) {
+		overlapping := models.MovieTimeSlot{
+			StartTime:   slot.StartTime.Add(time.Hour),
+			EndTime:     slot.StartTime.Add(3 * time.Hour),
+			Duration:    120,
+			MovieID:     slot.MovieID,
+			Date:        slot.Date,
+			MovieFormat: "2D",
+			VenueID:     slot.VenueID,
+		}
+
+		_, status, err := m.AddMovieTimeSlot(overlapping)
+
+		if status != 409 || conflictReason(t, err) != api.ScheduleConflictOverlap {
+			t.Errorf("expected 409 OVERLAP, got %d %v", status, err)
+		}
+	})
+
+	t.Run("Show after the turnaround buffer is added", func(t *testing.T) {
+		start := slot.EndTime.Add(m.ScheduleConfig.TurnaroundBuffer)
+
+		_, status, err := m.AddMovieTimeSlot(models.MovieTimeSlot{
+			StartTime:   start,
+			EndTime:     start.Add(120 * time.Minute),
+			Duration:    120,
+			MovieID:     slot.MovieID,
+			Date:        start,
+			MovieFormat: "TWO_D",
+			VenueID:     slot.VenueID,
+		})
+
+		if status != 200 || err != nil {
+			t.Error("status should be 200", err)
+		}
+	})
+
+	t.Run("Update into the buffer of the next show is rejected", func(t *testing.T) {
+		_, status, err := m.UpdateMovieTimeSlot(slot.ID, models.MovieTimeSlot{
+			StartTime: slot.StartTime.Add(10 * time.Minute),
+			EndTime:   slot.EndTime.Add(10 * time.Minute),
+		})
+
+		if status != 409 || conflictReason(t, err) != api.ScheduleConflictTurnaround {
+			t.Errorf("expected 409 TURNAROUND, got %d %v", status, err)
+		}
+	})
+
+	t.Run("Overlapping slots given to AddMovie are rejected", func(t *testing.T) {
+		suffix := time.Now().UnixNano()
+		start := time.Now().Add(48 * time.Hour).Truncate(time.Minute)
+
+		movie := models.Movie{
+			Title:           fmt.Sprintf("Schedule test %d", suffix),
+			Description:     "Movie used by the schedule tests",
+			Duration:        120,
+			Language:        pq.StringArray{"English"},
+			Type:            pq.StringArray{"Drama"},
+			ReleaseDate:     time.Now(),
+			MovieResolution: pq.StringArray{"2D"},
+			Venues: []models.Venue{{
+				Name:                 "Schedule test venue",
+				Type:                 "MOVIE",
+				Address:              "1 Test Street",
+				Rows:                 1,
+				Columns:              1,
+				ScreenNumber:         int(suffix % 1000000000),
+				MovieFormatSupported: pq.StringArray{"2D"},
+				LanguagesSupported:   pq.StringArray{"English"},
+			}},
+		}
+
+		show := func(start time.Time) models.MovieTimeSlot {
+			return models.MovieTimeSlot{StartTime: start, EndTime: start.Add(120 * time.Minute), Duration: 120, Date: start, MovieFormat: "2D"}
+		}
+
+		_, status, err := m.AddMovie(movie, []models.MovieTimeSlot{show(start), show(start.Add(time.Hour))}, nil)
+
+		if status != 409 || conflictReason(t, err) != api.ScheduleConflictOverlap {
+			t.Errorf("expected 409 OVERLAP, got %d %v", status, err)
+		}
+
+		var count int64
+
+		m.DB.Conn.Model(&models.Movie{}).Where("title = ?", movie.Title).Count(&count)
+
+		if count != 0 {
+			t.Error("expected the movie to be rolled back with its shows")
+		}
+	})
+}
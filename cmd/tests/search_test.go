@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+// uniqueWord returns a word made of letters only so it survives the full-text parser untouched
+func uniqueWord(prefix string) string {
+	n := time.Now().UnixNano()
+	var b strings.Builder
+
+	b.WriteString(prefix)
+
+	for n > 0 {
+		b.WriteByte(byte('a' + n%26))
+		n /= 26
+	}
+
+	return b.String()
+}
+
+func TestSearchMovies(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	word := uniqueWord("Quixotrel")
+	castName := uniqueWord("Marlowe")
+
+	movie := models.Movie{
+		Title:           "The " + word + " Affair",
+		Description:     "A detective follows the " + word + " across three continents",
+		Duration:        110,
+		Language:        pq.StringArray{"English"},
+		Type:            pq.StringArray{"Thriller"},
+		ReleaseDate:     time.Now(),
+		MovieResolution: pq.StringArray{"2D", "IMAX"},
+		CastCrew: []models.CastAndCrew{
+			{Type: "cast", Name: castName, Character: "Detective"},
+		},
+	}
+
+	if err := m.DB.Conn.Create(&movie).Error; err != nil {
+		t.Fatal("error creating movie", err)
+	}
+
+	t.Run("Title match is highlighted", func(t *testing.T) {
+		results, _, status, err := m.SearchMovies(word, api.MovieSearchFilters{}, 10, "")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(results) == 0 || results[0].Movie.ID != movie.ID {
+			t.Fatalf("expected movie %d first, got %v", movie.ID, results)
+		}
+
+		if !strings.Contains(results[0].TitleHighlight, api.SearchHighlightStart) {
+			t.Errorf("expected a highlighted title, got %q", results[0].TitleHighlight)
+		}
+
+		if !strings.Contains(results[0].DescriptionSnippet, api.SearchHighlightStart) {
+			t.Errorf("expected a highlighted snippet, got %q", results[0].DescriptionSnippet)
+		}
+	})
+
+	t.Run("Misspelled title still matches", func(t *testing.T) {
+		typo := word[:len(word)-2] + word[len(word)-1:]
+
+		results, _, status, err := m.SearchMovies(typo, api.MovieSearchFilters{}, 10, "")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(results) == 0 || results[0].Movie.ID != movie.ID {
+			t.Errorf("expected movie %d for %q", movie.ID, typo)
+		}
+	})
+
+	t.Run("Cast name matches", func(t *testing.T) {
+		results, _, status, err := m.SearchMovies(castName, api.MovieSearchFilters{}, 10, "")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(results) == 0 || results[0].Movie.ID != movie.ID {
+			t.Fatalf("expected movie %d for the cast name", movie.ID)
+		}
+
+		if len(results[0].MatchedCast) != 1 || results[0].MatchedCast[0] != castName {
+			t.Errorf("expected matched cast %q, got %v", castName, results[0].MatchedCast)
+		}
+	})
+
+	t.Run("Filters exclude the movie", func(t *testing.T) {
+		results, _, status, err := m.SearchMovies(word, api.MovieSearchFilters{MovieResolution: "4DX"}, 10, "")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(results) != 0 {
+			t.Errorf("expected no results, got %d", len(results))
+		}
+	})
+
+	t.Run("Empty query is rejected", func(t *testing.T) {
+		if _, _, status, _ := m.SearchMovies("  ", api.MovieSearchFilters{}, 10, ""); status != 400 {
+			t.Errorf("expected 400, got %d", status)
+		}
+	})
+}
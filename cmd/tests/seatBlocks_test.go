@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+func TestSeatBlockCovers(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	slot := models.MovieTimeSlot{VenueID: 1, StartTime: start, EndTime: start.Add(2 * time.Hour)}
+	slot.ID = 7
+
+	at := func(d time.Duration) *time.Time {
+		t := start.Add(d)
+		return &t
+	}
+
+	otherShow := uint(8)
+
+	cases := []struct {
+		name   string
+		block  models.SeatBlock
+		covers bool
+	}{
+		{"Permanent venue block", models.SeatBlock{VenueID: 1}, true},
+		{"Other venue", models.SeatBlock{VenueID: 2}, false},
+		{"Other show", models.SeatBlock{VenueID: 1, MovieTimeSlotID: &otherShow}, false},
+		{"Overlapping maintenance", models.SeatBlock{VenueID: 1, StartTime: at(time.Hour), EndTime: at(3 * time.Hour)}, true},
+		{"Starts when the show ends", models.SeatBlock{VenueID: 1, StartTime: at(2 * time.Hour)}, false},
+		{"Ended when the show starts", models.SeatBlock{VenueID: 1, EndTime: at(0)}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := api.SeatBlockCovers(c.block, slot); got != c.covers {
+				t.Errorf("expected %v, got %v", c.covers, got)
+			}
+		})
+	}
+}
+
+func TestValidateSeatBlock(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	show := uint(1)
+
+	if err := api.ValidateSeatBlock(models.SeatBlock{Reason: api.SeatBlockCamera, StartTime: &now, EndTime: &later}); err != nil {
+		t.Error("expected a valid block", err)
+	}
+
+	invalid := []models.SeatBlock{
+		{Reason: "LOST"},
+		{Reason: api.SeatBlockBroken, StartTime: &later, EndTime: &now},
+		{Reason: api.SeatBlockHouse, MovieTimeSlotID: &show, StartTime: &now},
+	}
+
+	for _, block := range invalid {
+		if err := api.ValidateSeatBlock(block); err == nil {
+			t.Errorf("expected an error for %#v", block)
+		}
+	}
+}
+
+func TestBlockSeats(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, bookedSeats := createTestShow(t, m, 3)
+
+	seatByNumber := func(t *testing.T) (map[string]api.ShowSeat, int) {
+		t.Helper()
+
+		layout, status, err := m.GetShowSeatLayout(slot.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("error getting seat layout", err)
+		}
+
+		seats := make(map[string]api.ShowSeat, len(layout.Seats))
+
+		for _, seat := range layout.Seats {
+			seats[seat.SeatNumber] = seat
+		}
+
+		return seats, layout.FreeSeats
+	}
+
+	showID := slot.ID
+
+	blocks, status, err := m.BlockSeats(models.SeatBlock{MovieTimeSlotID: &showID, Reason: api.SeatBlockHouse}, []uint{bookedSeats[1].SeatMatrixID})
+
+	if status != 200 || err != nil || len(blocks) != 1 {
+		t.Fatal("blocking a seat for the show should be 200", err)
+	}
+
+	t.Run("Blocked seats are reported with their reason", func(t *testing.T) {
+		seats, free := seatByNumber(t)
+
+		if seat := seats["A2"]; seat.Status != api.SeatStatusBlocked || seat.BlockReason != api.SeatBlockHouse || free != 2 {
+			t.Errorf("expected A2 blocked for HOUSE and 2 free seats, got %#v and %d", seat, free)
+		}
+	})
+
+	t.Run("Blocked seats cannot be sold", func(t *testing.T) {
+		if _, status, _ := m.LockBookedSeats([]int32{int32(bookedSeats[1].ID)}, "", 0); status != 409 {
+			t.Errorf("expected 409 locking a blocked seat, got %d", status)
+		}
+
+		if _, status, _ := m.SuggestSeats(slot.ID, 3, "", false, "", 0); status != 409 {
+			t.Errorf("expected no block of 3 seats around a blocked seat, got %d", status)
+		}
+	})
+
+	t.Run("Sold seats cannot be blocked", func(t *testing.T) {
+		sellTestSeat(t, m, bookedSeats[0])
+
+		if _, status, _ := m.BlockSeats(models.SeatBlock{VenueID: slot.VenueID, Reason: api.SeatBlockBroken}, []uint{bookedSeats[0].SeatMatrixID}); status != 409 {
+			t.Errorf("expected 409 blocking a sold seat, got %d", status)
+		}
+	})
+
+	t.Run("Blocking the last seat sells the show out", func(t *testing.T) {
+		venueBlocks, status, err := m.BlockSeats(models.SeatBlock{VenueID: slot.VenueID, Reason: api.SeatBlockBroken}, []uint{bookedSeats[2].SeatMatrixID})
+
+		if status != 200 || err != nil {
+			t.Fatal("blocking a seat of the venue should be 200", err)
+		}
+
+		var got models.MovieTimeSlot
+
+		m.DB.Conn.First(&got, slot.ID)
+
+		if got.Status != api.ShowtimeSoldOut {
+			t.Errorf("expected the show to be sold out, got %s", got.Status)
+		}
+
+		if status, err := m.UnblockSeats([]uint{venueBlocks[0].ID}); status != 200 || err != nil {
+			t.Fatal("unblocking should be 200", err)
+		}
+
+		m.DB.Conn.First(&got, slot.ID)
+
+		if got.Status != api.ShowtimeOnSale {
+			t.Errorf("expected the show to be on sale again, got %s", got.Status)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+func TestSeatMatrixChanges(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, bookedSeats := createTestShow(t, m, 3)
+
+	sellTestSeat(t, m, bookedSeats[0])
+
+	seatByNumber := func(t *testing.T) map[string]api.ShowSeat {
+		t.Helper()
+
+		layout, status, err := m.GetShowSeatLayout(slot.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("error getting seat layout", err)
+		}
+
+		seats := make(map[string]api.ShowSeat, len(layout.Seats))
+
+		for _, seat := range layout.Seats {
+			seats[seat.SeatNumber] = seat
+		}
+
+		return seats
+	}
+
+	t.Run("Sold seats cannot be changed by default", func(t *testing.T) {
+		if _, status, _ := m.UpdateSeatMatrix(bookedSeats[0].SeatMatrixID, models.SeatMatrix{Price: 300}, api.SeatChangeReject); status != 409 {
+			t.Errorf("expected 409 updating a sold seat, got %d", status)
+		}
+
+		if status, _ := m.DeleteSeatMatrix(bookedSeats[0].SeatMatrixID, api.SeatChangeReject); status != 409 {
+			t.Errorf("expected 409 deleting a sold seat, got %d", status)
+		}
+	})
+
+	t.Run("Unsold seats change for the show", func(t *testing.T) {
+		if _, status, err := m.UpdateSeatMatrix(bookedSeats[1].SeatMatrixID, models.SeatMatrix{Price: 300}, api.SeatChangeReject); status != 200 || err != nil {
+			t.Fatal("updating an unsold seat should be 200", err)
+		}
+
+		if seat := seatByNumber(t)["A2"]; seat.Price.Amount != 300 {
+			t.Errorf("expected the show to see the new price, got %v", seat.Price)
+		}
+	})
+
+	t.Run("Shows that sold a seat keep it as it was", func(t *testing.T) {
+		revised, status, err := m.UpdateSeatMatrix(bookedSeats[0].SeatMatrixID, models.SeatMatrix{Price: 350}, api.SeatChangeUnsoldShows)
+
+		if status != 200 || err != nil {
+			t.Fatal("updating for unsold shows should be 200", err)
+		}
+
+		if revised.ID == bookedSeats[0].SeatMatrixID {
+			t.Error("expected the seat to be copied")
+		}
+
+		seat := seatByNumber(t)["A1"]
+
+		if seat.SeatMatrixID != bookedSeats[0].SeatMatrixID || seat.Price.Amount != 200 || seat.Status != api.SeatStatusBooked {
+			t.Errorf("expected the sold seat to be kept at 200, got %#v", seat)
+		}
+	})
+
+	t.Run("Removed seats come back with ApplySeatMatrixToShows", func(t *testing.T) {
+		var removed models.SeatMatrix
+
+		if err := m.DB.Conn.First(&removed, bookedSeats[2].SeatMatrixID).Error; err != nil {
+			t.Fatal("error reading seat", err)
+		}
+
+		if status, err := m.DeleteSeatMatrix(removed.ID, api.SeatChangeReject); status != 200 || err != nil {
+			t.Fatal("deleting an unsold seat should be 200", err)
+		}
+
+		if _, ok := seatByNumber(t)["A3"]; ok {
+			t.Error("expected the deleted seat to leave the show")
+		}
+
+		removed.ID = 0
+
+		if status, err := m.AddSeatMatrix(int(slot.VenueID), []models.SeatMatrix{removed}); status != 200 || err != nil {
+			t.Fatal("adding the seat again should be 200", err)
+		}
+
+		changes, status, err := m.ApplySeatMatrixToShows(slot.VenueID, true)
+
+		if status != 200 || err != nil || len(changes) != 1 || changes[0].Added != 1 || changes[0].Kept != 1 {
+			t.Fatalf("expected one seat added and the sold one kept, got %+v %v", changes, err)
+		}
+
+		if _, ok := seatByNumber(t)["A3"]; ok {
+			t.Error("dry run should not change the show")
+		}
+
+		if _, status, err := m.ApplySeatMatrixToShows(slot.VenueID, false); status != 200 || err != nil {
+			t.Fatal("applying should be 200", err)
+		}
+
+		if seat, ok := seatByNumber(t)["A3"]; !ok || seat.Status != api.SeatStatusFree {
+			t.Errorf("expected A3 to be free again, got %#v", seat)
+		}
+	})
+}
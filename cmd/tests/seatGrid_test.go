@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+// Export writes every cell, so rows are as wide as the grid to read back the same
+const testSeatGrid = `A: V V | V V _
+B: N N | N N N
+C: W _ | N N _
+`
+
+var testSeatPrices = map[string]int{"VIP": 450, "NORMAL": 250}
+
+func TestParseSeatGrid(t *testing.T) {
+	grid, err := api.ParseSeatGrid(testSeatGrid, api.SeatGridText, testSeatPrices)
+
+	if err != nil {
+		t.Fatal("expected a valid grid", err)
+	}
+
+	if grid.Rows != 3 || grid.Columns != 6 || len(grid.Seats) != 11 || len(grid.Cells) != 4 {
+		t.Errorf("expected 3 x 6 with 11 seats and 4 cells, got %d x %d with %d seats and %d cells", grid.Rows, grid.Columns, len(grid.Seats), len(grid.Cells))
+	}
+
+	last := grid.Seats[len(grid.Seats)-1]
+
+	if last.SeatNumber != "C2" || last.Row != 3 || last.Column != 5 || last.Type != "NORMAL" || last.Price != 250 {
+		t.Errorf("expected NORMAL seat C2 at row 3 column 5 for 250, got %#v", last)
+	}
+
+	csvGrid, err := api.ParseSeatGrid("A,V,V,|,V,V\nB,N,N,|,N,N,N\nC,W,_,|,N,N\n", api.SeatGridCSV, testSeatPrices)
+
+	if err != nil || fmt.Sprint(csvGrid.Seats) != fmt.Sprint(grid.Seats) {
+		t.Errorf("expected the csv grid to match the text grid, got %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		data   string
+		prices map[string]int
+	}{
+		{"Empty grid", "\n\n", testSeatPrices},
+		{"Unknown cell", "A: N X N", testSeatPrices},
+		{"Missing price", "A: N V", map[string]int{"NORMAL": 250}},
+		{"No seats", "A: | _ |", testSeatPrices},
+		{"Duplicate row label", "A: N N\nA: N N", testSeatPrices},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			grid, err := api.ParseSeatGrid(c.data, api.SeatGridText, c.prices)
+
+			if err == nil {
+				err = api.ValidateVenueLayout(models.Venue{Rows: grid.Rows, Columns: grid.Columns, RowLabels: grid.RowLabels}, grid.Seats)
+			}
+
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestSeatGridRoundTrip(t *testing.T) {
+	for _, format := range []string{api.SeatGridText, api.SeatGridCSV} {
+		t.Run(format, func(t *testing.T) {
+			grid, err := api.ParseSeatGrid(testSeatGrid, api.SeatGridText, testSeatPrices)
+
+			if err != nil {
+				t.Fatal("expected a valid grid", err)
+			}
+
+			venue := models.Venue{Rows: grid.Rows, Columns: grid.Columns, RowLabels: grid.RowLabels, LayoutCells: grid.Cells}
+
+			data, err := api.FormatSeatGrid(api.BuildVenueLayout(venue, grid.Seats), format)
+
+			if err != nil {
+				t.Fatal("expected the grid to be written", err)
+			}
+
+			again, err := api.ParseSeatGrid(data, format, testSeatPrices)
+
+			if err != nil || fmt.Sprint(again.Seats) != fmt.Sprint(grid.Seats) || fmt.Sprint(again.Cells) != fmt.Sprint(grid.Cells) {
+				t.Errorf("expected the same grid back, got %v\n%s", err, data)
+			}
+		})
+	}
+}
+
+func TestSeatGridRoundTripSeatTypes(t *testing.T) {
+	// Every type of the SeatType enum has a code, other types are written in brackets
+	const grid = `A: N V 2 3 4 [RECLINER]
+`
+
+	prices := map[string]int{"NORMAL": 250, "VIP": 450, "TWO_D": 200, "THREE_D": 300, "FOUR_D": 400, "RECLINER": 900}
+
+	parsed, err := api.ParseSeatGrid(grid, api.SeatGridText, prices)
+
+	if err != nil {
+		t.Fatal("expected a valid grid", err)
+	}
+
+	types := make([]string, 0, len(parsed.Seats))
+
+	for _, seat := range parsed.Seats {
+		types = append(types, seat.Type)
+	}
+
+	if fmt.Sprint(types) != "[NORMAL VIP TWO_D THREE_D FOUR_D RECLINER]" {
+		t.Errorf("expected every seat type, got %v", types)
+	}
+
+	for _, format := range []string{api.SeatGridText, api.SeatGridCSV} {
+		t.Run(format, func(t *testing.T) {
+			venue := models.Venue{Rows: parsed.Rows, Columns: parsed.Columns, RowLabels: parsed.RowLabels}
+
+			data, err := api.FormatSeatGrid(api.BuildVenueLayout(venue, parsed.Seats), format)
+
+			if err != nil {
+				t.Fatal("expected the grid to be written", err)
+			}
+
+			again, err := api.ParseSeatGrid(data, format, prices)
+
+			if err != nil || fmt.Sprint(again.Seats) != fmt.Sprint(parsed.Seats) {
+				t.Errorf("expected the same seats back, got %v\n%s", err, data)
+			}
+		})
+	}
+
+	t.Run("Type that cannot be written", func(t *testing.T) {
+		seats := []models.SeatMatrix{{SeatNumber: "A1", Row: 1, Column: 1, Type: "BEAN BAG", Price: 100}}
+
+		if _, err := api.FormatSeatGrid(api.BuildVenueLayout(models.Venue{Rows: 1, Columns: 1}, seats), api.SeatGridText); err == nil {
+			t.Error("expected an error for a seat type with a space")
+		}
+	})
+}
+
+func TestParseSeatPrices(t *testing.T) {
+	prices, err := api.ParseSeatPrices("vip=450, NORMAL=250")
+
+	if err != nil || prices["VIP"] != 450 || prices["NORMAL"] != 250 {
+		t.Errorf("expected VIP 450 and NORMAL 250, got %v %v", prices, err)
+	}
+
+	if _, err := api.ParseSeatPrices("VIP=cheap"); err == nil {
+		t.Error("expected an error for an invalid price")
+	}
+}
+
+func TestImportSeatGrid(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	venue := models.Venue{
+		Name:                 "Seat grid test venue",
+		Type:                 "MOVIE",
+		Address:              "1 Test Street",
+		ScreenNumber:         int(time.Now().UnixNano() % 1000000000),
+		MovieFormatSupported: pq.StringArray{"2D"},
+		LanguagesSupported:   pq.StringArray{"English"},
+	}
+
+	if err := m.DB.Conn.Create(&venue).Error; err != nil {
+		t.Fatal("error creating venue", err)
+	}
+
+	if _, status, _ := m.ImportSeatGrid(venue.ID, testSeatGrid, api.SeatGridText, testSeatPrices, "USD", true); status != 400 {
+		t.Errorf("expected 400 for prices in another currency than the venue, got %d", status)
+	}
+
+	if _, status, err := m.ImportSeatGrid(venue.ID, testSeatGrid, api.SeatGridText, testSeatPrices, "", true); status != 200 || err != nil {
+		t.Fatal("dry run should be 200", err)
+	}
+
+	if seats, _, _ := m.GetSeatMatrix(int(venue.ID)); len(seats) != 0 {
+		t.Errorf("dry run should not write seats, got %d", len(seats))
+	}
+
+	if _, status, err := m.ImportSeatGrid(venue.ID, testSeatGrid, api.SeatGridText, testSeatPrices, "", false); status != 200 || err != nil {
+		t.Fatal("import should be 200", err)
+	}
+
+	data, prices, currency, status, err := m.ExportSeatGrid(venue.ID, api.SeatGridText)
+
+	if status != 200 || err != nil || data != testSeatGrid || prices["VIP"] != 450 || currency != api.DefaultCurrency {
+		t.Errorf("expected the imported grid back, got %v %s %v\n%s", prices, currency, err, data)
+	}
+
+	if _, status, _ := m.ImportSeatGrid(venue.ID, testSeatGrid, api.SeatGridText, testSeatPrices, "", false); status != 409 {
+		t.Errorf("expected 409 importing into a venue with seats, got %d", status)
+	}
+}
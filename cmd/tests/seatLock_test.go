@@ -0,0 +1,380 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/helper"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+// newTestMovieDB connects to the database from the .env file, the test is skipped in short mode
+func newTestMovieDB(t *testing.T) *api.MovieDB {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("Skipping this test in short mode")
+	}
+
+	err := godotenv.Load()
+
+	if err != nil {
+		t.Fatal("error loading .env file", err)
+	}
+
+	m := api.NewMovieDB()
+
+	conn, err := helper.ConnectToDB()
+
+	if err != nil {
+		t.Fatal("error connecting to the database", err)
+	}
+
+	m.DB.Conn = conn
+
+	if err := helper.Migrate(conn); err != nil {
+		t.Fatal("error migrating the database", err)
+	}
+
+	return m
+}
+
+// createTestShow adds a venue with the given number of seats in a single row and one movie time slot for it
+func createTestShow(t *testing.T, m *api.MovieDB, seats int) (models.MovieTimeSlot, []models.BookedSeats) {
+	t.Helper()
+
+	suffix := time.Now().UnixNano()
+
+	movie := models.Movie{
+		Title:           fmt.Sprintf("Seat lock test %d", suffix),
+		Description:     "Movie used by the seat lock tests",
+		Duration:        120,
+		Language:        pq.StringArray{"English"},
+		Type:            pq.StringArray{"Drama"},
+		ReleaseDate:     time.Now(),
+		MovieResolution: pq.StringArray{"2D"},
+	}
+
+	if err := m.DB.Conn.Create(&movie).Error; err != nil {
+		t.Fatal("error creating movie", err)
+	}
+
+	venue := models.Venue{
+		Name:                 "Seat lock test venue",
+		Type:                 "MOVIE",
+		Address:              "1 Test Street",
+		Rows:                 1,
+		Columns:              seats,
+		ScreenNumber:         int(suffix % 1000000000),
+		MovieFormatSupported: pq.StringArray{"2D"},
+		LanguagesSupported:   pq.StringArray{"English"},
+	}
+
+	if err := m.DB.Conn.Create(&venue).Error; err != nil {
+		t.Fatal("error creating venue", err)
+	}
+
+	seatMatrix := make([]models.SeatMatrix, 0, seats)
+
+	for i := 1; i <= seats; i++ {
+		seatMatrix = append(seatMatrix, models.SeatMatrix{
+			SeatNumber: fmt.Sprintf("A%d", i),
+			Row:        1,
+			Column:     i,
+			Price:      200,
+			Type:       "NORMAL",
+		})
+	}
+
+	if status, err := m.AddSeatMatrix(int(venue.ID), seatMatrix); status != 200 || err != nil {
+		t.Fatal("error adding seat matrix", err)
+	}
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+
+	slot, status, err := m.AddMovieTimeSlot(models.MovieTimeSlot{
+		StartTime:   start,
+		EndTime:     start.Add(120 * time.Minute),
+		Duration:    120,
+		MovieID:     movie.ID,
+		Date:        start,
+		MovieFormat: "2D",
+		VenueID:     venue.ID,
+	})
+
+	if status != 200 || err != nil {
+		t.Fatal("error adding movie time slot", err)
+	}
+
+	bookedSeats, status, err := m.GetBookedSeats(slot.ID)
+
+	if status != 200 || err != nil {
+		t.Fatal("error getting booked seats", err)
+	}
+
+	return slot, bookedSeats
+}
+
+func TestSeatLocks(t *testing.T) {
+	t.Run("Release expired seat locks", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		slot, bookedSeats := createTestShow(t, m, 2)
+
+		expired := time.Now().Add(-time.Minute)
+
+		err := m.DB.Conn.Model(&models.BookedSeats{}).
+			Where("id = ?", bookedSeats[0].ID).
+			Updates(map[string]any{"is_booked": true, "locked_until": expired}).Error
+
+		if err != nil {
+			t.Fatal("error expiring seat lock", err)
+		}
+
+		released, status, err := m.ReleaseExpiredSeatLocks(slot.ID, 0)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(released) != 1 || released[0].ID != bookedSeats[0].ID {
+			t.Fatalf("expected only seat %d to be released, got %v", bookedSeats[0].ID, released)
+		}
+
+		var seat models.BookedSeats
+
+		if err := m.DB.Conn.First(&seat, bookedSeats[0].ID).Error; err != nil {
+			t.Fatal("error fetching released seat", err)
+		}
+
+		if seat.IsBooked || seat.LockedUntil != nil {
+			t.Error("released seat should not be booked or locked anymore")
+		}
+	})
+
+	t.Run("Release seat locks only for the owning idempotent key", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		_, bookedSeats := createTestShow(t, m, 2)
+
+		ownerKey := fmt.Sprintf("owner-%d", time.Now().UnixNano())
+		otherKey := fmt.Sprintf("other-%d", time.Now().UnixNano())
+
+		if _, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, ownerKey, 0); status != 200 || err != nil {
+			t.Fatal("error locking first seat", err)
+		}
+
+		if _, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[1].ID)}, otherKey, 0); status != 200 || err != nil {
+			t.Fatal("error locking second seat", err)
+		}
+
+		_, status, _ := m.ReleaseSeatLocks(ownerKey, []int32{int32(bookedSeats[0].ID), int32(bookedSeats[1].ID)})
+
+		if status != 409 {
+			t.Errorf("releasing a seat locked by another key should fail with 409, got %d", status)
+		}
+
+		released, status, err := m.ReleaseSeatLocks(ownerKey, nil)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(released) != 1 || released[0].ID != bookedSeats[0].ID {
+			t.Fatalf("expected only seat %d to be released, got %v", bookedSeats[0].ID, released)
+		}
+	})
+
+	t.Run("Only one of many concurrent lockers wins the same seats", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		_, bookedSeats := createTestShow(t, m, 3)
+
+		seatIDs := []int32{int32(bookedSeats[0].ID), int32(bookedSeats[1].ID), int32(bookedSeats[2].ID)}
+
+		const lockers = 20
+
+		var wg sync.WaitGroup
+		var winners int32
+		tokens := make(chan string, lockers)
+
+		for i := 0; i < lockers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				// Every locker asks for the same seats in a different order
+				ids := append([]int32{}, seatIDs[i%len(seatIDs):]...)
+				ids = append(ids, seatIDs[:i%len(seatIDs)]...)
+
+				hold, status, _ := m.LockBookedSeats(ids, fmt.Sprintf("concurrent-%d-%d", i, time.Now().UnixNano()), 0)
+
+				if status == 200 {
+					atomic.AddInt32(&winners, 1)
+					tokens <- hold.HoldToken
+				}
+			}(i)
+		}
+
+		wg.Wait()
+		close(tokens)
+
+		if winners != 1 {
+			t.Fatalf("exactly one locker should win, got %d", winners)
+		}
+
+		winningToken := <-tokens
+
+		var seats []models.BookedSeats
+
+		if err := m.DB.Conn.Where("id IN ?", seatIDs).Find(&seats).Error; err != nil {
+			t.Fatal("error fetching locked seats", err)
+		}
+
+		for _, seat := range seats {
+			if seat.HoldToken == nil || *seat.HoldToken != winningToken {
+				t.Errorf("seat %s should be held by the winning token", seat.SeatNumber)
+			}
+		}
+	})
+
+	t.Run("Booking requires the matching hold token", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		slot, bookedSeats := createTestShow(t, m, 1)
+
+		hold, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, fmt.Sprintf("book-%d", time.Now().UnixNano()), 0)
+
+		if status != 200 || err != nil {
+			t.Fatal("error locking seat", err)
+		}
+
+		status, _ = m.BookSeats(int32(slot.ID), "user@example.com", "+919999999999", bookedSeats, "not-the-token")
+
+		if status != 409 {
+			t.Errorf("booking with a wrong hold token should fail with 409, got %d", status)
+		}
+
+		status, err = m.BookSeats(int32(slot.ID), "user@example.com", "+919999999999", bookedSeats, hold.HoldToken)
+
+		if status != 200 || err != nil {
+			t.Error("booking with the hold token should succeed", err)
+		}
+	})
+
+	t.Run("Seat hold can be extended only a limited number of times", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		m.HoldConfig = api.SeatHoldConfig{
+			DefaultDuration: 10 * time.Minute,
+			MaxDuration:     20 * time.Minute,
+			MaxExtensions:   1,
+		}
+
+		_, bookedSeats := createTestShow(t, m, 1)
+
+		hold, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, fmt.Sprintf("extend-%d", time.Now().UnixNano()), time.Hour)
+
+		if status != 200 || err != nil {
+			t.Fatal("error locking seat", err)
+		}
+
+		if hold.LockedUntil.After(time.Now().Add(20 * time.Minute)) {
+			t.Errorf("hold should be capped at the maximum duration, locked until %s", hold.LockedUntil)
+		}
+
+		extended, status, err := m.ExtendSeatHold(hold.HoldToken, 5*time.Minute)
+
+		if status != 200 || err != nil {
+			t.Fatal("first extension should succeed", err)
+		}
+
+		// Postgres keeps microseconds only, so allow for the rounding of the stored expiry
+		if diff := extended.LockedUntil.Sub(hold.LockedUntil); diff < 5*time.Minute-time.Millisecond || diff > 5*time.Minute+time.Millisecond {
+			t.Errorf("hold should be extended by 5 minutes, got %s", diff)
+		}
+
+		_, status, _ = m.ExtendSeatHold(hold.HoldToken, 5*time.Minute)
+
+		if status != 409 {
+			t.Errorf("second extension should fail with 409, got %d", status)
+		}
+	})
+
+	t.Run("Locking again with the same key does not move the expiry", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		_, bookedSeats := createTestShow(t, m, 2)
+
+		key := fmt.Sprintf("relock-%d", time.Now().UnixNano())
+
+		hold, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, key, 0)
+
+		if status != 200 || err != nil {
+			t.Fatal("error locking seat", err)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		relocked, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID), int32(bookedSeats[1].ID)}, key, 0)
+
+		if status != 200 || err != nil {
+			t.Fatal("locking again with the same key should succeed", err)
+		}
+
+		if relocked.HoldToken != hold.HoldToken || relocked.ExtensionsLeft != hold.ExtensionsLeft {
+			t.Errorf("expected the existing hold back, got %+v", relocked)
+		}
+
+		if diff := relocked.LockedUntil.Sub(hold.LockedUntil); diff < -time.Millisecond || diff > time.Millisecond {
+			t.Errorf("locking again should not move the expiry, moved by %s", diff)
+		}
+
+		var seats []models.BookedSeats
+
+		if err := m.DB.Conn.Where("id IN ?", []uint{bookedSeats[0].ID, bookedSeats[1].ID}).Find(&seats).Error; err != nil {
+			t.Fatal("error fetching locked seats", err)
+		}
+
+		for _, seat := range seats {
+			if seat.LockedUntil == nil || !seat.LockedUntil.Equal(relocked.LockedUntil) {
+				t.Errorf("seat %s should expire with the hold at %s, got %v", seat.SeatNumber, relocked.LockedUntil, seat.LockedUntil)
+			}
+		}
+	})
+}
+
+func TestSeatHoldDuration(t *testing.T) {
+	config := api.SeatHoldConfig{
+		DefaultDuration: 15 * time.Minute,
+		MaxDuration:     30 * time.Minute,
+		MaxExtensions:   1,
+	}
+
+	tests := []struct {
+		name      string
+		requested time.Duration
+		venue     time.Duration
+		want      time.Duration
+	}{
+		{"server default", 0, 0, 15 * time.Minute},
+		{"venue default", 0, 20 * time.Minute, 20 * time.Minute},
+		{"requested wins over venue", 25 * time.Minute, 20 * time.Minute, 25 * time.Minute},
+		{"capped at maximum", time.Hour, 0, 30 * time.Minute},
+		{"venue capped at maximum", 0, time.Hour, 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := config.HoldDuration(tt.requested, tt.venue); got != tt.want {
+				t.Errorf("HoldDuration(%s, %s) = %s, want %s", tt.requested, tt.venue, got, tt.want)
+			}
+		})
+	}
+}
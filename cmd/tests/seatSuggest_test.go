@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+)
+
+// testSeatRows builds a seat map from rows of F (free), X (taken) and V (free VIP), rows and columns start at 1
+func testSeatRows(rows ...string) []api.ShowSeat {
+	seats := make([]api.ShowSeat, 0)
+
+	for r, row := range rows {
+		for c, cell := range row {
+			seat := api.ShowSeat{
+				BookedSeatID: uint(len(seats) + 1),
+				SeatNumber:   fmt.Sprintf("%c%d", 'A'+r, c+1),
+				Row:          r + 1,
+				Column:       c + 1,
+				Type:         "NORMAL",
+				Status:       api.SeatStatusFree,
+			}
+
+			switch cell {
+			case 'X':
+				seat.Status = api.SeatStatusBooked
+			case 'V':
+				seat.Type = "VIP"
+			}
+
+			seats = append(seats, seat)
+		}
+	}
+
+	return seats
+}
+
+func seatNumbers(seats []api.ShowSeat) string {
+	numbers := ""
+
+	for _, seat := range seats {
+		numbers += seat.SeatNumber + " "
+	}
+
+	return numbers
+}
+
+func TestSuggestSeatBlock(t *testing.T) {
+	cases := []struct {
+		name      string
+		rows      []string
+		partySize int
+		seatType  string
+		expected  string
+	}{
+		{"Centre of the centre row", []string{"FFFFFF", "FFFFFF", "FFFFFF"}, 2, "", "B3 B4 "},
+		{"Next row when the centre row is full", []string{"FFFFFF", "XXXXXX", "FFFFFF"}, 2, "", "A3 A4 "},
+		{"No single seat gap", []string{"XFFFX", "XFFFFX", "XFFFX"}, 2, "", "B2 B3 "},
+		{"Single seat gap when nothing else fits", []string{"XFFFX"}, 2, "", "A2 A3 "},
+		{"Seat type", []string{"FFFFFF", "FFFFFF", "VVVVVV"}, 2, "VIP", "C3 C4 "},
+		{"Edge of the row rather than a single seat gap", []string{"FFFF"}, 2, "", "A1 A2 "},
+		{"Taken seats split a block", []string{"FFXFF"}, 2, "", "A1 A2 "},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			seats, err := api.SuggestSeatBlock(testSeatRows(c.rows...), c.partySize, c.seatType)
+
+			if err != nil {
+				t.Fatal("expected a suggestion", err)
+			}
+
+			if got := seatNumbers(seats); got != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, got)
+			}
+		})
+	}
+
+	t.Run("No block big enough", func(t *testing.T) {
+		if _, err := api.SuggestSeatBlock(testSeatRows("FFXFF"), 3, ""); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("Party size out of range", func(t *testing.T) {
+		if _, err := api.SuggestSeatBlock(testSeatRows("FFFF"), 0, ""); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestSuggestSeats(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 4)
+
+	suggestion, status, err := m.SuggestSeats(slot.ID, 2, "", true, "suggest-key", 0)
+
+	if status != 200 || err != nil || suggestion.Hold == nil || len(suggestion.Seats) != 2 {
+		t.Fatal("expected two held seats", status, err)
+	}
+
+	layout, status, err := m.GetShowSeatLayout(slot.ID)
+
+	if status != 200 || err != nil || layout.FreeSeats != 2 {
+		t.Errorf("expected two free seats left, got %d %v", layout.FreeSeats, err)
+	}
+
+	if _, status, _ := m.SuggestSeats(slot.ID, 3, "", false, "", 0); status != 409 {
+		t.Errorf("expected 409 when no block is big enough, got %d", status)
+	}
+}
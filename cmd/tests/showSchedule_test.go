@@ -0,0 +1,197 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestExpandShowSchedule(t *testing.T) {
+	schedule := models.ShowSchedule{
+		MovieID:     1,
+		VenueID:     1,
+		MovieFormat: "TWO_D",
+		StartDate:   time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		DaysOfWeek:  pq.Int32Array{0, 5},
+		StartTimes:  pq.StringArray{"21:00", "18:30"},
+		Duration:    150,
+	}
+
+	t.Run("Shows on the chosen days in the cinema time zone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		shows, err := api.ExpandShowSchedule(schedule, loc)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Friday the 7th and Sunday the 9th, clocks go forward on the 9th
+		expected := []time.Time{
+			time.Date(2025, 3, 7, 23, 30, 0, 0, time.UTC),
+			time.Date(2025, 3, 8, 2, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 9, 22, 30, 0, 0, time.UTC),
+			time.Date(2025, 3, 10, 1, 0, 0, 0, time.UTC),
+		}
+
+		if len(shows) != len(expected) {
+			t.Fatalf("expected %d shows, got %d", len(expected), len(shows))
+		}
+
+		for i, show := range shows {
+			if !show.StartTime.Equal(expected[i]) {
+				t.Errorf("show %d should start at %s, got %s", i, expected[i], show.StartTime)
+			}
+
+			if show.EndTime.Sub(show.StartTime) != 150*time.Minute || show.Duration != 150 {
+				t.Errorf("show %d should last 150 minutes", i)
+			}
+		}
+
+		if shows[1].Date.Day() != 7 {
+			t.Errorf("late show belongs to the day it was scheduled on, got %s", shows[1].Date)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		modify func(s *models.ShowSchedule)
+	}{
+		{"End before start", func(s *models.ShowSchedule) { s.EndDate = s.StartDate.AddDate(0, 0, -1) }},
+		{"Longer than a year", func(s *models.ShowSchedule) { s.EndDate = s.StartDate.AddDate(2, 0, 0) }},
+		{"Invalid day", func(s *models.ShowSchedule) { s.DaysOfWeek = pq.Int32Array{7} }},
+		{"Invalid start time", func(s *models.ShowSchedule) { s.StartTimes = pq.StringArray{"25:00"} }},
+		{"No start times", func(s *models.ShowSchedule) { s.StartTimes = nil }},
+		{"No duration", func(s *models.ShowSchedule) { s.Duration = 0 }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			invalid := schedule
+			c.modify(&invalid)
+
+			if _, err := api.ExpandShowSchedule(invalid, time.UTC); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestShowSchedule(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 2)
+
+	tomorrow := slot.StartTime.UTC()
+	startDate := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC)
+
+	schedule := models.ShowSchedule{
+		MovieID:     slot.MovieID,
+		VenueID:     slot.VenueID,
+		MovieFormat: "TWO_D",
+		StartDate:   startDate,
+		EndDate:     startDate.AddDate(0, 0, 6),
+		DaysOfWeek:  pq.Int32Array{0, 1, 2, 3, 4, 5, 6},
+		StartTimes:  pq.StringArray{tomorrow.Format("15:04")},
+	}
+
+	t.Run("Conflicting show stops the schedule", func(t *testing.T) {
+		result, status, err := m.CreateShowSchedule(schedule, api.ShowScheduleOptions{})
+
+		if status != 409 || err == nil {
+			t.Fatalf("expected 409, got %d %v", status, err)
+		}
+
+		if len(result.Conflicts) != 1 || result.Conflicts[0].Conflict.Reason != api.ScheduleConflictOverlap {
+			t.Errorf("expected one OVERLAP conflict, got %#v", result.Conflicts)
+		}
+	})
+
+	t.Run("Dry run does not write", func(t *testing.T) {
+		result, status, err := m.CreateShowSchedule(schedule, api.ShowScheduleOptions{DryRun: true, SkipConflicts: true})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		var count int64
+		m.DB.Conn.Model(&models.MovieTimeSlot{}).Where("venue_id = ?", slot.VenueID).Count(&count)
+
+		if count != 1 || len(result.Created) != 6 {
+			t.Errorf("expected 6 shows checked and none written, got %d created and %d in the venue", len(result.Created), count)
+		}
+	})
+
+	var created api.ShowScheduleResult
+
+	t.Run("Shows that fit are written with their seats", func(t *testing.T) {
+		result, status, err := m.CreateShowSchedule(schedule, api.ShowScheduleOptions{SkipConflicts: true})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if result.Schedule.Duration != 120 || len(result.Created) != 6 {
+			t.Fatalf("expected 6 shows of the movie runtime, got %d shows of %d minutes", len(result.Created), result.Schedule.Duration)
+		}
+
+		var seats int64
+		m.DB.Conn.Model(&models.BookedSeats{}).Where("movie_time_slot_id = ?", result.Created[0].ID).Count(&seats)
+
+		if seats != 2 {
+			t.Errorf("expected 2 seats for the show, got %d", seats)
+		}
+
+		created = result
+	})
+
+	if created.Schedule.ID == 0 {
+		t.FailNow()
+	}
+
+	t.Run("Update cancels shows dropped from the schedule", func(t *testing.T) {
+		result, status, err := m.UpdateShowSchedule(created.Schedule.ID, models.ShowSchedule{
+			EndDate: startDate.AddDate(0, 0, 3),
+		}, api.ShowScheduleOptions{SkipConflicts: true})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(result.Cancelled) != 3 || len(result.Created) != 0 {
+			t.Errorf("expected 3 shows cancelled, got %d cancelled and %d created", len(result.Cancelled), len(result.Created))
+		}
+	})
+
+	t.Run("Show with bookings is kept when the schedule is cancelled", func(t *testing.T) {
+		booked := created.Created[0]
+
+		m.DB.Conn.Model(&models.BookedSeats{}).Where("movie_time_slot_id = ?", booked.ID).Update("is_booked", true)
+
+		result, status, err := m.CancelShowSchedule(created.Schedule.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if result.Schedule.Status != api.ShowScheduleStatusCancelled {
+			t.Errorf("expected a cancelled schedule, got %s", result.Schedule.Status)
+		}
+
+		if len(result.Conflicts) != 1 || result.Conflicts[0].Conflict.Reason != api.ScheduleConflictHasBookings {
+			t.Errorf("expected the booked show as a conflict, got %#v", result.Conflicts)
+		}
+
+		if len(result.Cancelled) != 2 {
+			t.Errorf("expected 2 shows cancelled, got %d", len(result.Cancelled))
+		}
+	})
+}
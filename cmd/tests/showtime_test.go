@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestCanChangeShowtimeStatus(t *testing.T) {
+	cases := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{api.ShowtimeScheduled, api.ShowtimeOnSale, true},
+		{api.ShowtimeOnSale, api.ShowtimeScheduled, true},
+		{api.ShowtimeOnSale, api.ShowtimeSoldOut, true},
+		{api.ShowtimeSoldOut, api.ShowtimeCancelled, true},
+		{api.ShowtimeSoldOut, api.ShowtimeScheduled, false},
+		{api.ShowtimeCancelled, api.ShowtimeOnSale, false},
+		{api.ShowtimeCompleted, api.ShowtimeCancelled, false},
+	}
+
+	for _, c := range cases {
+		if got := api.CanChangeShowtimeStatus(c.from, c.to); got != c.allowed {
+			t.Errorf("%s to %s: expected %v, got %v", c.from, c.to, c.allowed, got)
+		}
+	}
+}
+
+func TestCancellationMail(t *testing.T) {
+	start := time.Date(2025, 1, 10, 18, 30, 0, 0, time.UTC)
+
+	mail := api.CancellationMail("jane@example.com", "Tom & Jerry", "Screen 1", start, []string{"A1", "A2"}, "projector fault")
+
+	if mail.To != "jane@example.com" || mail.Subject == "" {
+		t.Errorf("unexpected mail %#v", mail)
+	}
+
+	if !strings.Contains(mail.Text, "A1, A2") || !strings.Contains(mail.Text, "projector fault") {
+		t.Errorf("text should list the seats and the reason, got %s", mail.Text)
+	}
+
+	if !strings.Contains(mail.Html, "Tom &amp; Jerry") || strings.Contains(mail.Html, "\n") {
+		t.Errorf("html should be escaped and on one line, got %s", mail.Html)
+	}
+}
+
+// sellTestSeat books a seat of a show to a customer with a ticket, the way CreateTicket leaves it
+func sellTestSeat(t *testing.T, m *api.MovieDB, seat models.BookedSeats) models.Ticket {
+	t.Helper()
+
+	email := "customer@example.com"
+
+	err := m.DB.Conn.Model(&models.BookedSeats{}).Where("id = ?", seat.ID).
+		Updates(map[string]any{"is_booked": true, "email": email, "phone_number": "+15555550100"}).Error
+
+	if err != nil {
+		t.Fatal("error selling seat", err)
+	}
+
+	ticket := models.Ticket{
+		BookedSeatsID: pq.Int32Array{int32(seat.ID)},
+		CustomerID:    "customer",
+		TransactionID: fmt.Sprintf("txn-%d", time.Now().UnixNano()),
+	}
+
+	if err := m.DB.Conn.Create(&ticket).Error; err != nil {
+		t.Fatal("error creating ticket", err)
+	}
+
+	return ticket
+}
+
+func TestShowtimeLifecycle(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	t.Run("Seats of a scheduled show cannot be locked", func(t *testing.T) {
+		slot, seats := createTestShow(t, m, 1)
+
+		if _, status, err := m.SetShowtimeStatus(slot.ID, api.ShowtimeScheduled); status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if _, status, _ := m.LockBookedSeats([]int32{int32(seats[0].ID)}, "lifecycle-key", 0); status != 409 {
+			t.Errorf("expected 409, got %d", status)
+		}
+
+		if _, status, err := m.SetShowtimeStatus(slot.ID, api.ShowtimeOnSale); status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if _, status, err := m.LockBookedSeats([]int32{int32(seats[0].ID)}, "lifecycle-key", 0); status != 200 || err != nil {
+			t.Error("seat of a show on sale should be locked", err)
+		}
+	})
+
+	t.Run("Show with a sold seat cannot be deleted", func(t *testing.T) {
+		slot, seats := createTestShow(t, m, 1)
+
+		sellTestSeat(t, m, seats[0])
+
+		status, err := m.DeleteMovieTimeSlot(slot.ID)
+
+		if status != 409 || conflictReason(t, err) != api.ScheduleConflictHasBookings {
+			t.Errorf("expected 409 HAS_BOOKINGS, got %d %v", status, err)
+		}
+	})
+
+	t.Run("Show without sales is deleted", func(t *testing.T) {
+		slot, _ := createTestShow(t, m, 1)
+
+		if status, err := m.DeleteMovieTimeSlot(slot.ID); status != 200 || err != nil {
+			t.Error("status should be 200", err)
+		}
+
+		if status, _ := m.DeleteMovieTimeSlot(slot.ID); status != 404 {
+			t.Errorf("expected 404 for a deleted show, got %d", status)
+		}
+	})
+
+	t.Run("Cancel keeps the show, refunds tickets and stops sales", func(t *testing.T) {
+		slot, seats := createTestShow(t, m, 3)
+
+		ticket := sellTestSeat(t, m, seats[0])
+
+		if _, status, err := m.LockBookedSeats([]int32{int32(seats[1].ID)}, "checkout-key", 0); status != 200 || err != nil {
+			t.Fatal("error locking seat", err)
+		}
+
+		result, status, err := m.CancelShowtime(slot.ID, "projector fault")
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(result.RefundTickets) != 1 || result.RefundTickets[0].ID != ticket.ID || len(result.ReleasedSeats) != 1 {
+			t.Errorf("expected one ticket refunded and one hold released, got %d and %d", len(result.RefundTickets), len(result.ReleasedSeats))
+		}
+
+		var stored models.Ticket
+		m.DB.Conn.First(&stored, ticket.ID)
+
+		if stored.RefundStatus != api.TicketRefundPending {
+			t.Errorf("expected the ticket to be pending refund, got %q", stored.RefundStatus)
+		}
+
+		var cancelled models.MovieTimeSlot
+
+		if err := m.DB.Conn.First(&cancelled, slot.ID).Error; err != nil || cancelled.Status != api.ShowtimeCancelled || cancelled.CancelledAt == nil {
+			t.Errorf("expected the show to be kept as cancelled, got %q %v", cancelled.Status, err)
+		}
+
+		if _, status, _ := m.LockBookedSeats([]int32{int32(seats[2].ID)}, "late-key", 0); status != 409 {
+			t.Errorf("expected 409 locking a seat of a cancelled show, got %d", status)
+		}
+
+		if _, status, _ := m.CancelShowtime(slot.ID, ""); status != 409 {
+			t.Errorf("expected 409 cancelling twice, got %d", status)
+		}
+	})
+}
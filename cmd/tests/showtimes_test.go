@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+func TestSeatStatus(t *testing.T) {
+	now := time.Now()
+	token := "token"
+	email := "user@example.com"
+	future := now.Add(time.Minute)
+	past := now.Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		seat models.BookedSeats
+		want string
+	}{
+		{"free seat", models.BookedSeats{}, api.SeatStatusFree},
+		{"active hold", models.BookedSeats{IsBooked: true, HoldToken: &token, LockedUntil: &future}, api.SeatStatusHeld},
+		{"expired hold", models.BookedSeats{IsBooked: true, HoldToken: &token, LockedUntil: &past}, api.SeatStatusFree},
+		{"held seat with customer details", models.BookedSeats{IsBooked: true, HoldToken: &token, LockedUntil: &future, Email: &email}, api.SeatStatusBooked},
+		{"ticket created", models.BookedSeats{IsBooked: true}, api.SeatStatusBooked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := api.SeatStatus(tt.seat, now); got != tt.want {
+				t.Errorf("SeatStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShowtimes(t *testing.T) {
+	t.Run("Seat layout reports held seats", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		slot, bookedSeats := createTestShow(t, m, 2)
+
+		if _, status, err := m.LockBookedSeats([]int32{int32(bookedSeats[0].ID)}, fmt.Sprintf("layout-%d", time.Now().UnixNano()), 0); status != 200 || err != nil {
+			t.Fatal("error locking seat", err)
+		}
+
+		layout, status, err := m.GetShowSeatLayout(slot.ID)
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(layout.Seats) != 2 || layout.FreeSeats != 1 {
+			t.Fatalf("expected 2 seats with 1 free, got %d seats with %d free", len(layout.Seats), layout.FreeSeats)
+		}
+
+		for _, seat := range layout.Seats {
+			if seat.BookedSeatID == bookedSeats[0].ID && seat.Status != api.SeatStatusHeld {
+				t.Errorf("locked seat should be held, got %s", seat.Status)
+			}
+		}
+	})
+
+	t.Run("Showtimes are filtered by date", func(t *testing.T) {
+		m := newTestMovieDB(t)
+
+		slot, _ := createTestShow(t, m, 1)
+
+		slots, status, err := m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "", slot.StartTime.UTC().Format(time.DateOnly))
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		if len(slots) != 1 || slots[0].ID != slot.ID {
+			t.Errorf("expected only slot %d, got %v", slot.ID, slots)
+		}
+
+		slots, _, _ = m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "", slot.StartTime.UTC().AddDate(0, 0, 1).Format(time.DateOnly))
+
+		if len(slots) != 0 {
+			t.Errorf("expected no slots on the next day, got %d", len(slots))
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+)
+
+func TestLoadTimezone(t *testing.T) {
+	if loc, err := api.LoadTimezone(""); err != nil || loc != time.UTC {
+		t.Errorf("empty time zone should be UTC, got %v %v", loc, err)
+	}
+
+	if loc, err := api.LoadTimezone("Asia/Kolkata"); err != nil || loc.String() != "Asia/Kolkata" {
+		t.Errorf("expected Asia/Kolkata, got %v %v", loc, err)
+	}
+
+	if _, err := api.LoadTimezone("Mars/Olympus"); err == nil {
+		t.Error("expected an error for an unknown time zone")
+	}
+}
+
+func TestLocalShowtimes(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 1)
+
+	// 20:00 UTC is 01:30 of the next day in Mumbai
+
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1)
+	start := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 20, 0, 0, 0, time.UTC)
+
+	err := m.DB.Conn.Model(&models.MovieTimeSlot{}).Where("id = ?", slot.ID).
+		Updates(map[string]any{"start_time": start, "end_time": start.Add(2 * time.Hour), "date": start}).Error
+
+	if err != nil {
+		t.Fatal("error moving show", err)
+	}
+
+	if err := m.DB.Conn.Model(&models.Venue{}).Where("id = ?", slot.VenueID).Update("timezone", "Asia/Kolkata").Error; err != nil {
+		t.Fatal("error setting time zone", err)
+	}
+
+	t.Run("Late show is on its local day", func(t *testing.T) {
+		shows, status, err := m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "", start.Format(time.DateOnly))
+
+		if status != 200 || err != nil || len(shows) != 0 {
+			t.Errorf("expected no shows on the UTC day, got %d %d %v", len(shows), status, err)
+		}
+
+		shows, status, err = m.GetMovieShowtimes(slot.MovieID, slot.VenueID, "", start.AddDate(0, 0, 1).Format(time.DateOnly))
+
+		if status != 200 || err != nil || len(shows) != 1 {
+			t.Fatalf("expected the show on the local day, got %d %d %v", len(shows), status, err)
+		}
+
+		if shows[0].StartTime.Location().String() != "Asia/Kolkata" || shows[0].StartTime.Format("15:04") != "01:30" {
+			t.Errorf("expected 01:30 in Asia/Kolkata, got %v", shows[0].StartTime)
+		}
+
+		if !shows[0].StartTime.Equal(start) {
+			t.Errorf("expected the instant %v, got %v", start, shows[0].StartTime)
+		}
+	})
+
+	t.Run("Unknown time zone is refused", func(t *testing.T) {
+		if _, status, _ := m.GetUpcomingMovies("", "Mars/Olympus"); status != 400 {
+			t.Errorf("expected 400, got %d", status)
+		}
+	})
+}
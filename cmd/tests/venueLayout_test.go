@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kartik7120/booking_moviedb_service/cmd/api"
+	"github.com/kartik7120/booking_moviedb_service/cmd/models"
+	"github.com/lib/pq"
+)
+
+func TestRowLabel(t *testing.T) {
+	cases := map[int]string{1: "A", 26: "Z", 27: "AA", 28: "AB", 53: "BA"}
+
+	for row, expected := range cases {
+		if got := api.RowLabel(row, nil); got != expected {
+			t.Errorf("row %d: expected %s, got %s", row, expected, got)
+		}
+	}
+
+	if got := api.RowLabel(2, []string{"AA", "BB"}); got != "BB" {
+		t.Errorf("expected the given label BB, got %s", got)
+	}
+}
+
+func TestValidateVenueLayout(t *testing.T) {
+	venue := models.Venue{Rows: 2, Columns: 3}
+	seats := []models.SeatMatrix{{SeatNumber: "A1", Row: 1, Column: 1}, {SeatNumber: "A3", Row: 1, Column: 3}}
+
+	t.Run("Valid layout", func(t *testing.T) {
+		layout := venue
+		layout.LayoutCells = []models.LayoutCell{{Row: 1, Column: 2, Kind: api.LayoutCellAisle}, {Row: 2, Column: 1, Kind: api.LayoutCellWheelchair}}
+		layout.Sections = []models.SeatSection{{Name: "Front", FirstRow: 1, LastRow: 1, FirstColumn: 1, LastColumn: 3}}
+
+		if err := api.ValidateVenueLayout(layout, seats); err != nil {
+			t.Error("expected a valid layout", err)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		layout models.Venue
+		seats  []models.SeatMatrix
+	}{
+		{"Seat outside the grid", venue, []models.SeatMatrix{{SeatNumber: "C1", Row: 3, Column: 1}}},
+		{"Two seats in one cell", venue, []models.SeatMatrix{{SeatNumber: "A1", Row: 1, Column: 1}, {SeatNumber: "X1", Row: 1, Column: 1}}},
+		{"Aisle on a seat", models.Venue{Rows: 2, Columns: 3, LayoutCells: []models.LayoutCell{{Row: 1, Column: 1, Kind: api.LayoutCellAisle}}}, seats},
+		{"Unknown cell kind", models.Venue{Rows: 2, Columns: 3, LayoutCells: []models.LayoutCell{{Row: 2, Column: 2, Kind: "BAR"}}}, seats},
+		{"Section outside the grid", models.Venue{Rows: 2, Columns: 3, Sections: []models.SeatSection{{Name: "Back", FirstRow: 2, LastRow: 3, FirstColumn: 1, LastColumn: 3}}}, seats},
+		{"Duplicate row label", models.Venue{Rows: 2, Columns: 3, RowLabels: pq.StringArray{"B"}}, seats},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := api.ValidateVenueLayout(c.layout, c.seats); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestBuildVenueLayout(t *testing.T) {
+	venue := models.Venue{
+		Rows:        2,
+		Columns:     3,
+		LayoutCells: []models.LayoutCell{{Row: 1, Column: 2, Kind: api.LayoutCellAisle}},
+		Sections:    []models.SeatSection{{Name: "Back", FirstRow: 2, LastRow: 2, FirstColumn: 1, LastColumn: 3}},
+	}
+
+	seats := []models.SeatMatrix{{SeatNumber: "A1", Row: 1, Column: 1}, {SeatNumber: "B3", Row: 2, Column: 3}}
+
+	layout := api.BuildVenueLayout(venue, seats)
+
+	if layout.ScreenPosition != api.ScreenPositionTop || len(layout.Rows) != 2 || len(layout.Rows[0].Cells) != 3 {
+		t.Fatalf("expected a 2 x 3 grid with the screen on top, got %#v", layout)
+	}
+
+	kinds := ""
+
+	for _, row := range layout.Rows {
+		kinds += row.Label + ":"
+
+		for _, cell := range row.Cells {
+			kinds += cell.Kind + " "
+		}
+	}
+
+	if expected := "A:SEAT AISLE EMPTY B:EMPTY EMPTY SEAT "; kinds != expected {
+		t.Errorf("expected %s, got %s", expected, kinds)
+	}
+
+	if cell := layout.Rows[1].Cells[2]; cell.Seat == nil || cell.Seat.SeatNumber != "B3" || cell.Section != "Back" {
+		t.Errorf("expected seat B3 in the Back section, got %#v", cell)
+	}
+}
+
+func TestVenueLayout(t *testing.T) {
+	m := newTestMovieDB(t)
+
+	slot, _ := createTestShow(t, m, 2)
+
+	t.Run("Seat outside the venue is refused", func(t *testing.T) {
+		status, err := m.AddSeatMatrix(int(slot.VenueID), []models.SeatMatrix{{SeatNumber: "B1", Row: 2, Column: 1, Price: 200, Type: "NORMAL"}})
+
+		if status != 400 || err == nil {
+			t.Errorf("expected 400, got %d %v", status, err)
+		}
+	})
+
+	t.Run("Layout is saved and returned", func(t *testing.T) {
+		layout, status, err := m.SetVenueLayout(slot.VenueID, models.Venue{
+			ScreenPosition: api.ScreenPositionBottom,
+			RowLabels:      pq.StringArray{"R"},
+		})
+
+		if status != 200 || err != nil {
+			t.Fatal("status should be 200", err)
+		}
+
+		stored, status, err := m.GetVenueLayout(slot.VenueID)
+
+		if status != 200 || err != nil || stored.ScreenPosition != api.ScreenPositionBottom || stored.Rows[0].Label != "R" {
+			t.Errorf("expected the saved layout, got %#v %v", stored, err)
+		}
+
+		if len(layout.Rows) != 1 || layout.Rows[0].Cells[1].Kind != api.LayoutCellSeat {
+			t.Errorf("expected a row of seats, got %#v", layout.Rows)
+		}
+	})
+
+	t.Run("Grid cannot shrink below its seats", func(t *testing.T) {
+		if _, status, _ := m.UpdateVenue(slot.VenueID, models.Venue{Columns: 1}); status != 400 {
+			t.Errorf("expected 400, got %d", status)
+		}
+	})
+}